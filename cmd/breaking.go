@@ -0,0 +1,63 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/niiigoo/hawk/proto"
+	"github.com/spf13/cobra"
+)
+
+// breakingCmd represents the breaking command
+var breakingCmd = &cobra.Command{
+	Use:   "breaking [file]",
+	Short: "Detects breaking changes of the proto file",
+	Long: `Compares the proto file with an older revision and reports the changes breaking
+existing gRPC or HTTP clients:
+ - changed field tags, reused tags and removed fields without reserving their tag
+ - changed field types
+ - removed or renamed RPCs, changed request or response types
+ - changed google.api.http method, path or body
+ - changed HttpPrefix or WebSocketPath
+
+The older revision is either a file or a git revision (HEAD by default). The
+command exits with status 1 if breaking changes are found.
+
+Examples:
+hawk breaking
+hawk breaking --against main
+hawk breaking --against old.proto service.proto`,
+	Run: func(cmd *cobra.Command, args []string) {
+		against, err := cmd.Flags().GetString("against")
+		printErrorAndExit(err)
+
+		current := newParser()
+		file, err := current.DetectFile(args...)
+		printErrorAndExit(err)
+		printErrorAndExit(current.Parse(file))
+
+		previous := newParser()
+		if info, err := os.Stat(against); err == nil && !info.IsDir() {
+			printErrorAndExit(previous.Parse(against))
+		} else {
+			printErrorAndExit(previous.ParseRevision(file, against))
+		}
+
+		changes := proto.BreakingChanges(previous.Definition(), current.Definition())
+		for _, change := range changes {
+			fmt.Println(change.Error())
+		}
+		if len(changes) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(breakingCmd)
+
+	breakingCmd.Flags().String("against", "HEAD", "The file or git revision to compare with")
+}
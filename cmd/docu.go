@@ -32,7 +32,7 @@ hawk docu -f md`,
 		if err != nil {
 			return err
 		}
-		g := docu.NewService()
+		g := docu.NewService(newParser())
 		err = g.Generate(format, version, args...)
 		if err != nil && errors.Is(err, docu.ErrFormat) {
 			return err
@@ -0,0 +1,64 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/niiigoo/hawk/proto"
+	"github.com/spf13/cobra"
+)
+
+// fmtCmd represents the fmt command
+var fmtCmd = &cobra.Command{
+	Use:   "fmt [files or directories]",
+	Short: "Formats proto files in the canonical style",
+	Long: `Re-prints the proto files in the canonical style:
+ - two spaces per indentation level
+ - syntax, package, sorted imports and file options first
+ - aligned '=' of consecutive fields, enum values and options
+ - comments are preserved
+
+Directories are walked recursively, the current directory is used by default.
+With --check the files are not modified, the diff is printed instead and the
+command exits with status 1 if any file is not formatted.
+
+Examples:
+hawk fmt
+hawk fmt service.proto
+hawk fmt --check ./proto`,
+	Run: func(cmd *cobra.Command, args []string) {
+		check, err := cmd.Flags().GetBool("check")
+		printErrorAndExit(err)
+
+		files, err := proto.ProtoFiles(args...)
+		printErrorAndExit(err)
+
+		unformatted := false
+		for _, file := range files {
+			diff, err := proto.FormatFile(file, !check)
+			printErrorAndExit(err)
+			if diff == "" {
+				continue
+			}
+			unformatted = true
+			if check {
+				fmt.Print(diff)
+			} else {
+				fmt.Println(file)
+			}
+		}
+
+		if check && unformatted {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(fmtCmd)
+
+	fmtCmd.Flags().Bool("check", false, "Print the diff instead of rewriting the files, exit with status 1 if any file is not formatted")
+}
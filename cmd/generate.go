@@ -4,6 +4,9 @@ Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
 package cmd
 
 import (
+	"errors"
+	"os"
+
 	"github.com/niiigoo/hawk/kit"
 
 	"github.com/spf13/cobra"
@@ -27,10 +30,65 @@ Project dir
 ├── svc # do not touch, will be overridden
 ├── go.mod
 ├── go.sum
-├── *.pb.go # do not touch, will be overridden`,
+├── *.pb.go # do not touch, will be overridden
+
+All .proto files in the working directory are compiled and each service declared
+in them is generated. With multiple services, the handlers and svc files of each
+service are placed in sub-packages, e.g. handlers/admin and svc/admin.
+
+With --dry-run the files are rendered without writing them, the files which
+would change are listed instead, --diff prints their unified diffs. In both
+cases, the command exits with status 2 if the generation would change files,
+e.g. to verify in CI that the committed code is up to date.
+
+The generated files are recorded in .hawk/manifest.json. Files which must not
+be touched (all except handlers) are not overwritten if they have been modified
+by hand, use --force to overwrite them anyway. Files generated by the previous
+run which are not generated anymore are removed.
+
+Handlers of methods which are not defined anymore are moved from handlers.go
+to handlers/removed.go, the file is excluded from the build. With
+--detect-renames, a handler is renamed instead if a single new method has the
+same signature and types as a single removed one.
+
+The plugins configured in protoc.yaml are run afterward, their files are
+written and recorded like the generated ones.
+
+In a monorepo, the proto files and the service can be located anywhere in the
+module: --proto selects the proto files (like the arguments) and --out the
+directory of the service. The import paths are derived from the path of the
+module and the location of the output directory in it, the directory does not
+need a go.mod of its own.
+
+Example:
+hawk generate --proto api/foo.proto --out services/foo`,
 	Run: func(cmd *cobra.Command, args []string) {
-		g := kit.NewGenerator()
-		err := g.Service(args...)
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		printErrorAndExit(err)
+		diff, err := cmd.Flags().GetBool("diff")
+		printErrorAndExit(err)
+		force, err := cmd.Flags().GetBool("force")
+		printErrorAndExit(err)
+		detectRenames, err := cmd.Flags().GetBool("detect-renames")
+		printErrorAndExit(err)
+		protoFiles, err := cmd.Flags().GetStringSlice("proto")
+		printErrorAndExit(err)
+		out, err := cmd.Flags().GetString("out")
+		printErrorAndExit(err)
+
+		g := kit.NewGenerator(newParser())
+		if dryRun || diff {
+			g.SetDryRun(os.Stdout, diff)
+		}
+		g.SetForce(force)
+		g.SetDetectRenames(detectRenames)
+		if out != "" {
+			printErrorAndExit(g.SetOutput(out))
+		}
+		err = g.Service(append(args, protoFiles...)...)
+		if errors.Is(err, kit.ErrChanges) {
+			os.Exit(2)
+		}
 		printErrorAndExit(err)
 	},
 }
@@ -47,4 +105,10 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// generateCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	generateCmd.Flags().Bool("dry-run", false, "List the files which would change instead of writing them, exit with status 2 if any")
+	generateCmd.Flags().Bool("force", false, "Overwrite and remove generated files even if they have been modified by hand")
+	generateCmd.Flags().Bool("detect-renames", false, "Rename the handlers of methods which have most likely been renamed instead of removing them")
+	generateCmd.Flags().StringSlice("proto", nil, "Proto files to generate the service of, like the arguments")
+	generateCmd.Flags().String("out", "", "Directory of the generated service, the working directory by default")
+	generateCmd.Flags().Bool("diff", false, "Print the unified diff of the files which would change instead of writing them, exit with status 2 if any")
 }
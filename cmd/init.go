@@ -4,7 +4,10 @@ Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/niiigoo/hawk/kit"
+	"github.com/niiigoo/hawk/kit/profile"
 
 	"github.com/spf13/cobra"
 )
@@ -23,10 +26,23 @@ Output:
  - go.mod
    module: github.com/my-org/test-service
  - test.proto
-   service: Test`,
+   service: Test
+
+Further files are laid down by the profiles selected by --profile, e.g. a
+Dockerfile and an example proto file. A profile extends other profiles, they
+are selected with it. Existing files are not overwritten.
+
+Example:
+hawk init github.com/my-org/test-service test --profile compose --profile kubernetes
+
+Profiles:`,
 	Run: func(cmd *cobra.Command, args []string) {
+		profiles, err := cmd.Flags().GetStringSlice("profile")
+		printErrorAndExit(err)
+
 		g := kit.NewGenerator()
-		err := g.Init(args...)
+		g.SetProfiles(profiles...)
+		err = g.Init(args...)
 		printErrorAndExit(err)
 	},
 }
@@ -34,6 +50,13 @@ Output:
 func init() {
 	rootCmd.AddCommand(initCmd)
 
+	profiles, err := profile.List()
+	printErrorAndExit(err)
+	for _, p := range profiles {
+		initCmd.Long += fmt.Sprintf("\n - %s: %s", p.Name, p.Description)
+	}
+	initCmd.Flags().StringSlice("profile", []string{profile.Default}, "Profiles of the files to lay down, can be repeated")
+
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
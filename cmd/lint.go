@@ -0,0 +1,63 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"os"
+
+	"github.com/niiigoo/hawk/lint"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint [file]",
+	Short: "Checks the proto file against the hawk conventions",
+	Long: `Checks the proto file against the hawk conventions, e.g. missing comments,
+parameters which cannot be bound outside the body and naming conventions.
+Single rules can be disabled in protoc.yaml:
+
+lint:
+  rules:
+    COMMENT_FIELD: false
+
+The output format can be selected with the flag --format (or -f), supported
+values are text (default) and json. The command exits with status 1 if any
+problem is found.
+
+Examples:
+hawk lint
+hawk lint -f json service.proto`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+
+		// the warnings of the parser are part of the output
+		log.SetLevel(log.ErrorLevel)
+
+		diagnostics, err := lint.NewService(newParser()).Lint(args...)
+		printErrorAndExit(err)
+
+		err = lint.Write(os.Stdout, diagnostics, format)
+		if err != nil && errors.Is(err, lint.ErrFormat) {
+			return err
+		}
+		printErrorAndExit(err)
+
+		if len(diagnostics) > 0 {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().StringP("format", "f", "text", "Specify the output format. Supported values are text and json")
+}
@@ -8,9 +8,14 @@ import (
 	"os"
 	"strings"
 
+	"github.com/niiigoo/hawk/kit"
+	"github.com/niiigoo/hawk/proto"
 	"github.com/spf13/cobra"
 )
 
+// descriptorSet is the FileDescriptorSet the definition is built from
+var descriptorSet string
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "hawk",
@@ -28,19 +33,42 @@ func Execute() {
 }
 
 func init() {
+	version, date := kit.Version()
+	rootCmd.Version = strings.TrimSpace(version + " " + date)
+
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.hawk.yaml)")
+	rootCmd.PersistentFlags().StringVar(&descriptorSet, "descriptor-set", "", "Build the definition from a FileDescriptorSet instead of parsing the proto file, use 'protoc' to let protoc produce it")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
 	//rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
 
+// newParser creates the parser of the proto file configured by the global flags.
+func newParser() proto.Parser {
+	parser := proto.NewService()
+	if descriptorSet != "" {
+		parser.SetDescriptorSet(descriptorSet)
+	}
+	return parser
+}
+
+// printErrorAndExit prints the error and exits. Diagnostics of the proto file
+// are printed compiler-style, one per line (`file:line:col: message`).
 func printErrorAndExit(err error) {
 	if err != nil {
+		var diagnostics proto.Diagnostics
+		if errors.As(err, &diagnostics) {
+			for _, diagnostic := range diagnostics {
+				println(diagnostic.Error())
+			}
+			os.Exit(1)
+		}
+
 		last := ""
 		for ; err != nil; err = errors.Unwrap(err) {
 			if !strings.Contains(last, err.Error()) {
@@ -0,0 +1,59 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/niiigoo/hawk/kit"
+	"github.com/niiigoo/hawk/kit/template"
+	"github.com/spf13/cobra"
+)
+
+// templatesCmd represents the templates command
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "Manages the templates of the generated files",
+	Long: `The templates of 'hawk generate' can be overridden per project. The files of
+the directory .hawk/templates (or 'templates' in protoc.yaml) replace the
+embedded templates by their path, e.g. svc/server/run.go.tpl. Further '.tpl'
+files are generated for every service, 'NAME' in their path is replaced like
+for the embedded ones. All templates get the same data and functions.`,
+}
+
+// templatesExportCmd represents the templates export command
+var templatesExportCmd = &cobra.Command{
+	Use:   "export [dir]",
+	Short: "Writes the embedded templates to start an overlay from",
+	Long: `Writes the embedded templates to the directory, .hawk/templates by default.
+Existing files are kept unless --force is set. Delete the files you do not
+want to modify, they are taken from hawk then.
+
+Examples:
+hawk templates export
+hawk templates export ../templates`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		force, err := cmd.Flags().GetBool("force")
+		printErrorAndExit(err)
+
+		dir := kit.TemplatesPath
+		if len(args) > 0 {
+			dir = args[0]
+		}
+
+		written, err := template.Export(dir, force)
+		printErrorAndExit(err)
+		for _, name := range written {
+			fmt.Println(name)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesExportCmd)
+
+	templatesExportCmd.Flags().Bool("force", false, "Overwrite existing files")
+}
@@ -3,16 +3,39 @@ package docu
 import "github.com/niiigoo/hawk/proto"
 
 type Data struct {
-	Service     *proto.Service
-	Messages    map[string]Message
+	// Service is the first of Services
+	Service  *proto.Service
+	Services []*proto.Service
+	// Messages contains the messages by their fully-qualified name
+	Messages map[string]Message
+	// Referenced contains the fully-qualified names of the messages
+	// referenced by fields
 	Referenced  []string
 	VersionName string
 	VersionTime string
+
+	// requests and responses contain the fully-qualified names of the
+	// messages of the methods
+	requests  map[*proto.Method]string
+	responses map[*proto.Method]string
+}
+
+// Request returns the request message of the method m.
+func (d Data) Request(m *proto.Method) Message {
+	return d.Messages[d.requests[m]]
+}
+
+// Response returns the response message of the method m.
+func (d Data) Response(m *proto.Method) Message {
+	return d.Messages[d.responses[m]]
 }
 
 type Message struct {
+	// FullName is the fully-qualified name without the leading dot
+	FullName    string
 	Name        string
 	Description string
+	Deprecated  bool
 	Fields      []Field
 }
 
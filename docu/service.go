@@ -7,8 +7,8 @@ import (
 	"github.com/niiigoo/hawk/proto/io"
 	html "html/template"
 	"os"
+	"path/filepath"
 	"slices"
-	"strconv"
 	"strings"
 	"text/template"
 	"time"
@@ -24,28 +24,68 @@ type service struct {
 	protoService proto.Parser
 }
 
-func NewService() Service {
+// NewService creates the documentation service, the proto file is parsed by
+// parser if provided.
+func NewService(parser ...proto.Parser) Service {
+	protoService := proto.NewService()
+	if len(parser) > 0 {
+		protoService = parser[0]
+	}
 	return &service{
-		protoService: proto.NewService(),
+		protoService: protoService,
 	}
 }
 
+// Generate writes the documentation of all services declared in the proto
+// files given by args, all proto files in the working directory by default.
+// The markdown file is named by the proto file, or by the proto package if
+// multiple files are documented.
 func (s *service) Generate(format, version string, args ...string) error {
-	file, err := s.protoService.DetectFile(args...)
+	files, err := s.protoService.DetectFiles(args...)
 	if err != nil {
 		return err
 	}
-	source := strings.TrimSuffix(file, ".proto")
+	source := strings.TrimSuffix(files[0], ".proto")
 
-	if err = s.protoService.Parse(file, true); err != nil {
-		return err
+	data := Data{
+		Services:    make([]*proto.Service, 0),
+		Messages:    make(map[string]Message),
+		VersionName: version,
+		VersionTime: time.Now().Format(time.RFC822),
+		requests:    make(map[*proto.Method]string),
+		responses:   make(map[*proto.Method]string),
+	}
+	for _, file := range files {
+		if err = s.protoService.Parse(file, true); err != nil {
+			return err
+		}
+		def := s.protoService.Definition()
+		data.Services = append(data.Services, def.Services...)
+		for _, svc := range def.Services {
+			for _, m := range svc.Methods {
+				data.requests[m] = fullName(def, svc.Package, m.Request)
+				data.responses[m] = fullName(def, svc.Package, m.Response)
+			}
+		}
+		data.Referenced = append(data.Referenced, s.messages(def, data.Messages)...)
+	}
+	if len(data.Services) == 0 {
+		return errors.New("no service found")
+	}
+	slices.Sort(data.Referenced)
+	data.Referenced = slices.Compact(data.Referenced)
+	data.Service = data.Services[0]
+
+	if len(files) > 1 {
+		pkg := s.protoService.Definition().Package()
+		source = filepath.Join(filepath.Dir(files[0]), pkg[strings.LastIndex(pkg, ".")+1:])
 	}
 
 	switch format {
 	case "md":
-		err = s.genMD(source, version)
+		err = s.genMD(source, data)
 	case "html":
-		err = s.genHTML(source, version)
+		err = s.genHTML(data)
 	default:
 		err = ErrFormat
 	}
@@ -53,7 +93,7 @@ func (s *service) Generate(format, version string, args ...string) error {
 	return err
 }
 
-func (s *service) genMD(source, version string) error {
+func (s *service) genMD(source string, data Data) error {
 	tplBytes, err := Asset("documentation.md")
 	if err != nil {
 		return err
@@ -74,18 +114,8 @@ func (s *service) genMD(source, version string) error {
 		return err
 	}
 
-	messages, referenced := s.messages()
-	slices.Sort(referenced)
-	referenced = slices.Compact(referenced)
 	outputBuffer := bytes.NewBuffer(nil)
-	err = tpl.Execute(outputBuffer, Data{
-		Service:     s.protoService.Definition().Services[0],
-		Messages:    messages,
-		Referenced:  referenced,
-		VersionName: version,
-		VersionTime: time.Now().Format(time.RFC822),
-	})
-	if err != nil {
+	if err = tpl.Execute(outputBuffer, data); err != nil {
 		return err
 	}
 
@@ -104,7 +134,7 @@ func (s *service) genMD(source, version string) error {
 	return nil
 }
 
-func (s *service) genHTML(source, version string) error {
+func (s *service) genHTML(data Data) error {
 	if err := os.MkdirAll("html", 0777); err != nil {
 		return err
 	}
@@ -123,18 +153,8 @@ func (s *service) genHTML(source, version string) error {
 		return err
 	}
 
-	messages, referenced := s.messages()
-	slices.Sort(referenced)
-	referenced = slices.Compact(referenced)
 	outputBuffer := bytes.NewBuffer(nil)
-	err = tpl.Execute(outputBuffer, Data{
-		Service:     s.protoService.Definition().Services[0],
-		Messages:    messages,
-		Referenced:  referenced,
-		VersionName: version,
-		VersionTime: time.Now().Format(time.RFC822),
-	})
-	if err != nil {
+	if err = tpl.Execute(outputBuffer, data); err != nil {
 		return err
 	}
 
@@ -167,100 +187,94 @@ func (s *service) genHTML(source, version string) error {
 	return nil
 }
 
-func (s *service) messages() (map[string]Message, []string) {
-	messages := make(map[string]Message)
+// messages adds the messages of def to messages by their fully-qualified
+// name and returns the fully-qualified names of the messages referenced by
+// their fields.
+func (s *service) messages(def *proto.Definition, messages map[string]Message) []string {
 	var referenced []string
 
-	for _, msg := range s.protoService.Definition().MessagesMap {
+	for _, msg := range def.Messages {
+		if _, ok := messages[msg.FullName]; ok {
+			// the messages are accessible by their relative name as well
+			continue
+		}
 		fields := make([]Field, 0)
-		for _, entry := range msg.Entries {
-			if entry.Field != nil {
-				t, ref := s.parseType(entry.Field.Type)
-				if ref != nil {
-					referenced = append(referenced, ref...)
-				}
-				f := Field{
-					Name:        entry.Field.Name,
-					Description: s.normalizeComments(entry.Field.Comments),
-					Type:        t,
-					Optional:    entry.Field.Optional,
-					Options:     make([]string, 0),
-				}
-				if entry.Field.Repeated {
-					f.Type = "Array<" + f.Type + ">"
-				}
-				for _, option := range entry.Field.Options {
-					str := option.Name
-					if option.Attr != nil {
-						str = *option.Attr
-					}
-					str += " = " + s.parseValue(option.Value)
-					f.Options = append(f.Options, str)
+		for _, field := range msg.Fields {
+			t, ref := s.parseType(def, msg.FullName, field.Type)
+			if ref != nil {
+				referenced = append(referenced, ref...)
+			}
+			f := Field{
+				Name:        field.Name,
+				Description: s.normalizeComments(field.Comments),
+				Type:        t,
+				Optional:    field.Optional && (field.Validation == nil || !field.Validation.Required),
+				Options:     make([]string, 0),
+			}
+			if field.Repeated {
+				f.Type = "Array<" + f.Type + ">"
+			}
+			if field.JSONName != proto.JSONName(field.Name) {
+				f.Options = append(f.Options, "json_name = "+field.JSONName)
+			}
+			if field.Deprecated {
+				f.Options = append(f.Options, "deprecated = true")
+			}
+			if field.Validation != nil {
+				f.Options = append(f.Options, field.Validation.Strings()...)
+			}
+			for _, option := range field.Options {
+				str := option.Name
+				if option.Attr != nil {
+					str = *option.Attr
 				}
-				fields = append(fields, f)
+				str += " = " + proto.FormatValue(option.Value)
+				f.Options = append(f.Options, str)
 			}
+			fields = append(fields, f)
 		}
-		messages[msg.Name] = Message{
-			Name:        msg.Name,
+		messages[msg.FullName] = Message{
+			FullName: msg.FullName,
+			// relative to the package of the file like the types of the fields
+			Name:        strings.TrimPrefix(msg.FullName, def.Package()+"."),
 			Description: s.normalizeComments(msg.Comments),
+			Deprecated:  msg.Deprecated,
 			Fields:      fields,
 		}
 	}
 
-	return messages, referenced
+	return referenced
 }
 
-func (s *service) parseType(t io.Type) (string, []string) {
+// parseType returns the type as written in the proto file and the
+// fully-qualified names of the referenced types, they are resolved from
+// within scope.
+func (s *service) parseType(def *proto.Definition, scope string, t io.Type) (string, []string) {
 	if t.Scalar != io.None {
 		return t.Scalar.GoString(), nil
 	} else if t.Map != nil {
 		var ref []string
-		k, tmp := s.parseType(*t.Map.Key)
+		k, tmp := s.parseType(def, scope, *t.Map.Key)
 		if tmp != nil {
 			ref = append(ref, tmp...)
 		}
-		v, tmp := s.parseType(*t.Map.Value)
+		v, tmp := s.parseType(def, scope, *t.Map.Value)
 		if tmp != nil {
 			ref = append(ref, tmp...)
 		}
 		return "map<" + k + ", " + v + ">", ref
 	} else {
-		return t.Reference, []string{t.Reference}
+		return t.Reference, []string{fullName(def, scope, t.Reference)}
 	}
 }
 
-func (s *service) parseValue(v *io.Value) string {
-	if v == nil {
-		return ""
-	} else if v.Reference != nil {
-		return *v.Reference
-	} else if v.Bool != nil {
-		return strconv.FormatBool(bool(*v.Bool))
-	} else if v.Int != nil {
-		return strconv.FormatInt(*v.Int, 10)
-	} else if v.Number != nil {
-		return strconv.FormatFloat(*v.Number, 'f', -1, 64)
-	} else if v.String != nil {
-		return *v.String
-	} else if v.Array != nil {
-		strValues := make([]string, len(v.Array.Elements))
-		for i, v := range v.Array.Elements {
-			strValues[i] = s.parseValue(v)
-		}
-		return "[" + strings.Join(strValues, ", ") + "]"
-	} else if v.Map != nil {
-		str := "{"
-		for i, entry := range v.Map.Entries {
-			if i > 0 {
-				str += ", "
-			}
-			str += s.parseValue(entry.Key) + ": " + s.parseValue(entry.Value)
-		}
-		str += "}"
-		return str
+// fullName returns the fully-qualified name of the type ref referenced from
+// within scope, ref is returned if it cannot be resolved.
+func fullName(def *proto.Definition, scope, ref string) string {
+	if sym := def.Resolve(scope, ref); sym != nil {
+		return sym.FullName
 	}
-
-	return ""
+	return strings.TrimPrefix(ref, ".")
 }
 
 func (s *service) normalizeComments(c []string) string {
@@ -79,7 +79,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _documentationMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\x4d\x6f\xd4\x30\x10\xbd\xe7\x57\x8c\x94\x1c\x68\x44\xd3\xfb\xaa\xec\x81\x52\xc4\xa5\x1f\xea\x2e\xf4\xba\x4e\x32\xc9\x1a\x12\xdb\xb5\xbd\xd0\xb2\xc9\x7f\x47\xe3\x38\xde\x6c\x56\x95\x38\x81\xe0\x10\xc9\x99\xcf\xe7\xf7\xc6\x13\xc3\x7e\x0f\xd9\x0a\xf5\x77\x5e\x60\x76\xcb\x5a\x84\xbe\x07\x33\xfc\x47\xd1\x7e\x0f\xbc\x82\xec\x0b\x6a\xc3\xa5\xf0\xee\x34\xf5\xff\x8b\x34\x75\xe9\xc7\x6e\x20\x1b\x8a\x12\xfa\x3e\x0a\xa1\x60\x79\x8b\xb3\xf8\x35\x77\xf1\xae\x4b\x80\xf0\x01\x4d\xa1\xb9\xb2\x94\x43\xbe\x38\x86\x2b\x29\x2a\x5e\xef\x34\x23\x63\x14\xdd\x33\xbb\x05\xa5\xb1\xe2\xcf\x0b\xd8\x78\x84\x63\xfa\x27\x6b\xd5\xbd\xf3\x41\xdf\x4f\xeb\xce\x1d\xd8\x18\x6a\x7e\x11\xb0\x6e\x00\xa2\x2b\xd9\x2a\x8d\xc6\x01\x46\xc1\xf2\x06\xcb\x05\xcc\x3a\x4c\x62\xae\x87\x10\xe8\xfb\x17\x34\x87\x9a\x42\x86\xa2\x00\xd1\x2c\xfd\x71\xe5\xf0\xf7\x3d\x3c\x62\xbe\x92\xc5\x37\xb4\x60\x76\x4a\x49\x6d\xb1\x7c\x0b\x8a\xd9\xed\xe2\x48\x93\x90\x70\x60\x95\x48\xb9\x41\xbb\x95\xa5\xa1\xf2\x9a\x89\x1a\x21\x69\x61\xf1\xee\x90\xe6\xfd\x14\x1e\xc7\x4e\xe5\xa4\x1d\xf5\x9d\x24\xe5\x94\x94\xb4\x8e\x9e\xf7\x5c\x94\x5c\xd4\x66\x10\x8e\x98\x4d\x72\x5f\x07\x3a\x58\xcb\xcf\x4a\xa1\x26\xa6\xd2\x14\xbc\x97\xa0\x3d\xb0\x1f\x9e\xbe\x03\xc2\xcb\x12\x2d\xe3\x8d\x59\x3a\x71\x93\xd6\x97\x21\xf2\x5a\x14\xd6\x40\x07\xb7\x52\xb7\xac\xe1\x3f\x31\xd8\x06\x60\xbc\x82\x37\x5c\x94\xf8\x0c\x49\x76\x83\xc6\xb0\x1a\x0d\x55\x78\xc0\xa7\x1d\x1a\x7b\x96\x7d\xe4\xd8\x84\x8b\xc5\x70\xcf\x34\x6b\xd1\xa2\x36\xd1\x12\x3a\x10\x74\xc5\x0e\x34\x3e\xed\xb8\x46\x02\x6e\x5f\x14\x59\xca\xc9\x60\x75\xc0\xca\x92\xd3\x91\x35\xc0\x45\x45\x50\x06\x07\xd5\x38\x3f\x3f\x3f\xf9\x0e\x8c\x29\x62\xec\x77\x11\x12\x22\x22\x40\x85\xa7\xd5\xf9\x79\x4a\x54\x76\xa7\x3c\x82\x71\x64\x86\xf1\xf1\xb3\x34\xcc\xcf\x98\xbe\x1e\x2e\x71\x6d\x0a\xa6\x70\xea\x38\x7e\x2f\x83\xd9\x23\x95\x4e\xdb\xb1\x0f\xe1\x71\xaa\xc9\x69\x9d\xcd\x65\xae\x2f\x96\xb3\x7e\x5e\xc4\xa3\xd3\xeb\xb2\x18\x25\x85\xc1\x13\x5d\x46\xc7\xdf\x57\xe5\x14\xe0\x3f\x2d\xcb\x72\x72\xbe\xbc\x08\x0f\xed\x78\x3d\xdc\xe5\x5f\xb1\xb0\xd3\xf5\xe0\x24\xa0\x0d\xf1\x80\x15\x6a\x14\x85\x5b\x5c\xee\x75\x9a\xfa\x15\xf6\x28\xe7\x6c\x88\x22\x6e\x5a\x53\x87\x15\x12\x96\xca\xc4\x36\xfe\xcf\x77\xf8\x9f\x9b\x00\xd7\xfe\x3f\xd1\x39\x9c\xc2\xe1\x57\x00\x00\x00\xff\xff\xa0\xae\xa0\x84\xad\x07\x00\x00")
+var _documentationMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x54\x4b\x4f\xdc\x30\x10\xbe\xe7\x57\x8c\xb4\x39\x40\x04\xe1\xbe\xa2\x7b\x28\x50\xf5\xc2\x43\x40\xcb\x15\x6f\x32\xbb\xb8\x5d\x3f\xf0\x78\x5b\x68\xe2\xff\x5e\x4d\xe2\x38\xe9\x76\x2b\xf5\x84\xd4\x1e\x22\x39\xf3\xfa\xbe\xf9\x3c\x9e\xa6\x01\x27\xf4\x1a\x21\x97\x47\x90\x13\xcc\xdf\x41\x79\x87\xee\x9b\xac\x90\x20\x84\xa6\x01\xb9\x82\x5c\x42\x08\x59\xd3\x00\xea\x1a\x42\x98\x41\xd3\x40\x4e\xe5\x95\x50\x08\x21\x00\xf5\xf1\x1c\x20\x57\xa0\x8d\x1f\x13\x38\xb9\xfc\x8c\x8e\xa4\xd1\x31\xbc\x28\xe2\xff\xbc\x28\xba\x42\x3b\x7e\x80\x04\x94\xa5\x58\xf0\x52\xe1\x6e\xc2\xbd\x54\xf8\x0b\x31\x46\xcc\xa9\x3c\x47\xaa\x9c\xb4\x9e\xf3\x42\xc8\xb2\xd9\x0c\xce\x8c\x5e\xc9\xf5\xd6\x09\x36\x66\xd9\x8d\xf0\x4f\x60\x1d\xae\xe4\xcb\x1c\x1e\x23\x4f\x2a\x3f\x7a\x6f\x6f\x3a\x6b\xdf\xfa\x1e\x13\x6e\x88\x31\x4f\x12\xe6\x23\x40\x76\x66\x94\x75\x48\xcc\x09\x50\x8b\xe5\x06\xeb\x39\xa4\xaa\x13\xef\x45\xef\x84\x10\x5e\x91\xc6\x6a\xda\xa4\x72\x00\x83\x6c\x54\x3e\xdc\x75\x3c\x43\x80\x07\x5c\xde\x99\xea\x2b\x7a\xa0\xad\xb5\xc6\x79\xac\x8f\xc0\x0a\xff\x34\x8f\x57\x91\x42\x53\xa1\xae\xed\x4b\xf4\x4f\xa6\xa6\x6c\xbc\x65\xc5\x37\x9c\x53\x19\x3d\x1c\x38\x9b\xf5\x17\xaa\x86\x0b\x8d\x04\x54\x79\x8e\xd6\x61\x25\x7c\xc7\x18\x0e\xea\xf4\x7b\x38\xc2\x8c\xa5\x97\x5d\x69\xd5\x49\xf6\x5e\xea\x5a\xea\x35\x8f\x50\x56\x14\xac\x70\xbe\x8c\x98\xd0\xc2\xbd\xf9\x64\x2d\x3a\x56\xaf\x28\x20\x7a\xb9\x81\x5b\xf1\x3d\x4a\x3a\x02\x9c\xd6\xe8\x85\xdc\xd0\x22\xe3\x36\x72\x15\xcb\x94\x67\x46\x29\xd4\x9e\xa0\x85\x2b\xe3\x94\xd8\xc8\x1f\x98\x6c\x69\xfa\x0e\xf2\xf2\x16\x9f\xb7\x48\x1e\x72\x75\x58\x7e\x90\xb8\x49\x5d\xcf\xe0\x46\x38\xa1\xd0\xa3\xa3\x6c\x01\x2d\x68\xee\xbf\x05\x87\xcf\x5b\xe9\xb0\x86\x16\xfc\xab\x65\x4b\x3d\x99\xa8\x16\x44\x5d\x4b\x3e\x8a\x0d\x48\xbd\x62\x6c\xfe\x83\x96\x6b\x1c\x1f\x1f\xff\xf6\x8d\x12\x59\x96\xe8\x8f\x94\x98\x02\xb7\x68\xd3\xcb\x6a\x87\x29\xb2\xe5\xb5\x8d\x90\xc3\xb8\xf4\xa3\x13\xe7\xa8\x93\x2a\xa5\xdf\xf7\xac\x2f\xa8\x12\x76\xac\x93\xdb\x9d\x97\xd1\x9b\x23\x35\xc3\xd4\x12\x0e\xf3\xe9\xee\xc5\x4c\xeb\x3c\x9e\x2e\xdd\xc9\x62\x07\x6f\x9c\x83\xf1\x34\x08\x4f\xd6\x68\xc2\x7d\xca\x0f\xbe\xb7\xd6\x7d\x2f\xa3\x7f\x5a\xf8\xc5\xe4\x7c\x7a\x92\x1e\x4b\x32\x8e\x5e\xde\x08\xd7\xcb\x2f\x58\xf9\xe9\x46\xe8\x86\x9e\xd7\xfe\x2d\xae\xd0\xa1\xae\x30\x66\xe5\x8a\xd6\xcc\xed\x40\xea\x1a\x5f\x20\x2f\x2f\x91\x48\xac\x91\xfa\x9c\xc3\xc9\xa6\xa0\xf5\xa0\xdc\xb8\x4d\x46\xdb\x24\xea\x2f\x37\x4a\x8c\x9d\x6a\x96\xbd\xe1\xa4\x74\xf0\xff\xc9\x78\xa4\x53\xd3\x00\xea\x1a\x42\xf8\x39\x00\x75\x9c\xda\xb3\xf3\x07\x00\x00")
 
 func documentationMdBytes() ([]byte, error) {
 	return bindataRead(
@@ -94,12 +94,12 @@ func documentationMd() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "documentation.md", size: 1965, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "documentation.md", size: 2035, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _htmlIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x51\x6f\xdb\x36\x10\x7e\xef\xaf\xb8\x69\x7e\x58\x81\x46\x5a\x9a\x3e\x0c\x01\x25\x60\x4b\x3b\x6c\x0f\x6d\x82\x24\x58\xb1\x47\x4a\x3c\x99\x5c\x24\x52\x25\x69\xa7\x9e\xe7\xff\x3e\x90\xa2\x2c\xd9\x92\x1d\x77\xd8\x1e\x56\xe4\x29\x20\xef\xf8\xdd\xdd\x77\xf7\x51\x8c\xc9\x37\x6f\xaf\xaf\xee\x7f\xbf\x79\x07\xdc\xd6\x55\xf6\x82\xb8\x3f\x50\x51\x39\x4f\x23\x94\x91\xdb\x40\xca\xb2\x17\x00\x00\xa4\x46\x4b\xa1\xe0\x54\x1b\xb4\x69\xb4\xb0\xe5\xd9\x0f\x51\x30\x59\x61\x2b\xcc\xd6\x6b\x88\xef\x50\x2f\x45\x81\xf1\x07\x5a\x23\x6c\x36\xf0\xe3\xcd\xaf\xc0\x54\xb1\xa8\x51\x5a\x6a\x85\x92\x24\x69\x9d\x07\x98\x92\xd6\x98\x46\x4b\x81\x8f\x8d\xd2\x36\x82\x42\x49\x8b\xd2\xa6\xd1\xa3\x60\x96\xa7\x0c\x1d\xe2\x99\x5f\xbc\x02\x21\x85\x15\xb4\x3a\x33\x05\xad\x30\x3d\x8f\xbf\xef\x72\xa8\x84\x7c\x00\x8d\x55\x1a\x19\xbb\xaa\xd0\x70\x44\x1b\x01\xd7\x58\xa6\x51\x4d\x85\x8c\x0b\x63\xa2\x71\xd8\x42\x55\x4a\x9f\x99\x82\x63\x8d\x83\xd0\x95\x98\x73\x0b\x8c\xea\x07\xc7\x42\xd2\xd2\x40\x72\xc5\x56\x01\x82\x89\x25\x14\x15\x35\x26\x8d\x8c\x60\x18\x90\xf7\x4d\x85\xaa\x06\x16\x6f\xe5\xe7\x93\x44\x99\x76\x4d\x12\x7e\xbe\x7b\x60\xbd\x06\x51\x42\xfc\x1b\x6a\x23\x94\x0c\xee\xc4\x34\x54\x66\x24\xcf\xc2\xf6\x25\x49\xf2\xcc\xb9\xee\xfb\x25\xde\x71\xbd\x06\x94\x0c\x36\x9b\xdd\x54\x3a\x90\x7b\x51\xe3\x08\xc1\x6d\xf6\x08\x7d\x75\x09\x13\xcb\xc1\x32\xd7\xc9\x60\x25\xe9\x32\x1b\xc7\xd8\x12\x85\x85\x1b\x81\x28\x7b\x8f\x96\x2b\x66\xf6\xb1\x43\xb9\x9a\xca\x39\xc2\xac\x86\xcb\xb4\xe7\x29\x1c\x19\xd5\x40\x43\x8b\xbf\x5d\xaf\x61\x56\x77\x74\x46\xd9\xce\x92\x24\x74\x14\xe5\x10\x21\xa3\x64\xaf\xf3\x3f\xb0\xb0\x27\x25\x7b\x8b\x25\x6a\x94\x05\x3a\x68\x9f\x81\x99\x3b\xc3\x77\x42\x32\xfc\x0c\xb3\xf8\x3d\x1a\x43\xe7\x68\x60\x56\xbf\x6c\x5d\x44\xe9\xbd\xba\x4c\x8f\x15\xd7\x7b\x85\xf2\xfa\x8d\xc3\x05\x8e\x2b\x25\xc9\xb6\x4b\x83\x66\x12\xa7\x91\x41\x27\xf9\xeb\xec\x4a\xc9\x52\xcc\x17\x3a\xe8\x96\xbf\x1e\x98\x3d\x17\x37\xd4\x72\x68\x34\x96\xe2\xf3\x25\x90\x42\x31\x7f\x07\xb8\x69\xed\xba\xf6\x8b\xb5\xcd\x8d\x77\x68\x53\x39\x68\xc0\xca\xb8\x3a\x92\x6d\xba\x24\xf1\x78\xa3\xf1\xf3\xcb\x2b\x55\x37\x1a\x8d\x1b\x53\x40\x49\xf3\x0a\xd9\x25\xec\x85\x1e\xf8\xbc\x6b\x5d\x60\xb3\x59\xa1\xe9\x83\x49\x35\x88\xb6\x1b\x66\x0f\xeb\xe3\x9d\x2f\xb5\xd3\xdd\x47\xcc\xef\x54\xf1\x80\x16\xcc\xa2\x71\x77\x16\xb2\x57\xd0\x50\xcb\x7d\x12\x13\xa7\xf6\x55\xb8\x43\xf3\x56\x0c\x43\x82\xbf\x54\x06\xfc\x02\x04\x4b\xa3\xe3\x22\xe0\x17\x59\x8f\x9b\x3b\xdc\x59\xed\x3b\xf1\x93\x90\x4c\xc8\xf9\x18\xd6\x8d\xc7\x8e\x2a\x6a\x1f\xbf\x45\xce\x43\x36\x7d\x85\xfd\x10\xcc\xf2\xd8\x15\x7f\x4b\x1f\x87\xbd\x74\x70\xd3\xd2\xeb\x94\x10\x20\x5d\xf7\xdc\x27\xc3\x04\xca\xbb\xf0\x0c\x4d\xa1\x45\xd3\x0a\xb3\xad\x6e\xef\x00\xfc\x05\x1f\x94\xae\x69\x25\xfe\xc4\xed\xde\x13\x17\x61\x1b\x7c\x4a\xa4\xf1\x2d\x7e\x5a\xa0\xb1\x2f\xe3\x9f\x05\x56\x9e\x77\xc2\xdf\x64\x37\x54\xd3\x1a\x2d\x6a\xd7\xb5\x37\xd9\x98\xb3\x9d\x1d\xbf\x6b\xdd\x08\x8e\xf7\x5b\x9b\x9e\x36\xb4\x46\x9e\xb9\x2f\x15\x49\x2c\x3f\xee\xa5\xf1\xd3\x42\x68\x64\x4f\x7b\xda\x55\x73\x02\xde\x80\xeb\xa7\x9d\x29\x63\xc2\x79\xd2\x0a\x84\x2c\x5d\x03\x8e\x9f\x23\x89\xd5\x83\x61\x2c\x0f\xdd\x92\x13\x0d\xf8\x27\x1c\x32\x3f\x2c\x65\x2f\x05\xcb\x9e\x74\x77\xf3\x58\xc6\xd7\x4d\x28\xab\xbb\x2f\xda\xbb\x23\x5c\x24\xe1\xf2\x38\x01\x6d\x56\xc6\xf7\xab\xe6\xe4\xe0\xb3\x32\x7e\xdb\xf3\x7f\xea\xa9\xc0\xa6\xf2\xd2\xee\x72\xf7\x43\xbb\xd5\xa5\x1a\xe8\xd1\x7d\xbb\x4f\xa8\xa2\xeb\xd5\x84\x72\x82\x79\x3c\xdb\x7b\xcf\x84\xe1\xe7\xe8\xb0\xd4\x4c\xa3\xa4\xc1\x3d\xad\x75\xdb\xcf\x4a\xfb\xcf\x95\x36\xe2\xff\x59\x6a\x07\x4f\xfd\x2f\xa4\x36\xf5\xdc\xd8\x3e\x67\xa7\x9f\x1b\x4e\x01\x5f\xfa\x96\x75\x67\x4e\x79\xce\x0e\x1f\x29\x47\x1f\xb3\xfc\xe2\x59\xe9\xff\x8e\xd2\x3d\xaf\xcf\x7a\xfe\xba\xf4\xdc\x7a\xb5\xff\xb0\x91\xa4\xfd\x51\x82\x24\xfe\x27\x9c\xbf\x03\x00\x00\xff\xff\xdf\x55\xe3\x4f\xd2\x11\x00\x00")
+var _htmlIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x5b\x4f\xe4\xb6\x17\x7f\xe7\x53\x9c\x7f\xfe\x79\x00\x09\x92\xc2\xee\x43\x85\x3c\x91\x5a\xd8\x55\xfb\xb0\x0b\x02\xd4\x55\x1f\x3d\xf1\xc9\xd8\xc5\x76\xb2\xb6\x07\x96\x4e\xe7\xbb\x57\x4e\x9c\xdb\x64\x76\x98\x22\x1e\xaa\x0a\x18\x09\xf9\xf8\x5c\x7f\xe7\xe2\xc3\x90\xff\x5d\x5e\x5d\xdc\xfd\x7e\xfd\x01\xb8\x53\x32\x3b\x20\xfe\x0f\x48\xaa\x17\xb3\x08\x75\xe4\x09\x48\x59\x76\x00\x00\x40\x14\x3a\x0a\x39\xa7\xc6\xa2\x9b\x45\x4b\x57\x9c\xfc\x18\x85\x2b\x27\x9c\xc4\x6c\xb5\x02\x43\xf5\x02\x21\x16\xc7\x10\x5b\x38\x9f\x41\x72\x8b\xe6\x41\xe4\x68\x61\xbd\x5e\xad\x40\x14\x10\x0b\x58\xaf\x8f\x61\xb5\x02\xd4\xac\xa1\xc6\x36\xf9\x4c\x15\x36\x87\x86\x0a\x3f\x5d\xff\x0a\xac\xcc\x97\x0a\xb5\xa3\x4e\x94\x9a\xa4\x8d\x91\x81\x2f\x9a\x2a\x9c\x45\x0f\x02\x1f\xab\xd2\xb8\x08\xf2\x52\x3b\xd4\x6e\x16\x3d\x0a\xe6\xf8\x8c\xa1\xb7\x7c\x52\x1f\x8e\x41\x68\xe1\x04\x95\x27\x36\xa7\x12\x67\xa7\xc9\x0f\xad\xef\x52\xe8\x7b\x30\x28\x67\x91\x75\x4f\x12\x2d\x47\x74\x11\x70\x83\xc5\x2c\x52\x54\xe8\x24\xb7\x36\x9a\x9a\xcd\x4b\x59\x9a\x13\x9b\x73\x54\x38\x30\x2d\xc5\x82\x3b\x60\xd4\xdc\x7b\xf4\xd2\x06\x3e\x32\x2f\xd9\x53\x50\xc1\xc4\x03\xe4\x92\x5a\x3b\x8b\xac\x60\x18\x34\x6f\x5e\xe5\xa5\x1c\xdc\xf8\x0f\xe1\xa7\xaf\x0a\xb0\x6d\xe4\x1a\x99\x85\x83\x43\x89\xba\xd7\x76\x04\xa7\xb0\x5e\xdb\x8e\x9d\xa4\xfc\x74\xec\x4f\x23\x98\xfc\x86\xc6\x8a\x52\x07\xf5\xc4\x56\x54\x67\x64\x9e\x05\xf2\x39\x49\xe7\x99\x77\x65\x93\x2f\xad\x19\x3b\xf5\xe3\x48\x5b\x25\x77\x42\xe1\x44\xc3\x9d\x18\x6a\xe8\x04\x49\xca\xc4\xc3\xe0\x38\x37\xe9\xe0\xa4\xe9\xc3\xc4\xfb\x80\xe4\x04\xc5\x11\x5f\x1d\x50\x97\x2f\xcc\x7d\x25\x46\xd9\x18\xb4\x78\x03\xb5\x11\xe0\xa0\xd0\xf1\x92\xd5\x48\x4a\xeb\x6b\xfc\x53\x4f\xd0\x6c\x4b\x24\x63\xf7\x94\x77\x2f\xb6\x49\x90\x9a\xf8\x47\x43\xa5\xfe\x7f\x64\x35\xf1\x27\xd5\x9e\xa2\x6c\x74\x24\x29\x9d\x58\xdb\x92\x86\xed\xd4\xed\x80\x5c\xcd\xff\xc0\xdc\xd9\x3d\x42\x49\x6e\xb0\x40\x83\x3a\xc7\xb6\x36\x95\x5d\xf8\x18\x0f\x85\x66\xf8\x0d\xe2\xe4\x13\x5a\x4b\x17\x68\x21\x56\x47\x7d\x51\x2b\xbb\x68\xfd\xdf\x01\x80\xe7\xfa\xb8\x94\x72\x1c\xb8\x5d\x3c\x1f\xfa\x34\x5a\x92\x76\x55\x33\x28\x2e\xe2\x47\x42\x76\x30\x8d\xee\xfb\x75\xb4\xbb\x5a\x08\x3f\x05\xc1\x66\xd1\x28\x7f\x51\x36\x3a\xb6\xcd\x5a\x77\xe1\x16\x4f\xf9\x59\x76\x51\xea\x42\x2c\x96\x26\xcc\x4a\x7e\xd6\xfb\x58\xa7\x2c\xbb\xa6\x8e\x43\x65\xb0\x10\xdf\xce\x81\xe4\x25\xc3\x50\xc6\xb1\x4d\x7e\x71\xae\xba\xae\xaf\xba\xf2\xdd\x24\x85\xe2\x4d\x3b\xeb\x24\xad\x75\x6c\xa6\xbc\x31\x76\x51\xaa\xca\xa0\xf5\x1d\x0f\xa8\xe9\x5c\x22\x3b\x0f\x38\xc4\x36\x19\xdc\x7e\x68\x2e\x61\xbd\x7e\xc2\x41\x8f\xe8\x72\x60\x67\x6c\xa0\xd3\xf2\xe5\xb6\x0e\xa9\x1d\x3a\x5f\x70\x7e\x5b\xe6\xf7\xe8\xc0\x2e\x2b\xff\x1e\x20\x3b\x86\x8a\x3a\x5e\x1b\x1e\xf1\x6f\x0e\x9f\xde\x79\x7e\x96\x85\x46\x1b\x43\xb8\x7f\x3f\xf2\x77\xd3\x6c\xee\xec\xc6\x10\x8f\x4a\x2e\xb1\x32\x98\x53\x57\xa3\x01\xc4\x2a\x2a\x65\x76\xc8\x3a\xea\x11\x49\x1b\xda\x00\x1a\xfe\x6e\xf0\x26\xcc\x7d\x05\xc6\xaa\xce\xe6\xcf\x42\x33\xa1\x17\x53\xff\x7c\x21\x8f\x7a\xb8\x99\x4f\x8d\x53\xf3\x10\x56\x0f\x52\x5f\x29\xf1\x3c\xf1\x78\xdf\xd0\xc7\x61\xf2\xbd\xba\x1e\xc8\xd6\xca\x20\x4f\x2a\xa8\xf4\x49\xf7\x6f\xb9\x5d\xaf\x47\xe6\x19\xda\xdc\x88\xaa\x9b\xab\x53\x01\xf8\x0b\x3e\x97\x46\x51\x29\xfe\xc4\x8e\xb6\x25\x8b\x53\xe3\x87\x71\x72\x83\x5f\x97\x68\x9d\x9f\x25\xc9\x47\x81\x92\x79\x44\x08\x7f\x9f\x5d\x53\x43\x15\x3a\x34\x96\xa4\xfc\x7d\x36\x05\x69\x44\xf1\x1f\xe2\x7c\xa9\x8e\x39\xdb\x1f\xe2\xcc\xf6\x0b\xff\x4b\x1c\xcf\xfc\xaa\x42\x52\xc7\x77\x73\x19\xfc\xba\x14\x06\xd9\xf3\x9c\xee\xa9\xda\x43\xdf\x00\xdc\xe7\x99\x29\x63\xc2\xa7\x81\x4a\x10\xba\xf0\x88\xef\x96\x23\xa9\x33\x83\xea\x2b\x7c\xf5\x7d\x0f\xf1\x97\x80\xc6\xbc\xf2\xb8\x68\xfb\x84\xa4\x2e\x2c\xa2\x3b\xd8\xfd\x7c\x29\x92\xab\x2a\xc4\xd1\x0e\x92\x66\x76\x85\x09\xa3\xd9\xbe\xda\xe2\x22\xb9\x7b\xaa\xf6\x36\x1e\x17\xc9\x65\x0f\xf8\xbe\x52\x01\xbe\xd2\xc3\xd7\xf9\xee\x31\xeb\x3b\xaf\x1c\x74\x9c\xdf\x6b\xf6\x88\xa2\x4d\xce\x96\xde\x08\xd7\xd3\x62\xde\x58\xa1\x3a\xe9\x41\x33\xd9\xaa\xd4\x16\xa7\xdd\xd4\xde\xbc\xf5\xd2\x2b\xf7\xd2\x36\xc0\x5f\x82\xda\x5b\x33\xfd\x7b\x9a\x69\x43\x4d\x4f\xe9\x48\x84\x9f\xf5\x2b\xf5\xf6\x3d\xc4\x3f\x28\xff\x74\x9f\xf6\x32\xfb\xac\xd4\x83\x1d\xe6\xb9\x85\x7a\xa0\xea\x85\xfb\xcb\xdb\xb4\x78\x95\x69\x51\xa7\xe0\x6d\x44\xfc\xf7\x46\x04\x49\x9b\xff\x38\x49\xda\x7c\x89\x44\x52\xee\x94\xcc\xfe\x1e\x00\x9f\x84\x60\xc1\xba\x13\x00\x00")
 
 func htmlIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -114,12 +114,12 @@ func htmlIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "html/index.html", size: 4562, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "html/index.html", size: 5050, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _htmlMainCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x5d\x6e\xe2\x30\x10\x7e\xe7\x14\x23\x55\x55\x41\x25\x28\x84\x96\x55\xdd\x97\x3d\xc3\xde\xc0\xc4\x93\xd8\xaa\xe3\x89\x6c\x03\x65\x57\xbd\xfb\xca\xb1\x01\x03\xa5\x74\xb5\xf8\x25\x78\xfe\xbe\x6f\xe6\x1b\x33\x4b\xe4\xe1\xcf\x08\x00\xa0\x26\x4d\xb6\x70\xb5\xc4\x0e\x19\x68\xd5\x4a\x0f\x82\xdb\xb7\xd7\xd1\xc7\x68\xf4\xb3\x43\xa1\x38\x8c\x7b\x8b\x0d\x5a\x57\x9c\x3a\x07\xb7\x49\x4a\x93\xa7\x0c\xbf\xa2\x58\xf1\xfa\xad\xb5\xb4\x36\x22\x86\x31\xb8\x5b\x54\xe1\xbc\x66\x4e\x4e\x09\x3c\x98\xab\xe7\x70\x72\xb3\xc7\x77\x7f\x30\x0b\x21\x72\x9b\x54\xad\x1c\xe0\x32\xb8\x5b\x2e\x97\xb9\xa9\xb7\xaa\xe3\x76\xc7\x40\x3a\x3d\x9e\x57\x53\x78\x29\xef\xa7\xb0\x2c\xef\x27\xd1\xeb\xe3\x36\xb7\x21\xf3\x3f\x91\x6b\x30\x9c\xab\xe4\x96\x55\x38\x57\xc9\x55\x55\x75\x8d\x5c\x2d\xc2\xf9\x92\xdf\x8f\xc0\xef\xe9\x94\xdf\x8a\xc4\x2e\x61\xbe\x44\xbb\xe1\x76\x7c\xc9\x22\x85\x9f\xf8\x1c\x51\x26\x6b\xc7\x6d\xab\x0c\x83\x32\xfe\xed\xb9\x10\xca\xb4\xc3\xff\xe1\x42\x28\xd7\x6b\xbe\x63\xd0\x68\x7c\x8f\x3e\xe1\xab\x10\xca\x62\xed\x15\x19\x06\x96\xb6\xc9\xb9\x21\xe3\x8b\x86\x77\x4a\xef\x18\x3c\xfc\xa2\x15\x79\x7a\x98\x82\xe3\xc6\x15\x0e\xad\x6a\x06\x19\xce\x6a\xd2\x89\xca\x37\xb2\xd7\xa4\xd7\x9d\x89\x81\x61\x04\x37\x9a\x70\x9c\xd2\xe4\x8c\xd1\x1c\xbb\x78\x23\x31\x8e\xa2\xe6\xba\x1e\xcf\xcb\x72\x23\xa1\x80\x0a\xbb\x14\x40\x1b\xb4\x8d\xa6\x2d\x03\xa9\x84\x40\x03\x7c\xed\x69\xa8\xdf\x71\x65\x52\xf9\x1b\x39\x2e\x8b\x5e\x63\xda\xda\x50\x69\xfe\x35\xf7\x1c\x56\xb1\x63\x67\x88\x64\x35\x85\xf8\xb1\xc8\x5f\x81\x7d\x47\x92\xbc\x26\x79\x00\x3c\x82\x5c\x1c\xa3\x1e\x41\x3e\xa5\xd0\xa8\x87\xc2\x53\x3f\x68\x60\x1f\x32\xeb\xd0\x4b\x12\xc9\x69\x98\xf3\x36\xb5\x60\x45\x3a\xc9\x79\x10\x97\xb7\xdc\xb8\x86\x6c\xc7\x60\xdd\xf7\x68\x6b\xee\x30\xcb\x23\xd0\xd5\x56\xf5\x81\xdf\x49\x45\x06\xb3\x67\xec\xf2\x92\x9e\xaf\xf4\x61\xda\x64\x05\xda\x30\x57\xcd\x7b\x87\x43\x67\x86\xaf\xd7\xdc\xec\x7a\x5e\xef\xc5\x7b\x9a\xc5\xcb\x1c\xb9\x53\xbf\x91\x41\x39\x7b\xa9\x9e\xf7\xe3\x49\x19\x56\xe4\x3d\x75\x0c\xe6\xfd\x3b\x38\xd2\x4a\xa4\x1e\x1e\x16\x38\xcd\x77\x83\xd6\xab\x9a\xeb\x82\x6b\xd5\x9a\xd0\x83\x10\x97\x75\x21\xdd\x6b\x6c\x7c\xbc\xd5\xca\x60\xb1\x57\xcd\x7c\xb6\x3c\xd1\x49\x61\xd3\x7d\x80\x73\x86\x7c\xdf\xf3\xcf\xd3\x9e\x03\xf1\xd4\x7f\x55\xef\x64\x70\x8b\xb2\xfc\xce\x0b\x71\x05\x63\x5c\x46\xc3\x37\xff\xb1\xca\x33\x17\x4d\x97\xc3\xd1\xdc\xb6\xf8\x09\xe6\xa3\xd8\x0e\xb2\x59\x04\xd9\x84\x73\x06\xcb\xf5\x7c\x9f\xf8\xb0\x8e\xb3\xea\xa0\xb1\xe8\xc8\x93\xc7\x56\x09\x2f\x19\xcc\xcb\xf2\xfe\x6c\x83\x43\xfe\xef\xb4\x69\xb8\x10\x58\x93\xe5\x91\xad\x21\x83\x79\x25\x26\xc3\x0a\xdf\x78\xbe\x72\xa1\x7d\x8c\xfe\x06\x00\x00\xff\xff\x68\xfa\x75\x59\xda\x07\x00\x00")
+var _htmlMainCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xdb\x6e\xe2\x30\x10\x7d\xe7\x2b\x46\xaa\xaa\x82\x4a\xa2\x10\x5a\x56\x35\x2f\xfb\x0d\xfb\x07\x26\x9e\xc4\x56\x7d\x89\x6c\x03\x65\x51\xff\x7d\xe5\xd8\x40\x42\x0a\xed\x6a\x5e\x1c\xcf\xed\x9c\x99\xe3\x10\x6b\x8c\x87\xe3\x04\x00\xa0\x32\xd2\xd8\xcc\x55\x1c\x15\x12\x90\xa2\xe1\x1e\x18\xb5\xef\xeb\xc9\xe7\x64\xf2\x5b\x21\x13\x14\xa6\xad\xc5\x1a\xad\xcb\x86\xc1\x21\x6c\x96\xca\xf4\x4b\x06\xcb\xb2\x0d\xad\xde\x1b\x6b\xb6\x9a\xc5\x34\x02\x0f\xcb\x32\xd8\xba\x17\xe4\x04\xc3\xb3\xbb\x7c\x0d\xd6\x77\x7b\xfc\xf0\x67\x37\x63\xac\xef\xe3\xa2\xe1\x1d\x5c\x02\x0f\xab\xd5\xaa\xef\x6a\xad\x50\xd4\x1e\x08\x70\x27\xa7\x8b\x72\x0e\x6f\xc5\xe3\x1c\x56\xc5\xe3\x2c\x46\x7d\x7e\xcf\xad\xab\xfc\x5f\xe4\x6a\x0c\x76\x93\xdc\xaa\x0c\x76\x93\x5c\x59\x96\xb7\xc8\x55\x2c\xd8\x5d\x7e\xbf\x02\xbf\x97\x21\xbf\x8d\x61\x87\x84\x79\x8c\x76\x47\xed\x74\xcc\x22\xa5\x0f\x62\x2e\x28\x93\x57\x51\xdb\x08\x4d\xa0\x88\x9f\x2d\x65\x4c\xe8\xa6\xfb\xee\x2e\x98\x70\xad\xa4\x07\x02\xb5\xc4\x8f\x18\x13\x4e\x19\x13\x16\x2b\x2f\x8c\x26\x60\xcd\x3e\x05\xd7\x46\xfb\xac\xa6\x4a\xc8\x03\x81\xa7\x3f\x66\x63\xbc\x79\x9a\x83\xa3\xda\x65\x0e\xad\xa8\x3b\x19\xe6\x95\x91\x70\xfc\x69\xf5\xca\xc8\xad\xd2\x31\x31\xac\xe0\x9b\x21\x5c\xb6\x34\xbb\x62\xb4\x40\x15\x6f\x38\xc6\x55\x54\x54\x56\xd3\x45\x51\xec\x38\x64\x50\xa2\x4a\x09\x66\x87\xb6\x96\x66\x4f\x80\x0b\xc6\x50\x03\xdd\x7a\xd3\xf5\x57\x54\x68\x38\xfe\xa4\xc6\xb8\xe9\x2d\xa6\x8d\x0d\x9d\x16\xf7\xb9\xf7\x61\x65\x07\x72\x85\x88\x97\x73\x88\x87\x25\x1c\xc7\x2b\x4f\xf2\x9a\xf5\x13\xe0\x19\xf8\xf2\x92\xf5\x0c\xfc\x25\xa5\x46\x3d\x64\xde\xb4\x9d\x06\x4e\x29\xb9\x42\xcf\x0d\x4b\x41\xdd\x9e\xf7\x69\x04\x1b\x23\x93\x9c\x3b\x71\x79\x4b\xb5\xab\x8d\x55\x04\xb6\x6d\x8b\xb6\xa2\x0e\x7b\x75\x18\xba\xca\x8a\x36\xf0\x1b\x74\x24\x90\xbf\xa2\xea\xb7\xf4\x74\x23\xcf\xdb\x36\x96\xa1\x0d\x7b\x95\xb4\x75\xd8\x4d\xa6\x3b\xad\xfb\x6e\xd7\xd2\xea\x24\xde\x61\x15\xcf\x53\xa1\x0e\xb9\x13\x7f\x91\x40\x91\xbf\x95\xaf\xa8\x06\x15\x36\xc6\x7b\xa3\x08\x2c\xda\x0f\x70\x46\x0a\x96\x66\x78\x7e\xc0\x69\xbf\x3b\xb4\x5e\x54\x54\x66\x54\x8a\x46\x13\x88\x79\xbd\x29\xa4\x7b\x89\xb5\x8f\xb7\x52\x68\xcc\x4e\xaa\x59\xe4\xab\x81\x4e\x32\x9b\xee\x51\x8d\x90\x9f\x66\xfe\x75\xd9\x6b\x20\xde\xb4\xf7\xfa\x0d\x16\xb7\x2c\x8a\xf5\x58\x2e\xa3\x3f\xc4\x0d\x8c\x79\x78\x69\xa0\xe9\x0e\x8e\x77\x05\x7e\xef\x29\xe7\x2e\x2a\x7d\xbc\x1c\x49\x6d\x83\x5f\x60\xbe\x88\xed\x2c\x9b\x65\x90\x4d\xb0\x2b\x58\xae\xa5\xa7\xc2\x89\x02\x81\xbc\x3c\x6b\x2c\x22\xa0\x29\x62\x2f\x98\xe7\x04\x16\x45\xf1\x38\x60\x1d\xeb\xff\x64\x4c\xdd\xdc\x18\x56\xc6\xd2\x40\x89\x80\x36\x1a\xfb\x9d\x08\x0f\x4f\xf8\x9b\xdf\x57\x5f\x68\x9f\x93\x7f\x03\x00\x68\xfa\x75\x59\xda\x07\x00\x00")
 
 func htmlMainCssBytes() ([]byte, error) {
 	return bindataRead(
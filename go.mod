@@ -4,6 +4,7 @@ go 1.22.4
 
 require (
 	github.com/alecthomas/participle/v2 v2.1.1
+	github.com/bufbuild/protocompile v0.14.1
 	github.com/davecgh/go-spew v1.1.1
 	github.com/iancoleman/strcase v0.3.0
 	github.com/pkg/errors v0.9.1
@@ -13,6 +14,10 @@ require (
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/mod v0.22.0
 	golang.org/x/text v0.21.0
+	// pinned: protoc-gen-go runs in-process through its internal_gengo package,
+	// which has no compatibility guarantee. Update it deliberately and
+	// regenerate proto/testdata/golden with `HAWK_GENERATE=1 go test ./proto`.
+	google.golang.org/protobuf v1.36.6
 	gopkg.in/yaml.v3 v3.0.1
 )
 
@@ -20,6 +25,7 @@ require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/kr/pretty v0.2.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
+	golang.org/x/sync v0.10.0 // indirect
 	golang.org/x/sys v0.21.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
 )
@@ -6,7 +6,9 @@ import (
 	"github.com/niiigoo/hawk/kit/http"
 	"github.com/niiigoo/hawk/proto"
 	"github.com/pkg/errors"
+	"go/token"
 	"io"
+	"strings"
 	"text/template"
 )
 
@@ -19,6 +21,11 @@ type Config struct {
 	PBPackage   string
 	Version     string
 	VersionDate string
+	// ProtoHash identifies the proto files the service is generated from
+	ProtoHash string
+	// ProtoRevision is the git revision of the proto files, the ProtoHash if
+	// they are not committed
+	ProtoRevision string
 
 	PreviousFiles map[string]io.Reader
 }
@@ -37,30 +44,111 @@ type Data struct {
 	ImportPath string
 	// import path for .pb.go files containing service structs
 	PBImportPath string
-	// PackageName is the name of the package containing the service definition
+	// PackageName is the name of the proto package containing the service definition
 	PackageName string
 	// GRPC/Proto service, with all parameters and return values accessible
 	Service *proto.Service
+	// import path and name of the package containing the endpoints and
+	// transports of Service
+	SvcImportPath string
+	SvcPackage    string
+	// import path and name of the package containing the handlers of Service
+	HandlersImportPath string
+	HandlersPackage    string
+	// Alias prefixes the names of the imports and variables of Service in the
+	// files shared by all services, it is empty for a single service
+	Alias string
+	// Services contains the data of all services, used to render the files
+	// shared by them (e.g. `svc/server/run.go`)
+	Services []*Data
 	// A helper struct for generating http transport functionality.
 	HTTPHelper *http.Helper
 	// Helper functions used within the templates
 	FuncMap template.FuncMap
 
-	Version     string
-	VersionDate string
+	Version       string
+	VersionDate   string
+	ProtoHash     string
+	ProtoRevision string
 }
 
 func NewData(svc *proto.Service, conf Config) *Data {
-	return &Data{
-		ImportPath:   conf.GoPackage,
-		PBImportPath: conf.PBPackage,
-		PackageName:  conf.PBPackage,
-		Service:      svc,
-		HTTPHelper:   http.NewHelper(svc),
-		FuncMap:      FuncMap,
-		Version:      conf.Version,
-		VersionDate:  conf.VersionDate,
+	data := &Data{
+		ImportPath:         conf.GoPackage,
+		PBImportPath:       conf.PBPackage,
+		PackageName:        svc.Package,
+		Service:            svc,
+		SvcImportPath:      conf.GoPackage + "/svc",
+		SvcPackage:         "svc",
+		HandlersImportPath: conf.GoPackage + "/handlers",
+		HandlersPackage:    "handlers",
+		HTTPHelper:         http.NewHelper(svc),
+		FuncMap:            FuncMap,
+		Version:            conf.Version,
+		VersionDate:        conf.VersionDate,
+		ProtoHash:          conf.ProtoHash,
+		ProtoRevision:      conf.ProtoRevision,
+	}
+	data.Services = []*Data{data}
+	return data
+}
+
+// NewServicesData returns the data of each service and the data of the files
+// shared by all services. A single service is generated into the packages
+// `svc` and `handlers`, multiple services into sub-packages named by
+// ServicePackage.
+func NewServicesData(services []*proto.Service, conf Config) (*Data, []*Data) {
+	if len(services) == 1 {
+		data := NewData(services[0], conf)
+		return data, data.Services
+	}
+
+	all := make([]*Data, len(services))
+	for i, svc := range services {
+		data := NewData(svc, conf)
+		data.SvcPackage = ServicePackage(svc)
+		data.SvcImportPath += "/" + data.SvcPackage
+		data.HandlersPackage = data.SvcPackage
+		data.HandlersImportPath += "/" + data.HandlersPackage
+		data.Alias = data.SvcPackage
+		all[i] = data
+	}
+
+	shared := *all[0]
+	shared.SvcImportPath = conf.GoPackage + "/svc"
+	shared.SvcPackage = "svc"
+	shared.HandlersImportPath = conf.GoPackage + "/handlers"
+	shared.HandlersPackage = "handlers"
+	shared.Alias = ""
+	shared.Services = all
+	for _, data := range all {
+		data.Services = all
+	}
+
+	return &shared, all
+}
+
+// reservedPackages cannot be used as names of the packages of a service, they
+// are either treated specially by go or used by hawk (`svc/server`).
+var reservedPackages = map[string]bool{
+	"internal": true,
+	"server":   true,
+	"testdata": true,
+	"vendor":   true,
+}
+
+// ServicePackage returns the name of the packages generated for svc if
+// multiple services are generated: the lower case name without the
+// suffix "service". The suffix "svc" is appended to reserved names.
+func ServicePackage(svc *proto.Service) string {
+	name := strings.ToLower(svc.Name)
+	if trimmed := strings.TrimSuffix(name, "service"); trimmed != "" {
+		name = trimmed
+	}
+	if token.IsKeyword(name) || reservedPackages[name] {
+		name += "svc"
 	}
+	return name
 }
 
 // ApplyTemplate applies the passed template with the Data
@@ -350,9 +350,11 @@ func TestPruneDecls(t *testing.T) {
 
 func TestUpdatePBFieldType(t *testing.T) {
 	values := []string{
-		`*pb.Old`, "New", "*pb.New",
-		`pb.Old`, "New", "pb.New",
-		`Old`, "New", "Old",
+		`*pb.Old`, "pb.New", "*pb.New",
+		`pb.Old`, "pb.New", "pb.New",
+		`*pb.Old`, "pb.Outer_Inner", "*pb.Outer_Inner",
+		`*pb.Old`, "commonpb.Page", "*commonpb.Page",
+		`Old`, "pb.New", "Old",
 	}
 	for i := 0; i < len(values); i += 3 {
 		exp, err := parser.ParseExpr(values[i])
@@ -549,3 +551,293 @@ func parseFuncFromString(f string, t *testing.T) *ast.FuncDecl {
 	}
 	return fnc
 }
+
+func TestUpdateSignatures(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+
+		package general;
+
+		message RequestMessage {
+			string input = 1;
+		}
+
+		message ResponseMessage {
+			string output = 1;
+		}
+
+		service Proto {
+			rpc ProtoMethod (RequestMessage) returns (ResponseMessage);
+		}
+	`
+	p := parser2.NewService()
+	err := p.ParseString(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := p.Definition().Services[0]
+	method := svc.Methods[0]
+	conf := generic.Config{
+		GoPackage: "github.com/niiigoo/hawk/kit/gengokit",
+		PBPackage: "github.com/niiigoo/hawk/kit/gengokit/general-service",
+	}
+	te := generic.NewData(svc, conf)
+
+	const prev = `
+		package handlers
+
+		import (
+			"context"
+
+			pb "github.com/niiigoo/hawk/kit/gengokit/general-service"
+		)
+
+		type protoService struct{}
+
+		// ProtoMethod implements Service.
+		func (s protoService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
+			return &pb.ResponseMessage{Output: in.Input}, nil
+		}
+	`
+
+	// unary -> server stream
+	method.ResponseStream = true
+	serverStream, err := renderService(svc, prev, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"// ProtoMethod implements Service.\nfunc (s protoService) ProtoMethod(in *pb.RequestMessage, stream pb.Proto_ProtoMethodServer) error {",
+		"// TODO: ProtoMethod is a server-streaming method now, port the previous implementation:",
+		"// func (s protoService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {",
+	} {
+		if !strings.Contains(serverStream, want) {
+			t.Fatalf("Rewritten handler does not contain %q:\n%s", want, serverStream)
+		}
+	}
+	for _, line := range strings.Split(serverStream, "\n") {
+		if strings.Contains(line, "in.Input") && !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			t.Fatalf("Previous implementation not commented out:\n%s", serverStream)
+		}
+	}
+	if strings.Contains(serverStream, `"context"`) {
+		t.Fatalf("Unused import of context not removed:\n%s", serverStream)
+	}
+
+	// unchanged signature is kept
+	again, err := renderService(svc, serverStream, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != serverStream {
+		t.Fatal("Generated service differs after regenerated with same definition\n" +
+			diff(serverStream, again))
+	}
+
+	// server stream -> bidi stream
+	method.RequestStream = true
+	bidiStream, err := renderService(svc, serverStream, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(bidiStream, "func (s protoService) ProtoMethod(stream pb.Proto_ProtoMethodServer) error {") ||
+		!strings.Contains(bidiStream, "// TODO: ProtoMethod is a bidirectional streaming method now") {
+		t.Fatalf("Handler not rewritten to bidirectional stream:\n%s", bidiStream)
+	}
+
+	// bidi stream -> unary
+	method.RequestStream, method.ResponseStream = false, false
+	unary, err := renderService(svc, bidiStream, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(unary, "func (s protoService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {") {
+		t.Fatalf("Handler not rewritten to unary:\n%s", unary)
+	}
+	if !strings.Contains(unary, `"context"`) {
+		t.Fatalf("Import of context not added:\n%s", unary)
+	}
+}
+
+func TestFuncSignature(t *testing.T) {
+	tests := map[string]signature{
+		"func (s fooService) M(ctx context.Context, in *pb.Req) (*pb.Res, error) {}":        signatureUnary,
+		"func (s fooService) M(in *pb.Req, stream pb.Foo_MServer) error {}":                 signatureServerStream,
+		"func (s fooService) M(stream pb.Foo_MServer) error {}":                             signatureStream,
+		"func (s fooService) M(stream grpc.ClientStreamingServer[pb.Req, pb.Res]) error {}": signatureClientStream,
+		"func (s fooService) M(stream grpc.BidiStreamingServer[pb.Req, pb.Res]) error {}":   signatureBidiStream,
+		"func (s fooService) M() {}": signatureUnknown,
+	}
+	for code, want := range tests {
+		if got := funcSignature(parseFuncFromString("package p\n"+code, t)); got != want {
+			t.Errorf("%s: got %s, want %s", code, got, want)
+		}
+	}
+	if !signatureStream.matches(signatureClientStream) || !signatureStream.matches(signatureBidiStream) {
+		t.Error("Stream alias should match client and bidirectional streams")
+	}
+}
+
+func TestRenderRemoved(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+
+		package general;
+
+		message RequestMessage {
+			string input = 1;
+		}
+
+		message ResponseMessage {
+			string output = 1;
+		}
+
+		service Proto {
+			rpc Renamed (RequestMessage) returns (ResponseMessage);
+			rpc Other (ResponseMessage) returns (RequestMessage);
+		}
+	`
+	p := parser2.NewService()
+	err := p.ParseString(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := p.Definition().Services[0]
+
+	const prev = `package handlers
+
+import (
+	"context"
+
+	pb "github.com/niiigoo/hawk/kit/gengokit/general-service"
+)
+
+type protoService struct{}
+
+// ProtoMethod implements Service.
+func (s protoService) ProtoMethod(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {
+	// business logic
+	return &pb.ResponseMessage{Output: in.Input}, nil
+}
+`
+
+	render := func(detectRenames bool) (string, Handler) {
+		h, err := New(svc, strings.NewReader(prev))
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.SetDetectRenames(detectRenames)
+		code, err := h.Render(generic.NewData(svc, generic.Config{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		codeBytes, err := io.ReadAll(code)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(codeBytes), h
+	}
+
+	// removed
+	code, h := render(false)
+	if strings.Contains(code, "ProtoMethod") || strings.Contains(code, "business logic") {
+		t.Fatalf("Removed handler still contained:\n%s", code)
+	}
+	if got := h.Removed(); len(got) != 1 || got[0] != "ProtoMethod" {
+		t.Fatalf("Removed funcs: got %v, want [ProtoMethod]", got)
+	}
+	removed, err := h.RenderRemoved(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	removedBytes, err := io.ReadAll(removed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"//go:build ignore\n\npackage handlers\n",
+		`pb "github.com/niiigoo/hawk/kit/gengokit/general-service"`,
+		"// ProtoMethod implements Service.\nfunc (s protoService) ProtoMethod(",
+		"// business logic",
+	} {
+		if !strings.Contains(string(removedBytes), want) {
+			t.Fatalf("Removed file does not contain %q:\n%s", want, removedBytes)
+		}
+	}
+
+	// appended to the previous file
+	removed, err = h.RenderRemoved(strings.NewReader(string(removedBytes)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendedBytes, err := io.ReadAll(removed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(appendedBytes), "func (s protoService) ProtoMethod("); n != 2 {
+		t.Fatalf("Removed func not appended, found %d times:\n%s", n, appendedBytes)
+	}
+
+	// renamed, the types only match Renamed
+	code, h = render(true)
+	if !strings.Contains(code, "func (s protoService) Renamed(ctx context.Context, in *pb.RequestMessage) (*pb.ResponseMessage, error) {\n\t// business logic") {
+		t.Fatalf("Handler not renamed:\n%s", code)
+	}
+	if strings.Count(code, "func (s protoService) Renamed(") != 1 {
+		t.Fatalf("Handler of renamed method generated:\n%s", code)
+	}
+	if removed, err = h.RenderRemoved(nil); removed != nil || err != nil {
+		t.Fatalf("Renamed handler reported as removed: %v", h.Removed())
+	}
+}
+
+func TestFuncMatches(t *testing.T) {
+	method := &parser2.Method{Name: "New", Request: "Req", Response: "Res", ResponseStream: true}
+	tests := map[string]bool{
+		"func (s fooService) Old(in *pb.Req, stream pb.Foo_OldServer) error {}":        true,
+		"func (s fooService) Old(in *pb.Other, stream pb.Foo_OldServer) error {}":      false,
+		"func (s fooService) Old(ctx context.Context, in *pb.Req) (*pb.Res, error) {}": false,
+	}
+	for code, want := range tests {
+		if got := funcMatches(parseFuncFromString("package p\n"+code, t), method); got != want {
+			t.Errorf("%s: got %v, want %v", code, got, want)
+		}
+	}
+
+	f := parseFuncFromString("package p\nfunc (s fooService) Old(in *pb.Req, stream pb.Foo_OldServer) error {}", t)
+	renameStream(f, "Foo", "New")
+	if got := exprString(f.Type.Params.List[1].Type); got != "pb.Foo_NewServer" {
+		t.Errorf("Stream not renamed: got %s", got)
+	}
+}
+
+func TestAddImports(t *testing.T) {
+	imports := map[string]string{"commonpb": "example.com/common", "emptypb": "google.golang.org/protobuf/types/known/emptypb"}
+	tests := []struct {
+		code string
+		want string
+	}{
+		{
+			code: "package p\n\nimport (\n\tpb \"example.com/pb\"\n)\n",
+			want: "package p\n\nimport (\n\tcommonpb \"example.com/common\"\n\tpb \"example.com/pb\"\n\temptypb \"google.golang.org/protobuf/types/known/emptypb\"\n)\n",
+		},
+		{
+			code: "package p\n\nimport (\n\tcommonpb \"example.com/common\"\n\temptypb \"google.golang.org/protobuf/types/known/emptypb\"\n)\n",
+			want: "package p\n\nimport (\n\tcommonpb \"example.com/common\"\n\temptypb \"google.golang.org/protobuf/types/known/emptypb\"\n)\n",
+		},
+		{
+			code: "package p\n\nvar x = 1\n",
+			want: "package p\n\nimport (\n\tcommonpb \"example.com/common\"\n\temptypb \"google.golang.org/protobuf/types/known/emptypb\"\n)\n\nvar x = 1\n",
+		},
+	}
+	for _, test := range tests {
+		got, err := addImports([]byte(test.code), imports)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != test.want {
+			t.Errorf("addImports(%q) = %q, want %q", test.code, got, test.want)
+		}
+	}
+}
@@ -6,15 +6,19 @@ package handlers
 
 import (
 	"bytes"
+	"github.com/iancoleman/strcase"
 	"github.com/niiigoo/hawk/kit/generic"
 	"github.com/niiigoo/hawk/kit/template"
 	protoParser "github.com/niiigoo/hawk/proto"
 	log "github.com/sirupsen/logrus"
 	"go/ast"
+	"go/format"
 	"go/parser"
 	"go/printer"
 	"go/token"
 	"io"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -28,9 +32,9 @@ const ServerHandlerPath = "handlers/handlers.go.tpl"
 // ServerHandlerMethodsPath is the relative path to the server handler method template file
 const ServerHandlerMethodsPath = "handlers/handlers.methods.go.tpl"
 
-// New returns a hawk.Renderable capable of updating server handlers.
+// New returns a Handler capable of updating server handlers.
 // The previous version of the server handler should be provided.
-func New(svc *protoParser.Service, prev io.Reader) (generic.Renderable, error) {
+func New(svc *protoParser.Service, prev io.Reader) (Handler, error) {
 	var h handler
 	log.WithField("Service Methods", len(svc.Methods)).Debug("Handler being created")
 	h.mMap = newMethodMap(svc.Methods)
@@ -40,9 +44,11 @@ func New(svc *protoParser.Service, prev io.Reader) (generic.Renderable, error) {
 		return &h, nil
 	}
 
-	h.fileSet = token.NewFileSet()
-	var err error
-	if h.ast, err = parser.ParseFile(h.fileSet, "", prev, parser.ParseComments); err != nil {
+	src, err := io.ReadAll(prev)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.parse(src); err != nil {
 		return nil, err
 	}
 
@@ -72,6 +78,12 @@ type handler struct {
 	// 'handlers/handlers.go'. If the 'handlers/handlers.go' file does not
 	// exist, then ast will be nil.
 	ast *ast.File
+	// The source of ast.
+	src []byte
+	// The funcs removed from ast by Render.
+	removed []removedFunc
+	// Whether to rename the handlers of renamed methods.
+	detectRenames bool
 }
 
 type handlerData struct {
@@ -89,13 +101,26 @@ func (h *handler) Render(data *generic.Data) (io.Reader, error) {
 		return applyServerTpl(data)
 	}
 
+	// Lowercase the service name because the templates all lowercase the
+	// service name when generating code to ensure Identifiers incorporating
+	// the service name remain unexported.
+	svcName := strcase.ToLowerCamel(data.Service.Name)
+
+	// Rewrite the handlers switched between unary and streaming
+	if err := h.updateSignatures(svcName); err != nil {
+		return nil, err
+	}
+
+	if h.detectRenames {
+		h.renameFuncs(svcName)
+	}
+
 	// Remove exported methods not defined in service definition
 	// and remove methods defined in the previous file from methodMap
 	log.WithField("Service Methods", len(h.mMap)).Debug("Before prune")
-	// Lowercase the service name before pruning because the templates all
-	// lowercase the service name when generating code to ensure Identifiers
-	// incorporating the service name remain unexported.
-	h.ast.Decls = h.mMap.pruneDecls(h.ast.Decls, strings.ToLower(data.Service.Name))
+	decls := h.ast.Decls
+	h.ast.Decls = h.mMap.pruneDecls(decls, svcName)
+	h.removeFuncs(decls, h.ast.Decls)
 	log.WithField("Service Methods", len(h.mMap)).Debug("After prune")
 
 	// create a new handlerData, and add all methods not defined in the existing 'handlers/handlers.go' file
@@ -105,7 +130,11 @@ func (h *handler) Render(data *generic.Data) (io.Reader, error) {
 
 	// If there are no methods to template then exit early
 	if len(h.mMap) == 0 {
-		return h.buffer()
+		code, err := h.buffer()
+		if err != nil {
+			return nil, err
+		}
+		return h.fixImports(code)
 	}
 
 	for k, v := range h.mMap {
@@ -131,7 +160,66 @@ func (h *handler) Render(data *generic.Data) (io.Reader, error) {
 		return nil, err
 	}
 
-	return code, nil
+	return h.fixImports(code)
+}
+
+// fixImports fixes the import of context in code, see fixContextImport, and
+// adds the packages of the requests and responses declared in other Go
+// packages. Unused imports are removed when the file is written.
+func (h *handler) fixImports(code *bytes.Buffer) (io.Reader, error) {
+	fixed, err := fixContextImport(code.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	fixed, err = addImports(fixed, h.service.GoImports())
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(fixed), nil
+}
+
+// addImports adds the imports, the import paths keyed by their alias, which
+// are missing in code to its first import declaration.
+func addImports(code []byte, imports map[string]string) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", code, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	imported := make(map[string]bool)
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err == nil {
+			imported[path] = true
+		}
+	}
+
+	aliases := make([]string, 0, len(imports))
+	for alias, path := range imports {
+		if !imported[path] {
+			aliases = append(aliases, alias)
+		}
+	}
+	if len(aliases) == 0 {
+		return code, nil
+	}
+	slices.Sort(aliases)
+	specs := ""
+	for _, alias := range aliases {
+		specs += "\n\t" + alias + " " + strconv.Quote(imports[alias])
+	}
+
+	// the offset the imports are added at
+	offset := fileSet.Position(file.Name.End()).Offset
+	insert := "\n\nimport (" + specs + "\n)"
+	for _, d := range file.Decls {
+		if gen, _ := d.(*ast.GenDecl); gen != nil && gen.Tok == token.IMPORT && gen.Lparen.IsValid() {
+			offset = fileSet.Position(gen.Lparen).Offset + 1
+			insert = specs
+			break
+		}
+	}
+	code = append(code[:offset:offset], append([]byte(insert), code[offset:]...)...)
+	return format.Source(code)
 }
 
 func (h *handler) buffer() (*bytes.Buffer, error) {
@@ -183,39 +271,48 @@ func (m methodMap) pruneDecls(decls []ast.Decl, svcName string) []ast.Decl {
 	return newDecls
 }
 
-// updateParams updates the second param of f to be `X`.{m.RequestType.Name}.
+// updateParams updates the second param of f to be the Go type of the request.
 // For example, this function signature:
 //
 //	func ProtoMethod(ctx context.Context, *pb.Old)
 //
 // will become the following kind of function signature, where the old input type is
-// replaced by the new input type defined in m.RequestType.Name:
+// replaced by the new input type returned by m.GoRequest:
 //
-//	func ProtoMethod(ctx context.Context, *pb.{m.RequestType.Name})...
+//	func ProtoMethod(ctx context.Context, *pb.{m.GoRequest})...
 //
-// TODO: Right now it does not support changing the signature from/to stream types.
+// The first param of server-streaming methods is updated the same way. Changes
+// from/to stream types are handled by updateSignatures beforehand.
 func updateParams(f *ast.FuncDecl, m *protoParser.Method) {
-	if !m.RequestStream && !m.ResponseStream {
-		if f.Type.Params.NumFields() != 2 {
+	switch methodSignature(m) {
+	case signatureUnary:
+		if f.Type.Params.NumFields() != 2 || len(f.Type.Params.List) != 2 {
 			log.WithField("Function", f.Name.Name).
 				Warn("Function params signature should be func NAME(ctx context.Context, in *pb.TYPE), cannot fix")
 			return
 		}
-		updatePBFieldType(f.Type.Params.List[1].Type, m.Request)
+		updatePBFieldType(f.Type.Params.List[1].Type, m.GoRequest())
+	case signatureServerStream:
+		if f.Type.Params.NumFields() != 2 || len(f.Type.Params.List) != 2 {
+			log.WithField("Function", f.Name.Name).
+				Warn("Function params signature should be func NAME(in *pb.TYPE, stream pb.STREAM), cannot fix")
+			return
+		}
+		updatePBFieldType(f.Type.Params.List[0].Type, m.GoRequest())
 	}
 }
 
-// updateResults updates the first result of f to be `X`.{m.ResponseType.Name}.
+// updateResults updates the first result of f to be the Go type of the response.
 // For example, this function signature:
 //
 //	func ProtoMethod(...) (*pb.Old, error)
 //
 // will become the following function signature, where the prior return type is
-// replaced with the return type defined in m.ResponseType.Name:
+// replaced with the return type returned by m.GoResponse:
 //
-//	func ProtoMethod(...) (*pb.{m.ResponseType.Name}, error)
+//	func ProtoMethod(...) (*{m.GoResponse}, error)
 //
-// TODO: Right now it does not support changing the signature from/to stream types.
+// Streaming methods only return an error, there is nothing to update.
 func updateResults(f *ast.FuncDecl, m *protoParser.Method) {
 	if !m.RequestStream && !m.ResponseStream {
 		if f.Type.Results.NumFields() != 2 {
@@ -223,20 +320,24 @@ func updateResults(f *ast.FuncDecl, m *protoParser.Method) {
 				Warn("Function results signature should be (*pb.TYPE, error), cannot fix")
 			return
 		}
-		updatePBFieldType(f.Type.Results.List[0].Type, m.Response)
+		updatePBFieldType(f.Type.Results.List[0].Type, m.GoResponse())
 	}
 }
 
-// updatePBFieldType updates t if in the form X.Sel/*X.Sel to X.newType/*X.newType.
+// updatePBFieldType updates t if in the form X.Sel/*X.Sel to the qualified
+// newType/*newType, e.g. `pb.Outer_Inner`.
 func updatePBFieldType(t ast.Expr, newType string) {
 	// *pb.TYPE -> pb.TYPE
 	if ptr, _ := t.(*ast.StarExpr); ptr != nil {
 		t = ptr.X
 	}
-	// pb.TYPE -> TYPE
+	pkg, name, _ := strings.Cut(newType, ".")
 	if sel, _ := t.(*ast.SelectorExpr); sel != nil {
-		//pb.SOMETYPE -> pb.newType
-		sel.Sel.Name = newType
+		//pb.SOMETYPE -> pkg.name
+		if x, _ := sel.X.(*ast.Ident); x != nil {
+			x.Name = pkg
+		}
+		sel.Sel.Name = name
 	}
 }
 
@@ -332,7 +433,7 @@ func exprString(e ast.Expr) string {
 
 func applyServerTpl(exec *generic.Data) (io.Reader, error) {
 	log.Debug("Rendering handler for the first time")
-	tpl, err := template.Asset(ServerHandlerPath)
+	tpl, err := template.Read(ServerHandlerPath)
 	if err != nil {
 		return nil, err
 	}
@@ -340,7 +441,7 @@ func applyServerTpl(exec *generic.Data) (io.Reader, error) {
 }
 
 func applyServerMethsTpl(exec handlerData) (io.Reader, error) {
-	tpl, err := template.Asset(ServerHandlerMethodsPath)
+	tpl, err := template.Read(ServerHandlerMethodsPath)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ func (h *HookRender) Render(data *generic.Data) (io.Reader, error) {
 	if h.prev != nil {
 		return h.prev, nil
 	}
-	tpl, err := template.Asset(HookPath)
+	tpl, err := template.Read(HookPath)
 	if err != nil {
 		return nil, err
 	}
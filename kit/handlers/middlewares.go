@@ -36,7 +36,7 @@ func (m *Middlewares) Render(data *generic.Data) (io.Reader, error) {
 	if m.prev != nil {
 		return m.prev, nil
 	}
-	tplBytes, err := template.Asset(MiddlewaresPath)
+	tplBytes, err := template.Read(MiddlewaresPath)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to find template file: %v", MiddlewaresPath)
 	}
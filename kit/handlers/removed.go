@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/iancoleman/strcase"
+	"github.com/niiigoo/hawk/kit/generic"
+	protoParser "github.com/niiigoo/hawk/proto"
+	log "github.com/sirupsen/logrus"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"io"
+	"strings"
+)
+
+// RemovedPath is the relative path to the file the funcs removed from
+// handlers.go are moved to.
+const RemovedPath = "handlers/removed.go"
+
+// Handler is a generic.Renderable rendering handlers.go. The funcs removed
+// from the previous file, e.g. the handlers of methods not defined by the
+// service anymore, are rendered separately by RenderRemoved.
+type Handler interface {
+	generic.Renderable
+	// SetDetectRenames makes Render detect methods which have been renamed,
+	// their handlers are renamed instead of being removed.
+	SetDetectRenames(detect bool)
+	// Removed returns the names of the funcs removed by Render.
+	Removed() []string
+	// RenderRemoved returns the funcs removed by Render appended to prev, the
+	// previous content of the file at RemovedPath. It returns nil if no func
+	// has been removed.
+	RenderRemoved(prev io.Reader) (io.Reader, error)
+}
+
+// removedFunc is a func removed from handlers.go.
+type removedFunc struct {
+	name string
+	// source of the func including its doc comment
+	code string
+}
+
+func (h *handler) SetDetectRenames(detect bool) {
+	h.detectRenames = detect
+}
+
+func (h *handler) Removed() []string {
+	names := make([]string, len(h.removed))
+	for i, f := range h.removed {
+		names[i] = f.name
+	}
+	return names
+}
+
+func (h *handler) RenderRemoved(prev io.Reader) (io.Reader, error) {
+	if len(h.removed) == 0 {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if prev != nil {
+		if _, err := b.ReadFrom(prev); err != nil {
+			return nil, err
+		}
+	} else {
+		b.WriteString("//go:build ignore\n\n")
+		fmt.Fprintf(&b, "package %s\n\n", h.ast.Name.Name)
+		b.WriteString("// The funcs below have been removed from handlers.go by hawk, most likely\n")
+		b.WriteString("// because their methods are not defined by the service anymore. This file\n")
+		b.WriteString("// is excluded from the build, port the code still needed and delete it.\n\n")
+		// the imports of handlers.go, the funcs most likely use them
+		for _, d := range h.ast.Decls {
+			if gen, _ := d.(*ast.GenDecl); gen != nil && gen.Tok == token.IMPORT {
+				b.Write(h.source(gen.Pos(), gen.End()))
+				b.WriteString("\n")
+			}
+		}
+	}
+	for _, f := range h.removed {
+		b.WriteString("\n")
+		b.WriteString(f.code)
+		b.WriteString("\n")
+	}
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(formatted), nil
+}
+
+// removeFuncs records the funcs of decls which are not contained in kept as
+// removed. The comments of the removed funcs are dropped from the AST, the
+// printer would keep them otherwise.
+func (h *handler) removeFuncs(decls, kept []ast.Decl) {
+	keep := make(map[ast.Decl]bool, len(kept))
+	for _, d := range kept {
+		keep[d] = true
+	}
+
+	var ranges [][2]token.Pos
+	for _, d := range decls {
+		f, ok := d.(*ast.FuncDecl)
+		if !ok || keep[d] {
+			continue
+		}
+		start := f.Pos()
+		if f.Doc != nil {
+			start = f.Doc.Pos()
+		}
+		ranges = append(ranges, [2]token.Pos{start, f.End()})
+		h.removed = append(h.removed, removedFunc{
+			name: f.Name.Name,
+			code: string(h.source(start, f.End())),
+		})
+	}
+
+	comments := h.ast.Comments[:0]
+	for _, c := range h.ast.Comments {
+		removed := false
+		for _, r := range ranges {
+			if c.Pos() >= r[0] && c.End() <= r[1] {
+				removed = true
+			}
+		}
+		if !removed {
+			comments = append(comments, c)
+		}
+	}
+	h.ast.Comments = comments
+}
+
+// source returns the source of the previous file between start and end.
+func (h *handler) source(start, end token.Pos) []byte {
+	return h.src[h.fileSet.Position(start).Offset:h.fileSet.Position(end).Offset]
+}
+
+// renameFuncs renames the handler funcs of methods not defined anymore to a
+// method without handler, if the signatures and the request and response
+// types match. A rename is only detected if it is unambiguous, i.e. the func
+// matches a single method and the method a single func. The body of the func
+// is kept as is.
+func (h *handler) renameFuncs(svcName string) {
+	defined := map[string]bool{}
+	var funcs []*ast.FuncDecl
+	for _, d := range h.ast.Decls {
+		f, ok := d.(*ast.FuncDecl)
+		if !ok || !ast.IsExported(f.Name.Name) || recvTypeToString(f.Recv) != svcName+"Service" {
+			continue
+		}
+		defined[f.Name.Name] = true
+		if h.mMap[f.Name.Name] == nil {
+			funcs = append(funcs, f)
+		}
+	}
+
+	var methods []*protoParser.Method
+	for _, m := range h.service.Methods {
+		if !defined[m.Name] {
+			methods = append(methods, m)
+		}
+	}
+
+	candidates := func(f *ast.FuncDecl) []*protoParser.Method {
+		var found []*protoParser.Method
+		for _, m := range methods {
+			if funcMatches(f, m) {
+				found = append(found, m)
+			}
+		}
+		return found
+	}
+	for _, f := range funcs {
+		found := candidates(f)
+		if len(found) != 1 {
+			continue
+		}
+		m := found[0]
+		matches := 0
+		for _, other := range funcs {
+			if funcMatches(other, m) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			continue
+		}
+
+		log.WithField("Function", f.Name.Name).WithField("Method", m.Name).
+			Warn("Method has most likely been renamed, renaming its handler")
+		renameStream(f, h.service.Name, m.Name)
+		f.Name.Name = m.Name
+	}
+}
+
+// funcMatches reports whether f is a handler func of m, apart from its name.
+// The types which cannot be derived from f, e.g. the response of a stream
+// using the type alias of grpc, are ignored.
+func funcMatches(f *ast.FuncDecl, m *protoParser.Method) bool {
+	if !funcSignature(f).matches(methodSignature(m)) {
+		return false
+	}
+	request, response := funcTypes(f)
+	_, goRequest, _ := strings.Cut(m.GoRequest(), ".")
+	_, goResponse, _ := strings.Cut(m.GoResponse(), ".")
+	return (request == "" || request == goRequest) &&
+		(response == "" || response == goResponse)
+}
+
+// funcTypes returns the names of the request and the response type of the
+// handler func f, they are empty if unknown.
+func funcTypes(f *ast.FuncDecl) (string, string) {
+	params := f.Type.Params.List
+	switch funcSignature(f) {
+	case signatureUnary:
+		if results := f.Type.Results.List; len(params) == 2 && len(results) == 2 {
+			return typeName(params[1].Type), typeName(results[0].Type)
+		}
+	case signatureServerStream:
+		if len(params) == 2 {
+			return typeName(params[0].Type), ""
+		}
+	case signatureClientStream, signatureBidiStream:
+		// grpc.ClientStreamingServer[pb.REQ, pb.RES]
+		if index, _ := params[0].Type.(*ast.IndexListExpr); index != nil && len(index.Indices) == 2 {
+			return typeName(index.Indices[0]), typeName(index.Indices[1])
+		}
+	}
+	return "", ""
+}
+
+// typeName returns the name of the type X.Sel/*X.Sel.
+func typeName(t ast.Expr) string {
+	name := exprString(t)
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return strings.TrimPrefix(name, "*")
+}
+
+// renameStream updates the type alias of the stream param of the handler
+// func f to the method name, e.g. pb.SVC_OLDServer -> pb.SVC_NAMEServer.
+func renameStream(f *ast.FuncDecl, svcName, name string) {
+	old := strcase.ToCamel(svcName) + "_" + strcase.ToCamel(f.Name.Name) + "Server"
+	for _, p := range f.Type.Params.List {
+		if sel, _ := p.Type.(*ast.SelectorExpr); sel != nil && sel.Sel.Name == old {
+			sel.Sel.Name = strcase.ToCamel(svcName) + "_" + strcase.ToCamel(name) + "Server"
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	protoParser "github.com/niiigoo/hawk/proto"
+	log "github.com/sirupsen/logrus"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// signature is the form of a handler func, it depends on whether the request
+// and the response of the method are streamed.
+type signature int
+
+const (
+	signatureUnknown signature = iota
+	// func NAME(ctx context.Context, in *pb.REQ) (*pb.RES, error)
+	signatureUnary
+	// func NAME(in *pb.REQ, stream pb.SVC_NAMEServer) error
+	signatureServerStream
+	// func NAME(stream pb.SVC_NAMEServer) error
+	signatureClientStream
+	// func NAME(stream pb.SVC_NAMEServer) error
+	signatureBidiStream
+	// func NAME(stream pb.SVC_NAMEServer) error, the alias is used for client
+	// and bidirectional streams, they cannot be distinguished
+	signatureStream
+)
+
+func (s signature) String() string {
+	switch s {
+	case signatureUnary:
+		return "a unary method"
+	case signatureServerStream:
+		return "a server-streaming method"
+	case signatureClientStream:
+		return "a client-streaming method"
+	case signatureBidiStream:
+		return "a bidirectional streaming method"
+	case signatureStream:
+		return "a client-streaming or bidirectional streaming method"
+	}
+	return "an unknown method"
+}
+
+// matches reports whether a handler func of signature s implements a method
+// of signature want.
+func (s signature) matches(want signature) bool {
+	if s == signatureStream {
+		return want == signatureClientStream || want == signatureBidiStream
+	}
+	return s == want
+}
+
+// methodSignature returns the signature of the handler func of m.
+func methodSignature(m *protoParser.Method) signature {
+	switch {
+	case m.RequestStream && m.ResponseStream:
+		return signatureBidiStream
+	case m.RequestStream:
+		return signatureClientStream
+	case m.ResponseStream:
+		return signatureServerStream
+	}
+	return signatureUnary
+}
+
+// funcSignature returns the signature of the handler func f, it is derived
+// from the number of params and results. Client and bidirectional streams are
+// only distinguished if the generic stream types of grpc are used.
+func funcSignature(f *ast.FuncDecl) signature {
+	results := f.Type.Results.NumFields()
+	switch params := f.Type.Params.NumFields(); {
+	case params == 2 && results == 2:
+		return signatureUnary
+	case params == 2 && results == 1:
+		return signatureServerStream
+	case params == 1 && results == 1:
+		// grpc.ClientStreamingServer[pb.REQ, pb.RES]
+		if index, _ := f.Type.Params.List[0].Type.(*ast.IndexListExpr); index != nil {
+			if sel, _ := index.X.(*ast.SelectorExpr); sel != nil {
+				switch sel.Sel.Name {
+				case "ClientStreamingServer":
+					return signatureClientStream
+				case "BidiStreamingServer":
+					return signatureBidiStream
+				}
+			}
+		}
+		return signatureStream
+	}
+	return signatureUnknown
+}
+
+// updateSignatures rewrites the handler funcs whose signature does not match
+// the streaming of their method anymore, e.g. a unary method changed to a
+// server-streaming one. The rewritten func is rendered like a new handler,
+// the previous func is kept as comment to be ported by the developer.
+//
+// The source is modified, h.ast is parsed again afterward.
+func (h *handler) updateSignatures(svcName string) error {
+	type replacement struct {
+		start, end int
+		code       string
+	}
+	var replacements []replacement
+
+	for _, d := range h.ast.Decls {
+		f, ok := d.(*ast.FuncDecl)
+		if !ok || !ast.IsExported(f.Name.Name) || recvTypeToString(f.Recv) != svcName+"Service" {
+			continue
+		}
+		m := h.mMap[f.Name.Name]
+		if m == nil {
+			continue
+		}
+		have, want := funcSignature(f), methodSignature(m)
+		if have.matches(want) {
+			continue
+		}
+
+		log.WithField("Function", f.Name.Name).
+			Warnf("Method changed from %s to %s, the previous implementation is commented out", have, want)
+
+		start, end := h.fileSet.Position(f.Pos()).Offset, h.fileSet.Position(f.End()).Offset
+		code, err := rewriteFunc(h.service.Name, m, want, h.src[start:end])
+		if err != nil {
+			return err
+		}
+		replacements = append(replacements, replacement{start: start, end: end, code: code})
+	}
+	if len(replacements) == 0 {
+		return nil
+	}
+
+	// replaced from the end, the offsets of the preceding ones stay valid
+	sort.Slice(replacements, func(i, j int) bool {
+		return replacements[i].start > replacements[j].start
+	})
+	src := h.src
+	for _, r := range replacements {
+		src = append(src[:r.start:r.start], append([]byte(r.code), src[r.end:]...)...)
+	}
+
+	return h.parse(src)
+}
+
+// rewriteFunc renders the handler func of m and adds prev, the source of the
+// previous func, as comment with a TODO marker.
+func rewriteFunc(svcName string, m *protoParser.Method, want signature, prev []byte) (string, error) {
+	tpl, err := applyServerMethsTpl(handlerData{
+		ServiceName: svcName,
+		Methods:     []*protoParser.Method{m},
+	})
+	if err != nil {
+		return "", err
+	}
+	code, err := io.ReadAll(tpl)
+	if err != nil {
+		return "", err
+	}
+
+	// split after the opening brace of the body
+	head, body, _ := strings.Cut(strings.TrimSpace(string(code)), "\n")
+
+	var b strings.Builder
+	b.WriteString(head)
+	_, _ = fmt.Fprintf(&b, "\n\t// TODO: %s is %s now, port the previous implementation:", m.Name, want)
+	b.WriteString("\n\t//")
+	for _, line := range strings.Split(string(prev), "\n") {
+		b.WriteString("\n\t//")
+		if line != "" {
+			b.WriteString(" ")
+			b.WriteString(line)
+		}
+	}
+	b.WriteString("\n")
+	b.WriteString(body)
+	return b.String(), nil
+}
+
+// fixContextImport adds the import of the package context if it is used and
+// removes it otherwise. Switching methods between unary and streaming changes
+// whether the handlers need it.
+func fixContextImport(code []byte) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, _ := n.(*ast.SelectorExpr); sel != nil {
+			if x, _ := sel.X.(*ast.Ident); x != nil && x.Name == "context" {
+				used = true
+			}
+		}
+		return !used
+	})
+
+	// the import spec of context and the node to remove with it
+	var imported ast.Node
+	// the offset a missing import is added at
+	offset := fileSet.Position(file.Name.End()).Offset
+	insert := "\n\nimport \"context\""
+	for _, d := range file.Decls {
+		gen, _ := d.(*ast.GenDecl)
+		if gen == nil || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() && imported == nil {
+			offset = fileSet.Position(gen.Lparen).Offset + 1
+			insert = "\n\t\"context\""
+		}
+		for _, spec := range gen.Specs {
+			spec := spec.(*ast.ImportSpec)
+			if path, _ := strconv.Unquote(spec.Path.Value); path == "context" && spec.Name == nil {
+				imported = spec
+				if !gen.Lparen.IsValid() {
+					imported = gen
+				}
+			}
+		}
+	}
+
+	switch {
+	case used && imported == nil:
+		code = append(code[:offset:offset], append([]byte(insert), code[offset:]...)...)
+	case !used && imported != nil:
+		start, end := fileSet.Position(imported.Pos()).Offset, fileSet.Position(imported.End()).Offset
+		code = append(code[:start:start], code[end:]...)
+	default:
+		return code, nil
+	}
+
+	return format.Source(code)
+}
+
+// parse sets the source and the AST of the previous file.
+func (h *handler) parse(src []byte) error {
+	h.src = src
+	h.fileSet = token.NewFileSet()
+	var err error
+	h.ast, err = parser.ParseFile(h.fileSet, "", bytes.NewReader(src), parser.ParseComments)
+	return err
+}
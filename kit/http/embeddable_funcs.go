@@ -21,6 +21,13 @@ import (
 // the functionality defined there is implemented here.
 func PathParams(url string, urlTmpl string) (map[string]string, error) {
 	rv := map[string]string{}
+	urlTmpl, verb := SplitVerb(urlTmpl)
+	if verb != "" {
+		if !strings.HasSuffix(url, ":"+verb) {
+			return nil, fmt.Errorf("expecting a path with the verb %q", verb)
+		}
+		url = strings.TrimSuffix(url, ":"+verb)
+	}
 	pmp := BuildParamMap(urlTmpl)
 
 	expectedLen := len(strings.Split(strings.TrimRight(urlTmpl, "/"), "/"))
@@ -52,6 +59,7 @@ func PathParams(url string, urlTmpl string) (map[string]string, error) {
 //	}
 func BuildParamMap(urlTmpl string) map[string]int {
 	rv := map[string]int{}
+	urlTmpl, _ = SplitVerb(urlTmpl)
 
 	parts := strings.Split(urlTmpl, "/")
 	for idx, part := range parts {
@@ -63,6 +71,18 @@ func BuildParamMap(urlTmpl string) map[string]int {
 	return rv
 }
 
+// SplitVerb splits the custom verb from the url template, e.g.
+// "/v1/{name}:cancel" results in "/v1/{name}" and "cancel". The verb is empty
+// if the template does not have one.
+func SplitVerb(urlTmpl string) (string, string) {
+	last := urlTmpl[strings.LastIndex(urlTmpl, "/")+1:]
+	pos := strings.LastIndex(last, ":")
+	if pos < 0 || strings.Contains(last[pos:], "}") {
+		return urlTmpl, ""
+	}
+	return urlTmpl[:len(urlTmpl)-len(last)+pos], last[pos+1:]
+}
+
 // RemoveBraces replace all curly braces in the provided string, opening and
 // closing, with empty strings.
 func RemoveBraces(val string) string {
@@ -67,3 +67,42 @@ func TestEncodePathParams(t *testing.T) {
 		})
 	}
 }
+
+func TestPathParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		urlTmpl string
+		want    map[string]string
+	}{
+		{
+			name:    "simple",
+			url:     "/v1/abc",
+			urlTmpl: "/v1/{name}",
+			want:    map[string]string{"name": "abc"},
+		},
+		{
+			name:    "verb",
+			url:     "/v1/abc:cancel",
+			urlTmpl: "/v1/{name}:cancel",
+			want:    map[string]string{"name": "abc"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PathParams(tt.url, tt.urlTmpl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PathParams() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathParams_MissingVerb(t *testing.T) {
+	if _, err := PathParams("/v1/abc", "/v1/{name}:cancel"); err == nil {
+		t.Error("PathParams() expected an error for a missing verb")
+	}
+}
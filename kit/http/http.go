@@ -9,11 +9,12 @@ import (
 	"github.com/niiigoo/hawk/kit/http/templates"
 	"github.com/niiigoo/hawk/proto"
 	"github.com/pkg/errors"
-	log "github.com/sirupsen/logrus"
 	"go/format"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -29,6 +30,10 @@ type Helper struct {
 	QueryWithTime      bool
 	ServerTemplate     func(interface{}) (string, error)
 	ClientTemplate     func(interface{}) (string, error)
+	// Imports contains the packages of the types of the bindings, the requests
+	// and the responses declared outside the package of the service, sorted by
+	// their path
+	Imports []*Import
 }
 
 // NewHelper builds a helper struct from a service declaration. The other
@@ -50,15 +55,50 @@ func NewHelper(svc *proto.Service) *Helper {
 		}
 	}
 	rv.QueryWithTime = svc.QueryWithTime
+
+	imports := map[string]*Import{}
+	for alias, path := range svc.GoImports() {
+		imports[path] = &Import{Alias: alias, Path: path}
+	}
+	for _, method := range rv.Methods {
+		for _, binding := range method.Bindings {
+			for _, imp := range binding.Imports {
+				imports[imp.Path] = imp
+			}
+		}
+	}
+	for _, imp := range imports {
+		rv.Imports = append(rv.Imports, imp)
+	}
+	sort.Slice(rv.Imports, func(i, j int) bool {
+		return rv.Imports[i].Path < rv.Imports[j].Path
+	})
 	return &rv
 }
 
+// Routes returns the bindings of all methods in the order they have to be
+// registered at the router. Bindings with a custom verb come first, otherwise
+// a sibling without verb (`/v1/{name}`) would match `/v1/abc:cancel` as well.
+func (h *Helper) Routes() []*Binding {
+	routes := make([]*Binding, 0)
+	for _, verb := range []bool{true, false} {
+		for _, method := range h.Methods {
+			for _, binding := range method.Bindings {
+				if (binding.Path != nil && binding.Path.Verb != nil) == verb {
+					routes = append(routes, binding)
+				}
+			}
+		}
+	}
+	return routes
+}
+
 // NewMethod builds a Method struct from a svcdef.ServiceMethod.
 func NewMethod(meth *proto.Method) *Method {
 	nMeth := Method{
 		Name:         meth.Name,
-		RequestType:  meth.Request,
-		ResponseType: meth.Response,
+		RequestType:  meth.GoRequest(),
+		ResponseType: meth.GoResponse(),
 		Compressed:   meth.Compressed,
 	}
 	for i := range meth.HttpBindings {
@@ -79,6 +119,7 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 	nBinding := Binding{
 		Label:        meth.Name + EnglishNumber(i),
 		PathTemplate: binding.GorillaMuxPath(),
+		Path:         binding.Path,
 		BasePath:     basePath(binding.PathRaw),
 		Method:       binding.Method,
 	}
@@ -98,7 +139,7 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 				//QueryParamName: oneOfType.PBFieldName,
 				QueryParamName: oneofType.Name,
 				CamelName:      strcase.ToCamel(oneofType.Name),
-				LowCamelName:   strcase.ToLowerCamel(oneofType.Name),
+				LowCamelName:   oneofType.JSONName,
 				Repeated:       oneofType.Repeated,
 				IsOptional:     oneofType.Optional,
 				//GoType:         oneofType.Type,
@@ -107,8 +148,8 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 			if oneofType.Type == proto.TypeScalar {
 				option.GoType = oneofType.Field.Type.Scalar.GoString()
 				option.IsBaseType = true
-			} else if oneofType.Field.Type.Reference != "" {
-				option.GoType = "pb." + oneofType.Field.Type.Reference
+			} else {
+				option.GoType = nBinding.goType(oneofType, meth.Parent.GoPackage)
 			}
 
 			// Modify GoType to reflect pointer or repeated status
@@ -120,7 +161,7 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 
 			option.IsEnum = oneofType.Type == proto.TypeEnum
 			option.ConvertFunc, option.ConvertFuncNeedsErrorCheck = createDecodeConvertFunc(option)
-			option.TypeConversion = fmt.Sprintf("&pb.%s_%s{%s: %s}", strcase.ToCamel(meth.Request), strcase.ToCamel(oneofType.Name), strcase.ToCamel(oneofType.Name), createDecodeTypeConversion(option))
+			option.TypeConversion = fmt.Sprintf("&%s_%s{%s: %s}", meth.GoRequest(), strcase.ToCamel(oneofType.Name), strcase.ToCamel(oneofType.Name), createDecodeTypeConversion(option))
 			option.ZeroValue = getZeroValue(option)
 
 			oneOfField.Options = append(oneOfField.Options, option)
@@ -139,7 +180,7 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 			Name:           param.Name,
 			QueryParamName: param.Name,
 			CamelName:      strcase.ToCamel(param.Name),
-			LowCamelName:   strcase.ToLowerCamel(param.Name),
+			LowCamelName:   param.JSONName,
 			Location:       string(param.Location),
 			Repeated:       param.Repeated,
 			IsOptional:     param.Optional,
@@ -148,8 +189,8 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 		if param.Type == proto.TypeScalar {
 			newField.GoType = param.Field.Type.Scalar.GoString()
 			newField.IsBaseType = true
-		} else if param.Field.Type.Reference != "" {
-			newField.GoType = "pb." + param.Field.Type.Reference
+		} else {
+			newField.GoType = nBinding.goType(param, meth.Parent.GoPackage)
 		}
 
 		// Modify GoType to reflect pointer or repeated status
@@ -159,7 +200,8 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 			newField.GoType = "[]" + newField.GoType
 		}
 
-		if newField.Location == "query" && newField.GoType == "pb.google.protobuf.Timestamp" {
+		// timestamps are parsed from the path as well
+		if newField.Location != "body" && newField.GoType == timestampType {
 			meth.Parent.QueryWithTime = true
 		}
 
@@ -169,31 +211,33 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 		newField.TypeConversion = createDecodeTypeConversion(newField)
 
 		nBinding.Fields = append(nBinding.Fields, &newField)
+	}
+	return &nBinding
+}
 
-		// Enums are allowed in query/path parameters, skip warning
-		if newField.IsEnum {
-			continue
-		}
-
-		// Emit warnings for certain cases
-		if !newField.IsBaseType && newField.Location != "body" {
-			log.Warnf(
-				"%s.%s is a non-base type specified to be located outside of "+
-					"the body. Non-base types outside the body may result in "+
-					"generated code which fails to compile.",
-				meth.Name,
-				newField.Name)
+// timestampType is the Go type of `google.protobuf.Timestamp`, it is parsed
+// from the path and the query as RFC 3339.
+const timestampType = "timestamppb.Timestamp"
+
+// goType returns the type of the go struct generated for a message or an enum.
+// Types declared outside the Go package goPackage of the service are
+// qualified by the package they are generated to, e.g. the well-known types
+// (`durationpb.Duration`), it is added to the imports of the binding.
+func (b *Binding) goType(param *proto.Param, goPackage string) string {
+	if sym := param.Symbol; sym != nil {
+		if sym.GoPackage == "" || sym.GoPackage == goPackage {
+			return sym.GoType(goPackage)
 		}
-		if newField.Repeated && newField.Location == "path" {
-			log.Warnf(
-				"%s.%s is a repeated field specified to be in the path. "+
-					"Repeated fields are not supported in the path and may"+
-					"result in generated code which fails to compile.",
-				meth.Name,
-				newField.Name)
+		imp := &Import{Alias: sym.GoAlias(), Path: sym.GoPackage}
+		// the body is decoded as a whole, the type is not referenced
+		if param.Location != proto.LocationBody && !slices.ContainsFunc(b.Imports, func(i *Import) bool { return i.Path == imp.Path }) {
+			b.Imports = append(b.Imports, imp)
 		}
+		return sym.GoType(goPackage)
+	} else if param.Field.Type.Reference != "" {
+		return "pb." + strings.TrimPrefix(param.Field.Type.Reference, ".")
 	}
-	return &nBinding
+	return ""
 }
 
 func GenServerTemplate(exec interface{}) (string, error) {
@@ -254,13 +298,11 @@ func (b *Binding) GenClientEncode() (string, error) {
 //	    "\"sum\"",
 //	    "fmt.Sprint(req.A)",
 //	}
+//
+// A custom verb is appended to the last section, e.g.
+// `fmt.Sprint(req.Name) + ":cancel"`. Wildcards outside a variable are not
+// bound to a field, they are rejected by proto.Method.CheckParams.
 func (b *Binding) PathSections() []string {
-	path := b.PathTemplate
-	re := regexp.MustCompile(`{.+:.+}`)
-	path = re.ReplaceAllStringFunc(path, func(v string) string {
-		return strings.Split(v, ":")[0] + "}"
-	})
-
 	isEnum := make(map[string]struct{})
 	for _, v := range b.Fields {
 		if v.IsEnum {
@@ -268,31 +310,38 @@ func (b *Binding) PathSections() []string {
 		}
 	}
 
-	var rv []string
-	parts := strings.Split(path, "/")
-	for _, part := range parts {
-		if len(part) > 2 && part[0] == '{' && part[len(part)-1] == '}' {
-			name := part[1 : len(part)-1]
-			parts := strings.Split(name, ".")
-			for idx, part := range parts {
-				parts[idx] = strcase.ToCamel(part)
-			}
-			camelName := strings.Join(parts, ".")
-
+	rv := []string{`""`}
+	if len(b.Path.Segments) == 0 {
+		rv = append(rv, `""`)
+	}
+	for _, segment := range b.Path.Segments {
+		if segment.Variable != nil {
+			camelName := strcase.ToCamel(segment.Variable.Field)
 			if _, ok := isEnum[camelName]; ok {
 				convert := fmt.Sprintf("fmt.Sprintf(\"%%d\", req.%v)", camelName)
 				rv = append(rv, convert)
 				continue
 			}
-			convert := fmt.Sprintf("fmt.Sprint(req.%v)", camelName)
-			rv = append(rv, convert)
-		} else {
+			// the getters of a field path (`book.name`) do not panic if a
+			// message is not set
+			value := "req." + camelName
+			if parts := strings.Split(segment.Variable.Field, "."); len(parts) > 1 {
+				value = "req"
+				for _, part := range parts {
+					value += ".Get" + strcase.ToCamel(part) + "()"
+				}
+			}
+			rv = append(rv, fmt.Sprintf("fmt.Sprint(%v)", value))
+		} else if segment.Literal != nil {
 			// Add quotes around things which will be embedded as string literals,
 			// so that the 'fmt.Sprint' lines will be unquoted and thus
 			// evaluated as code.
-			rv = append(rv, `"`+part+`"`)
+			rv = append(rv, strconv.Quote(*segment.Literal))
 		}
 	}
+	if b.Path.Verb != nil {
+		rv[len(rv)-1] += " + " + strconv.Quote(":"+*b.Path.Verb)
+	}
 	return rv
 }
 
@@ -399,7 +448,7 @@ func createDecodeConvertFunc(f Field) (string, bool) {
 		needsErrorCheck = false
 	}
 
-	if f.GoType == "pb.google.protobuf.Timestamp" {
+	if f.GoType == timestampType {
 		return fmt.Sprintf(`tmpTime, err := time.Parse(time.RFC3339, strings.Replace(%s, " ", "+", 1))
 		if err == nil {
 			req.%s = timestamppb.New(tmpTime)
@@ -418,6 +467,9 @@ func createDecodeConvertFunc(f Field) (string, bool) {
 		// pointer as well. So we special case args of a single custom message
 		// type so that the variable LocalName is declared as a pointer.
 		singleCustomTypeUnmarshalTmpl := `
+{{- if .GoType}}
+req.{{.CamelName}} = &{{.GoType}}{}
+{{- end}}
 err = json.Unmarshal([]byte({{.LocalName}}Str), req.{{.CamelName}})`
 
 		errorCheckingTmpl := `
@@ -3,9 +3,12 @@ package http
 import (
 	"github.com/niiigoo/hawk/kit/testHelper"
 	"github.com/niiigoo/hawk/proto"
+	"github.com/niiigoo/hawk/proto/io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -21,6 +24,14 @@ func init() {
 	gopath = filepath.SplitList(os.Getenv("GOPATH"))
 }
 
+func mustParsePath(path string) *io.Path {
+	p, err := io.ParsePath(path)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
 func TestNewMethod(t *testing.T) {
 	defStr := `
 		syntax = "proto3";
@@ -57,6 +68,7 @@ func TestNewMethod(t *testing.T) {
 	binding := &Binding{
 		Label:        "SumZero",
 		PathTemplate: "/sum/{a}",
+		Path:         mustParsePath("/sum/{a}"),
 		BasePath:     "/sum/",
 		Method:       "get",
 		Fields: []*Field{
@@ -103,8 +115,8 @@ func TestNewMethod(t *testing.T) {
 	}
 	meth := &Method{
 		Name:         "Sum",
-		RequestType:  "SumRequest",
-		ResponseType: "SumReply",
+		RequestType:  "pb.SumRequest",
+		ResponseType: "pb.SumReply",
 		Bindings: []*Binding{
 			binding,
 		},
@@ -132,6 +144,21 @@ func TestAllFuncSourceCode(t *testing.T) {
 	}
 }
 
+func TestHelper_Routes(t *testing.T) {
+	get := &Binding{Label: "GetZero", Path: mustParsePath("/v1/{name}")}
+	cancel := &Binding{Label: "CancelZero", Path: mustParsePath("/v1/{name}:cancel")}
+	h := &Helper{
+		Methods: []*Method{
+			{Name: "Get", Bindings: []*Binding{get}},
+			{Name: "Cancel", Bindings: []*Binding{cancel}},
+		},
+	}
+
+	if got, want := h.Routes(), []*Binding{cancel, get}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Helper.Routes() = %v, want %v", got, want)
+	}
+}
+
 func TestEnglishNumber(t *testing.T) {
 	var cases = []struct {
 		i    int
@@ -209,13 +236,13 @@ func Test_getMuxPathTemplate(t *testing.T) {
 
 func TestBinding_PathSections(t *testing.T) {
 	tests := []struct {
-		name         string
-		pathTemplate string
-		want         []string
+		name string
+		path string
+		want []string
 	}{
 		{
-			name:         "simple",
-			pathTemplate: "/sum/{a}",
+			name: "simple",
+			path: "/sum/{a}",
 			want: []string{
 				`""`,
 				`"sum"`,
@@ -223,8 +250,8 @@ func TestBinding_PathSections(t *testing.T) {
 			},
 		},
 		{
-			name:         "pattern",
-			pathTemplate: `/v1/{parent:shelves/[^/]+}/books`,
+			name: "pattern",
+			path: `/v1/{parent=shelves/*}/books`,
 			want: []string{
 				`""`,
 				`"v1"`,
@@ -233,19 +260,45 @@ func TestBinding_PathSections(t *testing.T) {
 			},
 		},
 		{
-			name:         "dot notation",
-			pathTemplate: `/v1/{book.name:shelves/[^/]+/books/[^/]+}`,
+			name: "dot notation",
+			path: `/v1/{book.name=shelves/*/books/*}`,
+			want: []string{
+				`""`,
+				`"v1"`,
+				"fmt.Sprint(req.GetBook().GetName())",
+			},
+		},
+		{
+			name: "verb",
+			path: `/v1/{name=operations/*}:cancel`,
 			want: []string{
 				`""`,
 				`"v1"`,
-				"fmt.Sprint(req.Book.Name)",
+				`fmt.Sprint(req.Name) + ":cancel"`,
+			},
+		},
+		{
+			name: "literal verb",
+			path: `/v1/books:batchGet`,
+			want: []string{
+				`""`,
+				`"v1"`,
+				`"books" + ":batchGet"`,
+			},
+		},
+		{
+			name: "root",
+			path: `/`,
+			want: []string{
+				`""`,
+				`""`,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			b := &Binding{
-				PathTemplate: tt.pathTemplate,
+				Path: mustParsePath(tt.path),
 			}
 			if got := b.PathSections(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Binding.PathSections() = %v, want %v", got, tt.want)
@@ -253,3 +306,152 @@ func TestBinding_PathSections(t *testing.T) {
 		})
 	}
 }
+
+// TestGenServerDecode_Build compiles the decoding of a request with fields of
+// well-known types and of types declared in another Go package.
+func TestGenServerDecode_Build(t *testing.T) {
+	// the build cache is kept, the bundled proto files are written to an
+	// empty cache directory
+	goCache, err := exec.Command("go", "env", "GOCACHE").Output()
+	if err != nil {
+		t.Skip("go not found")
+	}
+	t.Setenv("GOCACHE", strings.TrimSpace(string(goCache)))
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	// the generated code is built within this module to resolve its
+	// dependencies
+	dir, err := os.MkdirTemp(".", "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	pkg := "github.com/niiigoo/hawk/kit/http/" + filepath.Base(dir)
+
+	writeFile := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+	common := writeFile("common/common.proto", `syntax = "proto3";
+package common;
+option go_package = "`+pkg+`/common";
+message Filter { string q = 1; }
+enum Kind { KIND_UNSPECIFIED = 0; KIND_A = 1; }
+`)
+	// the service is declared separately, the code of the messages is built
+	// without the dependencies of the annotations and gRPC
+	messages := writeFile("messages.proto", `syntax = "proto3";
+package test;
+option go_package = "`+pkg+`/pb";
+import "google/protobuf/duration.proto";
+import "common/common.proto";
+
+message Request {
+  string id = 1;
+  google.protobuf.Duration timeout = 2;
+  common.Filter filter = 3;
+  common.Kind kind = 4;
+  common.Filter body = 5;
+}
+`)
+	file := writeFile("test.proto", `syntax = "proto3";
+package test;
+option go_package = "`+pkg+`/pb";
+import "googleapis/google/api/annotations.proto";
+import "messages.proto";
+
+service Test {
+  rpc Get(Request) returns (Request) {
+    option (google.api.http) = {
+      post: "/test/{id}"
+      body: "body"
+    };
+  }
+}
+`)
+
+	p := proto.NewService()
+	if err = p.Parse(file); err != nil {
+		t.Fatal(err)
+	}
+	if err = proto.CompilePackage(messages, filepath.Join(dir, "pb"), pkg+"/pb", dir); err != nil {
+		t.Fatal(err)
+	}
+	if err = proto.CompilePackage(common, filepath.Join(dir, "common"), pkg+"/common", dir); err != nil {
+		t.Fatal(err)
+	}
+
+	helper := NewHelper(p.Definition().Services[0])
+	want := []*Import{
+		{Alias: "commonpb", Path: pkg + "/common"},
+		{Alias: "durationpb", Path: "google.golang.org/protobuf/types/known/durationpb"},
+	}
+	if !reflect.DeepEqual(helper.Imports, want) {
+		t.Errorf("Imports %s, want %s", spew.Sdump(helper.Imports), spew.Sdump(want))
+	}
+
+	code, err := helper.Methods[0].Bindings[0].GenServerDecode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode, err := FuncSourceCode(encodePathParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := `package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "` + pkg + `/pb"
+`
+	for _, imp := range helper.Imports {
+		src += imp.Alias + ` "` + imp.Path + `"
+`
+	}
+	src += `)
+
+var (
+	_ = fmt.Sprint
+	_ = strconv.Itoa
+	_ = json.Unmarshal
+	_ context.Context
+)
+
+var unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+var mux = struct {
+	Vars func(*http.Request) map[string]string
+}{}
+
+type httpError struct {
+	error
+	statusCode int
+	headers    map[string][]string
+}
+` + encode + code
+	writeFile("server/server.go", src)
+
+	cmd := exec.Command("go", "build", "./"+filepath.Base(dir)+"/...")
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=readonly")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("Failed to build the generated code: %v\n%s\n%s", err, out, src)
+	}
+}
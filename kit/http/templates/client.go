@@ -10,7 +10,7 @@ var ClientEncodeTemplate = `
 	func EncodeHTTP{{$binding.Label}}Request(_ context.Context, r *http.Request, request interface{}) error {
 		strval := ""
 		_ = strval
-		req := request.(*pb.{{GoName $binding.Parent.RequestType}})
+		req := request.(*{{$binding.Parent.RequestType}})
 		_ = req
 		r.Header.Set("transport", "HTTPJSON")
 		r.Header.Set("request-url", r.URL.Path)
@@ -76,7 +76,7 @@ var ClientEncodeTemplate = `
 		{{- if ne $binding.Method "get" }}
 		// Set the body parameters
 		var buf bytes.Buffer
-		toRet := request.(*pb.{{GoName $binding.Parent.RequestType}})
+		toRet := request.(*{{$binding.Parent.RequestType}})
 		{{- range $field := $binding.Fields -}}
 			{{if eq $field.Location "body"}}
 				{{/* Only set the fields which should be in the body, so all
@@ -120,8 +120,15 @@ import (
 	transport "github.com/go-kit/kit/transport/http"
 	"github.com/pkg/errors"
 	// This Service
-	"{{.ImportPath -}} /svc"
+	svc "{{.SvcImportPath}}"
 	pb "{{.PBImportPath -}}"
+	{{- if .HTTPHelper.Imports}}
+
+	// Types declared in other packages
+	{{- range .HTTPHelper.Imports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
+	{{- end}}
 )
 var (
 	_ = endpoint.Chain
@@ -194,7 +201,7 @@ func CtxValuesToSend(keys ...string) transport.ClientOption {
 // HTTP Client Decode
 {{range $method := .HTTPHelper.Methods}}
 	// DecodeHTTP{{$method.Name}}Response is a transport/http.DecodeResponseFunc that decodes
-	// a JSON-encoded {{GoName $method.ResponseType}} response from the HTTP response body.
+	// a JSON-encoded {{$method.ResponseType}} response from the HTTP response body.
 	// If the response has a non-200 status code, we will interpret that as an
 	// error and attempt to decode the specific error message from the response
 	// body. Primarily useful in a client.
@@ -210,7 +217,7 @@ func CtxValuesToSend(keys ...string) transport.ClientOption {
 		if r.StatusCode != http.StatusOK {
 			return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
 		}
-		var resp pb.{{GoName $method.ResponseType}}
+		var resp {{$method.ResponseType}}
 		if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
 			return nil, errorDecoder(buf)
 		}
@@ -9,7 +9,7 @@ var ServerDecodeTemplate = `
 	// body. Primarily useful in a server.
 	func DecodeHTTP{{$binding.Label}}Request(_ context.Context, r *http.Request) (interface{}, error) {
 		defer r.Body.Close()
-		var req pb.{{GoName $binding.Parent.RequestType}}
+		var req {{$binding.Parent.RequestType}}
 		buf, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot read body of http request")
@@ -50,7 +50,7 @@ var ServerTemplate = `
 // Rerunning hawk will overwrite this file.
 // Version: {{.Version}}
 // Version Date: {{.VersionDate}}
-package svc
+package {{.SvcPackage}}
 // This file provides server-side bindings for the HTTP transport.
 // It utilizes the transport/http.Server.
 import (
@@ -71,12 +71,18 @@ import (
 	"strconv"
 	"strings"
 	{{- if .HTTPHelper.QueryWithTime}}
-		"google.golang.org/protobuf/types/known/timestamppb"
 		"time"
 	{{- end}}
 
 	// This service
 	pb "{{.PBImportPath -}}"
+	{{- if .HTTPHelper.Imports}}
+
+	// Types declared in other packages
+	{{- range .HTTPHelper.Imports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
+	{{- end}}
 )
 const contentType = "application/json; charset=utf-8"
 var (
@@ -106,8 +112,8 @@ func MakeHTTPHandler(logger *logrus.Entry, endpoints Endpoints, responseEncoder
 		m.Handle("{{.HTTPHelper.Service.WSPath}}", wsPool)
 	{{- end}}
 
-	{{range $method := .HTTPHelper.Methods}}
-		{{range $binding := $method.Bindings}}
+	{{range $binding := .HTTPHelper.Routes}}
+		{{with $method := $binding.Parent}}
 			if endpoints.HasHttpHandlerFunc("{{$method.Name}}") {
 				m.Methods("{{$binding.Method | ToUpper}}").Path("{{$binding.PathTemplate}}").HandlerFunc(endpoints.GetHttpHandlerFunc("{{$method.Name}}"))
 			} else {
@@ -15,6 +15,7 @@ func TestGenClientEncode(t *testing.T) {
 	binding := &Binding{
 		Label:        "SumZero",
 		PathTemplate: "/sum/{a}",
+		Path:         mustParsePath("/sum/{a}"),
 		BasePath:     "/sum/",
 		Method:       "get",
 		Fields: []*Field{
@@ -44,8 +45,8 @@ func TestGenClientEncode(t *testing.T) {
 	}
 	meth := &Method{
 		Name:         "Sum",
-		RequestType:  "SumRequest",
-		ResponseType: "SumReply",
+		RequestType:  "pb.SumRequest",
+		ResponseType: "pb.SumReply",
 		Bindings: []*Binding{
 			binding,
 		},
@@ -105,6 +106,7 @@ func TestGenServerDecode(t *testing.T) {
 	binding := &Binding{
 		Label:        "SumZero",
 		PathTemplate: "/sum/{a}",
+		Path:         mustParsePath("/sum/{a}"),
 		BasePath:     "/sum/",
 		Method:       "get",
 		Fields: []*Field{
@@ -138,8 +140,8 @@ func TestGenServerDecode(t *testing.T) {
 	}
 	meth := &Method{
 		Name:         "Sum",
-		RequestType:  "SumRequest",
-		ResponseType: "SumReply",
+		RequestType:  "pb.SumRequest",
+		ResponseType: "pb.SumReply",
 		Bindings: []*Binding{
 			binding,
 		},
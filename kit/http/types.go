@@ -1,10 +1,12 @@
 package http
 
+import "github.com/niiigoo/hawk/proto/io"
+
 // Method contains the distillation of information within a
 // proto.Method that's useful for templating http transport.
 type Method struct {
 	Name string
-	// RequestType is the name of type of the Request, e.g. *EchoRequest
+	// RequestType is the Go type of the Request, e.g. pb.EchoRequest
 	RequestType  string
 	ResponseType string
 	Bindings     []*Binding
@@ -23,17 +25,28 @@ type Binding struct {
 	// PathTemplate is the full path template as it appeared in the http
 	// annotation which this binding refers to.
 	PathTemplate string
+	// Path is the parsed path template of the http annotation.
+	Path *io.Path
 	// BasePath is the longest static portion of the full PathTemplate, and is
 	// given to the net/http mux as the path for the route for this binding.
 	BasePath    string
 	Method      string
 	Fields      []*Field
 	OneOfFields []*OneofField
+	// Imports contains the packages of the types decoded from the path and
+	// the query which are declared outside the package of the service
+	Imports []*Import
 	// A pointer back to the parent method of this binding. Used within some
 	// binding methods
 	Parent *Method
 }
 
+// Import is a Go package imported by the generated code.
+type Import struct {
+	Alias string
+	Path  string
+}
+
 // Field contains the distillation of information within an svcdef.Field that's
 // useful for templating http transport.
 type Field struct {
@@ -45,7 +58,8 @@ type Field struct {
 	// The name of this field, but run through the camelcase function and with
 	// the first letter lowercased. "example_name" becomes "exampleName".
 	// LowCamelName is how the names of fields should appear when marshaled to
-	// JSON, according to the gRPC language guide.
+	// JSON, according to the gRPC language guide. The `json_name` option
+	// overrides it.
 	LowCamelName string
 	// The go-compatible name for this variable, for use in auto generated go
 	// code.
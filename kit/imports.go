@@ -0,0 +1,85 @@
+package kit
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+// majorVersion matches the last element of import paths like `example.com/x/v2`,
+// the package is named by the element before.
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// pruneImports removes the imports of the Go source which are not used. The
+// templates import the packages used by some services only, e.g. `strconv` to
+// parse query parameters. An import without a name is kept if the package
+// name cannot be derived from the import path. Sources which are not a Go
+// file, e.g. rendered by a template of the overlay, are returned as they are.
+func pruneImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return src, nil
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	// the lines of the unused imports, removed from the end of the source
+	type lines struct{ start, end int }
+	remove := make([]lines, 0)
+	for _, spec := range file.Imports {
+		name, ok := importName(spec)
+		if !ok || used[name] {
+			continue
+		}
+		start := fset.Position(spec.Pos()).Offset
+		if spec.Doc != nil {
+			start = fset.Position(spec.Doc.Pos()).Offset
+		}
+		end := fset.Position(spec.End()).Offset
+		start = bytes.LastIndexByte(src[:start], '\n') + 1
+		if i := bytes.IndexByte(src[end:], '\n'); i >= 0 {
+			end += i + 1
+		} else {
+			end = len(src)
+		}
+		remove = append(remove, lines{start, end})
+	}
+	if len(remove) == 0 {
+		return src, nil
+	}
+
+	res := slices.Clone(src)
+	for i := len(remove) - 1; i >= 0; i-- {
+		res = append(res[:remove[i].start], res[remove[i].end:]...)
+	}
+	return format.Source(res)
+}
+
+// importName returns the name the package of the import is referred to by, ok
+// is false for blank and dot imports and if the name is unknown.
+func importName(spec *ast.ImportSpec) (name string, ok bool) {
+	if spec.Name != nil {
+		return spec.Name.Name, spec.Name.Name != "_" && spec.Name.Name != "."
+	}
+	importPath, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return "", false
+	}
+	name = path.Base(importPath)
+	return name, token.IsIdentifier(name) && !majorVersion.MatchString(name)
+}
@@ -0,0 +1,96 @@
+package kit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	httpTemplates "github.com/niiigoo/hawk/kit/http/templates"
+	tplFiles "github.com/niiigoo/hawk/kit/template"
+	"slices"
+	"strings"
+)
+
+// ManifestPath is the path of the manifest relative to the project root.
+const ManifestPath = ".hawk/manifest.json"
+
+// Manifest records the files written by the last generation. It is used to
+// detect generated files modified by hand and files which are not generated
+// anymore.
+type Manifest struct {
+	// Version identifies the templates the files were rendered from
+	Version string                  `json:"version"`
+	Files   map[string]ManifestFile `json:"files"`
+}
+
+type ManifestFile struct {
+	// Hash is the SHA-256 of the written content
+	Hash string `json:"hash"`
+	// Editable files are meant to be modified, e.g. `handlers/handlers.go`
+	Editable bool `json:"editable,omitempty"`
+}
+
+// NewManifest returns an empty manifest of the current templates.
+func NewManifest() *Manifest {
+	return &Manifest{
+		Version: TemplateVersion(),
+		Files:   make(map[string]ManifestFile),
+	}
+}
+
+// Outdated reports whether the files were rendered from other templates than
+// the current ones, e.g. by another version of hawk or with another overlay.
+func (m *Manifest) Outdated() bool {
+	return m.Version != TemplateVersion()
+}
+
+// Add records the file name with its content. The files below `handlers/`
+// are editable, all others must not be modified.
+func (m *Manifest) Add(name string, content []byte) {
+	m.Files[name] = ManifestFile{
+		Hash:     hash(content),
+		Editable: strings.HasPrefix(name, "handlers/"),
+	}
+}
+
+// Modified reports whether the generated file name was modified since it has
+// been written, content is its current content. Editable files and files not
+// recorded are never modified.
+func (m *Manifest) Modified(name string, content []byte) bool {
+	file, ok := m.Files[name]
+	return ok && !file.Editable && file.Hash != hash(content)
+}
+
+// Stale returns the files which are not editable and not contained in files,
+// sorted by name.
+func (m *Manifest) Stale(files map[string][]byte) []string {
+	stale := make([]string, 0)
+	for name, file := range m.Files {
+		if _, ok := files[name]; !ok && !file.Editable {
+			stale = append(stale, name)
+		}
+	}
+	slices.Sort(stale)
+	return stale
+}
+
+// TemplateVersion identifies the templates, it is the hash of all of them
+// including the templates of the overlay.
+func TemplateVersion() string {
+	// the overlay has been read successfully before the manifest is created
+	names, _ := tplFiles.Names()
+
+	h := sha256.New()
+	for _, name := range names {
+		data, _ := tplFiles.Read(name)
+		h.Write([]byte(name))
+		h.Write(data)
+	}
+	for _, tpl := range []string{httpTemplates.ServerTemplate, httpTemplates.ServerDecodeTemplate, httpTemplates.ClientTemplate, httpTemplates.ClientEncodeTemplate} {
+		h.Write([]byte(tpl))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+func hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
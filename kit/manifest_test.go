@@ -0,0 +1,207 @@
+package kit
+
+import (
+	"github.com/sirupsen/logrus"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestManifest(t *testing.T) {
+	m := NewManifest()
+	m.Add("svc/endpoints.go", []byte("generated"))
+	m.Add("svc/old.go", []byte("old"))
+	m.Add("handlers/handlers.go", []byte("handlers"))
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		modified bool
+	}{
+		{"unchanged file", "svc/endpoints.go", "generated", false},
+		{"hand-edited file", "svc/endpoints.go", "edited", true},
+		{"editable file", "handlers/handlers.go", "edited", false},
+		{"file not recorded", "svc/other.go", "other", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := m.Modified(test.file, []byte(test.content)); got != test.modified {
+				t.Errorf("Modified(%s) = %t, want %t", test.file, got, test.modified)
+			}
+		})
+	}
+
+	stale := m.Stale(map[string][]byte{"svc/endpoints.go": nil})
+	if want := []string{"svc/old.go"}; !slices.Equal(stale, want) {
+		t.Errorf("Stale() = %v, want %v", stale, want)
+	}
+
+	if m.Outdated() {
+		t.Error("Manifest of the current templates is outdated")
+	}
+	m.Version = "other"
+	if !m.Outdated() {
+		t.Error("Manifest of other templates is not outdated")
+	}
+}
+
+func TestManifest_ReadWrite(t *testing.T) {
+	dir := t.TempDir()
+	repo := NewRepository()
+
+	m, err := repo.ReadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Files) != 0 || m.Outdated() {
+		t.Errorf("Missing manifest read as %+v", m)
+	}
+
+	m.Add("svc/endpoints.go", []byte("generated"))
+	if err = repo.WriteManifest(dir, m); err != nil {
+		t.Fatal(err)
+	}
+	read, err := repo.ReadManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.Version != m.Version || read.Files["svc/endpoints.go"] != m.Files["svc/endpoints.go"] {
+		t.Errorf("Read manifest %+v, want %+v", read, m)
+	}
+}
+
+// TestService_Manifest generates a service and changes it on disk between
+// the generations.
+func TestService_Manifest(t *testing.T) {
+	g, dir := newTestProject(t, ".", map[string]string{
+		"go.mod":        "module example.com/greeter\n\ngo 1.22\n",
+		"greeter.proto": greeterProto,
+	})
+	proto := filepath.Join(dir, "greeter.proto")
+	if err := g.Service(proto); err != nil {
+		t.Fatal(err)
+	}
+	endpoints := filepath.Join(dir, "svc", "endpoints.go")
+	generated, err := os.ReadFile(endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// change modifies the project before the generation
+		change func(t *testing.T)
+		force  bool
+		// err is a part of the expected error, if any
+		err string
+		// check verifies the project after the generation
+		check func(t *testing.T)
+		// warn reports whether the generation warns about other templates
+		warn bool
+	}{
+		{
+			name:   "unchanged file",
+			change: func(t *testing.T) {},
+			check:  func(t *testing.T) { assertFile(t, endpoints, string(generated)) },
+		},
+		{
+			name:   "hand-edited file",
+			change: func(t *testing.T) { writeTestFile(t, endpoints, "edited") },
+			err:    "generated files have been modified, use --force to overwrite them: svc/endpoints.go",
+			check:  func(t *testing.T) { assertFile(t, endpoints, "edited") },
+		},
+		{
+			name:   "hand-edited file with force",
+			change: func(t *testing.T) { writeTestFile(t, endpoints, "edited") },
+			force:  true,
+			check:  func(t *testing.T) { assertFile(t, endpoints, string(generated)) },
+		},
+		{
+			name: "stale file",
+			change: func(t *testing.T) {
+				writeTestFile(t, filepath.Join(dir, "svc", "old.go"), "package svc\n")
+				m, err := g.repo.ReadManifest(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				m.Add("svc/old.go", []byte("package svc\n"))
+				if err = g.repo.WriteManifest(dir, m); err != nil {
+					t.Fatal(err)
+				}
+			},
+			check: func(t *testing.T) {
+				if _, err := os.Stat(filepath.Join(dir, "svc", "old.go")); !os.IsNotExist(err) {
+					t.Errorf("Stale file not removed: %v", err)
+				}
+			},
+		},
+		{
+			name: "manifest of other templates",
+			change: func(t *testing.T) {
+				m, err := g.repo.ReadManifest(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				m.Version = "other"
+				if err = g.repo.WriteManifest(dir, m); err != nil {
+					t.Fatal(err)
+				}
+			},
+			warn: true,
+			check: func(t *testing.T) {
+				m, err := g.repo.ReadManifest(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if m.Outdated() {
+					t.Errorf("Manifest not updated to the current templates: %s", m.Version)
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.change(t)
+			hook := logtest.NewGlobal()
+			defer logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+
+			g.SetForce(test.force)
+			err := g.Service(proto)
+			if test.err == "" && err != nil {
+				t.Fatal(err)
+			} else if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Fatalf("Service returned %v, want %q", err, test.err)
+			}
+			test.check(t)
+
+			warned := slices.ContainsFunc(hook.AllEntries(), func(e *logrus.Entry) bool {
+				return e.Level == logrus.WarnLevel && strings.Contains(e.Message, "The templates changed")
+			})
+			if warned != test.warn {
+				t.Errorf("Warning about other templates printed: %t, want %t", warned, test.warn)
+			}
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, file, content string) {
+	t.Helper()
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertFile(t *testing.T, file, want string) {
+	t.Helper()
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != want {
+		t.Errorf("Content of %s is\n%s\nwant\n%s", file, content, want)
+	}
+}
@@ -0,0 +1,68 @@
+package profile_test
+
+import (
+	"github.com/niiigoo/hawk/kit"
+	"github.com/niiigoo/hawk/kit/profile"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestInitGenerate initializes a project of each profile, generates the
+// service and builds it like `make all` does.
+func TestInitGenerate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("downloads the dependencies of the generated service")
+	}
+	hawk, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.Chdir(wd)
+	})
+
+	profiles, err := profile.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range profiles {
+		t.Run(p.Name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.Chdir(dir); err != nil {
+				t.Fatal(err)
+			}
+
+			g := kit.NewGenerator()
+			g.SetProfiles(p.Name)
+			if err := g.Init("example.com/greeter", "greeter"); err != nil {
+				t.Fatalf("Init failed: %v", err)
+			}
+			// the generated service depends on this version of hawk, the
+			// other dependencies are resolved by its modules
+			run(t, dir, "go", "mod", "edit",
+				"-require", "github.com/niiigoo/hawk@v0.0.0", "-replace", "github.com/niiigoo/hawk="+hawk,
+				"-require", "github.com/niiigoo/hawk/pkg@v0.0.0", "-replace", "github.com/niiigoo/hawk/pkg="+filepath.Join(hawk, "pkg"))
+
+			if err := kit.NewGenerator().Service(); err != nil {
+				t.Fatalf("Service failed: %v", err)
+			}
+			run(t, dir, "go", "build", "./...")
+			run(t, dir, "go", "test", "./...")
+		})
+	}
+}
+
+func run(t *testing.T, dir, name string, args ...string) {
+	t.Helper()
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%s %v failed: %v\n%s", name, args, err, out)
+	}
+}
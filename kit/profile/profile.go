@@ -0,0 +1,155 @@
+// Package profile bundles the scaffolding profiles of `hawk init`. A profile
+// is a directory of templates rendered into a new project, e.g. a Dockerfile
+// and an example proto file. It can extend other profiles, their files are
+// rendered as well.
+package profile
+
+import (
+	"bytes"
+	"embed"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// Default is the profile used if none is selected.
+const Default = "minimal"
+
+// configFile declares the description of a profile and the profiles it
+// extends, it is not rendered.
+const configFile = "profile.yaml"
+
+//go:embed all:profiles
+var files embed.FS
+
+// Profile is a set of files laid down by `hawk init`.
+type Profile struct {
+	Name        string
+	Description string
+	// Extends contains the names of the profiles rendered with this one
+	Extends []string
+}
+
+// Data is passed to the templates of the profiles.
+type Data struct {
+	// Module is the path of the Go module
+	Module string
+	// Name is the name of the project, the proto file is named after it
+	Name string
+	// Package is the package of the proto file
+	Package string
+	// Service is the name of the service
+	Service string
+	// Command is the name of the directory of the generated main package
+	// (`cmd/COMMAND`), it is used for the binary as well
+	Command string
+	// GoVersion is the version of Go declared by go.mod, e.g. `1.22`
+	GoVersion string
+}
+
+// List returns all profiles sorted by name.
+func List() ([]*Profile, error) {
+	entries, err := files.ReadDir("profiles")
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*Profile, 0, len(entries))
+	for _, entry := range entries {
+		p, err := Get(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles, nil
+}
+
+// Get returns the profile name.
+func Get(name string) (*Profile, error) {
+	data, err := files.ReadFile(path.Join("profiles", name, configFile))
+	if err != nil {
+		return nil, errors.Errorf("unknown profile '%s'", name)
+	}
+	p := &Profile{Name: name}
+	if err = yaml.Unmarshal(data, p); err != nil {
+		return nil, errors.Wrapf(err, "invalid profile '%s'", name)
+	}
+	return p, nil
+}
+
+// Resolve returns the profiles names including the ones they extend, the
+// extended profiles precede the extending ones.
+func Resolve(names ...string) ([]string, error) {
+	resolved := make([]string, 0, len(names))
+	var resolve func(name string, seen []string) error
+	resolve = func(name string, seen []string) error {
+		if slices.Contains(seen, name) {
+			return errors.Errorf("profile '%s' extends itself", name)
+		}
+		if slices.Contains(resolved, name) {
+			return nil
+		}
+		p, err := Get(name)
+		if err != nil {
+			return err
+		}
+		for _, extended := range p.Extends {
+			if err = resolve(extended, append(seen, name)); err != nil {
+				return err
+			}
+		}
+		resolved = append(resolved, name)
+		return nil
+	}
+	for _, name := range names {
+		if err := resolve(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// Render renders the files of the profiles names and the ones they extend.
+// The files are returned by their slash-separated path, `NAME` in the path is
+// replaced by the name of the project. A file of a profile replaces the one
+// of the profiles it extends.
+func Render(data Data, names ...string) (map[string][]byte, error) {
+	resolved, err := Resolve(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := map[string][]byte{}
+	for _, name := range resolved {
+		root := path.Join("profiles", name)
+		err = fs.WalkDir(files, root, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || !strings.HasSuffix(file, ".tpl") {
+				return err
+			}
+			tpl, err := files.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			t, err := template.New(file).Parse(string(tpl))
+			if err != nil {
+				return errors.Wrapf(err, "invalid template '%s'", file)
+			}
+			var b bytes.Buffer
+			if err = t.Execute(&b, data); err != nil {
+				return errors.Wrapf(err, "failed to render template '%s'", file)
+			}
+
+			target := strings.TrimSuffix(strings.TrimPrefix(file, root+"/"), ".tpl")
+			rendered[strings.ReplaceAll(target, "NAME", data.Name)] = b.Bytes()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rendered, nil
+}
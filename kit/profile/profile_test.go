@@ -0,0 +1,74 @@
+package profile
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	resolved, err := Resolve("compose", "kubernetes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"service", "compose", "kubernetes"}; !slices.Equal(resolved, want) {
+		t.Errorf("Resolved %v, want %v", resolved, want)
+	}
+
+	if _, err = Resolve("unknown"); err == nil || err.Error() != "unknown profile 'unknown'" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	profiles, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range profiles {
+		if p.Description == "" {
+			t.Errorf("Profile %s has no description", p.Name)
+		}
+	}
+	if !slices.ContainsFunc(profiles, func(p *Profile) bool { return p.Name == Default }) {
+		t.Errorf("Default profile %s not found", Default)
+	}
+}
+
+func TestRender(t *testing.T) {
+	data := Data{
+		Module:    "example.com/greeter",
+		Name:      "greeter",
+		Package:   "greeter",
+		Service:   "Greeter",
+		Command:   "greeter",
+		GoVersion: "1.22",
+	}
+
+	files, err := Render(data, Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Rendered %d files of the default profile, want none", len(files))
+	}
+
+	files, err = Render(data, "kubernetes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{".gitignore", "Dockerfile", "Makefile", "protoc.yaml", "greeter.proto", "k8s/deployment.yaml", "k8s/service.yaml"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("File %s not rendered", name)
+		}
+	}
+	if _, ok := files["docker-compose.yaml"]; ok {
+		t.Error("File docker-compose.yaml of another profile rendered")
+	}
+	if !strings.Contains(string(files["Dockerfile"]), "FROM golang:1.22-alpine AS build") {
+		t.Errorf("Unexpected Dockerfile:\n%s", files["Dockerfile"])
+	}
+	if !strings.Contains(string(files["greeter.proto"]), `option go_package = "example.com/greeter;greeter";`) {
+		t.Errorf("Unexpected proto file:\n%s", files["greeter.proto"])
+	}
+}
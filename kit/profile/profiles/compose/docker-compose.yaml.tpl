@@ -0,0 +1,9 @@
+services:
+  {{.Command}}:
+    build: .
+    environment:
+      PORT: "5050"
+      DEBUG_ADDR: ":5060"
+    ports:
+      - "5050:5050"
+      - "5060:5060"
@@ -0,0 +1,36 @@
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{.Command}}
+  labels:
+    app: {{.Command}}
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: {{.Command}}
+  template:
+    metadata:
+      labels:
+        app: {{.Command}}
+    spec:
+      containers:
+        - name: {{.Command}}
+          image: {{.Command}}:latest
+          env:
+            - name: PORT
+              value: "5050"
+            - name: DEBUG_ADDR
+              value: ":5060"
+          ports:
+            - name: service
+              containerPort: 5050
+            - name: debug
+              containerPort: 5060
+          livenessProbe:
+            httpGet:
+              path: /debug/buildinfo
+              port: debug
+          readinessProbe:
+            tcpSocket:
+              port: service
@@ -0,0 +1,13 @@
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{.Command}}
+  labels:
+    app: {{.Command}}
+spec:
+  selector:
+    app: {{.Command}}
+  ports:
+    - name: service
+      port: 5050
+      targetPort: service
@@ -0,0 +1,2 @@
+/bin/
+# the manifest of hawk (.hawk/manifest.json) must be committed
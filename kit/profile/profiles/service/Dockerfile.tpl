@@ -0,0 +1,18 @@
+# syntax=docker/dockerfile:1
+
+FROM golang:{{.GoVersion}}-alpine AS build
+WORKDIR /src
+COPY go.mod go.sum ./
+RUN go mod download
+COPY . .
+RUN CGO_ENABLED=0 go build -o /out/{{.Command}} ./cmd/{{.Command}}
+
+FROM alpine:3
+RUN adduser -D -H service
+COPY --from=build /out/{{.Command}} /usr/local/bin/{{.Command}}
+USER service
+# PORT serves HTTP, gRPC and WebSocket, DEBUG_ADDR serves pprof and the build info
+ENV PORT=5050 DEBUG_ADDR=:5060
+EXPOSE 5050 5060
+HEALTHCHECK --interval=30s --timeout=3s CMD wget -q -O /dev/null "http://localhost${DEBUG_ADDR}/debug/buildinfo" || exit 1
+ENTRYPOINT ["/usr/local/bin/{{.Command}}"]
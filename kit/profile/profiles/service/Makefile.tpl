@@ -0,0 +1,21 @@
+NAME := {{.Command}}
+
+.PHONY: all generate test build run docker
+
+all: generate test build
+
+# generate updates the service after changing the proto file
+generate:
+	hawk generate
+
+test:
+	go test ./...
+
+build:
+	CGO_ENABLED=0 go build -o bin/$(NAME) ./cmd/$(NAME)
+
+run:
+	go run ./cmd/$(NAME)
+
+docker:
+	docker build -t $(NAME) .
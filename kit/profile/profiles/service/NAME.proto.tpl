@@ -0,0 +1,35 @@
+syntax = "proto3";
+
+package {{.Package}};
+option go_package = "{{.Module}};{{.Package}}";
+
+import "googleapis/google/api/annotations.proto";
+import "hawk/options.proto";
+
+// {{.Service}} is an example service, run `make generate` after changing it.
+service {{.Service}} {
+  option (hawk.service) = {
+    HttpPrefix: "/api/{{.Package}}"
+    HttpCompress: false
+  };
+
+  // Hello greets the caller, it is available via gRPC and HTTP
+  // (GET /api/{{.Package}}/hello/{name}).
+  rpc Hello(HelloRequest) returns (HelloResponse) {
+    option (google.api.http) = {
+      get: "/hello/{name}"
+    };
+  }
+}
+
+// HelloRequest is the request of Hello.
+message HelloRequest {
+  // name of the caller
+  string name = 1;
+}
+
+// HelloResponse is the response of Hello.
+message HelloResponse {
+  // message greeting the caller
+  string message = 1;
+}
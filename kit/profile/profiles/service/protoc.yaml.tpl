@@ -0,0 +1,6 @@
+# The configuration of hawk, see https://github.com/niiigoo/hawk
+# Additional directories the imports of the proto file are looked up in
+imports: []
+# Lint rules enabled or disabled by their ID, see `hawk lint`
+lint:
+  rules: {}
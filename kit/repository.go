@@ -2,6 +2,7 @@ package kit
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/niiigoo/hawk/kit/generic"
 	"github.com/niiigoo/hawk/kit/template"
@@ -10,6 +11,7 @@ import (
 	"go/format"
 	"golang.org/x/mod/modfile"
 	"io"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -18,10 +20,15 @@ import (
 type Repository interface {
 	WriteFile(name string, reader io.Reader) error
 	OpenFiles(dir string, excludes ...string) (map[string]io.Reader, error)
-	GitClone(path, repo string) error
+	ReadDir(dir string) (map[string]io.Reader, error)
+	RemoveFile(name string) error
+	ReadManifest(dir string) (*Manifest, error)
+	WriteManifest(dir string, manifest *Manifest) error
 	GoModInit(pkg string) error
-	GoModTidy() error
+	GoModTidy(dir string) error
 	GetGoModule(dir string) (string, error)
+	FindGoModule(dir string) (string, string, error)
+	GetGoVersion(dir string) (string, error)
 	GenerateFile(tpl string, gen generic.Renderable, data *generic.Data) (io.Reader, error)
 }
 
@@ -36,12 +43,16 @@ func (r repository) GoModInit(pkg string) error {
 	return exec.Command("go", "mod", "init", pkg).Run()
 }
 
-func (r repository) GoModTidy() error {
-	err := exec.Command("go", "get", "google.golang.org/genproto@latest").Run()
-	if err != nil {
+// GoModTidy updates the dependencies of the module located in dir.
+func (r repository) GoModTidy(dir string) error {
+	get := exec.Command("go", "get", "google.golang.org/genproto@latest")
+	get.Dir = dir
+	if err := get.Run(); err != nil {
 		return err
 	}
-	return exec.Command("go", "mod", "tidy").Run()
+	tidy := exec.Command("go", "mod", "tidy")
+	tidy.Dir = dir
+	return tidy.Run()
 }
 
 // WriteFile creates or overrides a file with the data from the reader
@@ -75,6 +86,46 @@ func (r repository) WriteFile(name string, reader io.Reader) error {
 	return nil
 }
 
+// RemoveFile removes the file and its parent directories as long as they are
+// empty
+func (r repository) RemoveFile(name string) error {
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for dir := filepath.Dir(name); dir != "." && dir != string(filepath.Separator); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+// ReadManifest reads the manifest of the project located in dir, an empty
+// manifest is returned if the project does not have one yet
+func (r repository) ReadManifest(dir string) (*Manifest, error) {
+	manifest := NewManifest()
+	data, err := os.ReadFile(filepath.Join(dir, ManifestPath))
+	if os.IsNotExist(err) {
+		return manifest, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest '%s'", ManifestPath)
+	}
+	return manifest, nil
+}
+
+// WriteManifest writes the manifest of the project located in dir
+func (r repository) WriteManifest(dir string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return r.WriteFile(filepath.Join(dir, ManifestPath), bytes.NewReader(append(data, '\n')))
+}
+
 // OpenFiles returns a map[string]io.Reader representing the files in dir
 func (r repository) OpenFiles(dir string, nested ...string) (map[string]io.Reader, error) {
 	if _, err := os.Stat(dir); err != nil {
@@ -123,17 +174,30 @@ func (r repository) OpenFiles(dir string, nested ...string) (map[string]io.Reade
 	return files, nil
 }
 
-func (r repository) GitClone(path, repo string) error {
-	if _, err := os.Stat(filepath.Join(path, repo)); os.IsNotExist(err) {
-		println("Downloading dependencies...")
-		cmd := exec.Command("git", "clone", "https://"+repo, filepath.Join(path, repo))
-		err = r.run(cmd)
+// ReadDir returns the content of all files below dir, the keys are the paths
+// relative to dir
+func (r repository) ReadDir(dir string) (map[string]io.Reader, error) {
+	files := make(map[string]io.Reader)
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
 		if err != nil {
-			return errors.Wrapf(err, "failed to clone '%s'", repo)
+			return errors.Wrapf(err, "cannot read file: %v", path)
 		}
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(relPath)] = bytes.NewReader(data)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot fully walk directory %v", dir)
 	}
 
-	return nil
+	return files, nil
 }
 
 func (r repository) GetGoModule(dir string) (string, error) {
@@ -145,6 +209,48 @@ func (r repository) GetGoModule(dir string) (string, error) {
 	return modfile.ModulePath(goModBytes), nil
 }
 
+// FindGoModule returns the root of the module containing dir, i.e. the
+// closest directory containing go.mod, and the path of the module. The
+// directory dir does not need to exist.
+func (r repository) FindGoModule(dir string) (string, string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", "", err
+	}
+	for root := abs; ; {
+		module, err := r.GetGoModule(root)
+		if err == nil && module == "" {
+			return "", "", errors.Errorf("file '%s' declares no module", filepath.Join(root, "go.mod"))
+		} else if err == nil {
+			return root, module, nil
+		} else if !os.IsNotExist(err) {
+			return "", "", err
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return "", "", errors.Errorf("no file 'go.mod' found in '%s' or its parents", abs)
+		}
+		root = parent
+	}
+}
+
+// GetGoVersion returns the version of Go declared by the go.mod of dir, it is
+// empty if go.mod does not declare a version.
+func (r repository) GetGoVersion(dir string) (string, error) {
+	name := filepath.Join(dir, "go.mod")
+	goModBytes, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := modfile.ParseLax(name, goModBytes, nil)
+	if err != nil || f.Go == nil {
+		return "", err
+	}
+	return f.Go.Version, nil
+}
+
 // GenerateFile contains logic to choose how to render a template file
 // based on path and if that file was generated previously. It accepts a
 // template path to render, a templateExecutor to apply to the template, and a
@@ -174,14 +280,17 @@ func (r repository) GenerateFile(tpl string, gen generic.Renderable, data *gener
 	if err != nil {
 		log.WithError(err).WithField("file", tpl).Warn("Code formatting error, generated service will not build, outputting not formatted code")
 		return bytes.NewReader(codeBytes), nil
-	} else {
-		return bytes.NewReader(formatted), nil
 	}
+	pruned, err := pruneImports(formatted)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot remove unused imports: %s", tpl)
+	}
+	return bytes.NewReader(pruned), nil
 }
 
 // applyTemplateFromPath calls applyTemplate with the template
 func (r repository) applyTemplateFromPath(tpl string, data *generic.Data) (io.Reader, error) {
-	tplBytes, err := template.Asset(tpl)
+	tplBytes, err := template.Read(tpl)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to find template file: %v", tpl)
 	}
@@ -1,38 +1,79 @@
 package kit
 
 import (
+	"bytes"
+	"fmt"
 	"github.com/iancoleman/strcase"
 	"github.com/niiigoo/hawk/kit/generic"
 	"github.com/niiigoo/hawk/kit/handlers"
+	"github.com/niiigoo/hawk/kit/profile"
 	tplFiles "github.com/niiigoo/hawk/kit/template"
+	"github.com/niiigoo/hawk/plugin"
 	"github.com/niiigoo/hawk/proto"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	log "github.com/sirupsen/logrus"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 )
 
 type Generator interface {
 	Init(args ...string) error
 	Service(file ...string) error
+	SetDryRun(w io.Writer, diff bool)
+	SetForce(force bool)
+	SetDetectRenames(detect bool)
+	SetProfiles(names ...string)
+	SetOutput(dir string) error
 }
 
+// TemplatesPath is the default directory of the templates overriding the
+// embedded ones, relative to the project root.
+const TemplatesPath = ".hawk/templates"
+
+// ErrChanges is returned by a dry run of Generator.Service if the generation
+// would change files.
+var ErrChanges = errors.New("generation would change files")
+
 type generator struct {
 	protoService proto.Parser
 	repo         Repository
-	dir          string
+	// dir is the root of the generated service, it is located in the module
+	// but not necessarily its root
+	dir string
+	// dryRun receives the changed files instead of writing them, if not nil
+	dryRun io.Writer
+	diff   bool
+	// force overwrites generated files modified by hand
+	force bool
+	// detectRenames renames the handlers of renamed methods
+	detectRenames bool
+	// profiles are rendered by Init, see the package profile
+	profiles []string
 }
 
-func NewGenerator() Generator {
+// NewGenerator creates a generator, the proto file is parsed by parser if
+// provided.
+func NewGenerator(parser ...proto.Parser) Generator {
 	dir, _ := os.Getwd()
+	protoService := proto.NewService()
+	if len(parser) > 0 {
+		protoService = parser[0]
+	}
 	return &generator{
-		protoService: proto.NewService(),
+		protoService: protoService,
 		repo:         NewRepository(),
 		dir:          dir,
 	}
 }
 
+// Init initializes a project: go.mod, the files of the profiles and a proto
+// file declaring the service, unless a profile provides it. Existing files
+// are not overwritten.
 func (g generator) Init(args ...string) error {
 	var pkg, name string
 	if len(args) > 0 {
@@ -46,72 +87,292 @@ func (g generator) Init(args ...string) error {
 		pkg = filepath.Base(g.dir)
 		name = pkg
 	}
+	name = strcase.ToLowerCamel(name)
+
+	profiles := g.profiles
+	if len(profiles) == 0 {
+		profiles = []string{profile.Default}
+	}
+	// the profiles are checked before anything is written
+	if _, err := profile.Resolve(profiles...); err != nil {
+		return err
+	}
 
 	err := g.repo.GoModInit(pkg)
 	if err != nil {
 		return errors.Wrap(err, "failed to initialize go mod")
 	}
 
-	name = strcase.ToLowerCamel(name)
-	err = g.protoService.CreateFile(name+".proto", name, strcase.ToCamel(name))
+	goVersion, err := g.repo.GetGoVersion(g.dir)
+	if err != nil {
+		return errors.Wrap(err, "failed to read file 'go.mod'")
+	}
+	// the patch version is omitted, e.g. for the tag of the Docker image
+	if parts := strings.Split(goVersion, "."); len(parts) > 2 {
+		goVersion = strings.Join(parts[:2], ".")
+	}
+
+	files, err := profile.Render(profile.Data{
+		Module:    pkg,
+		Name:      name,
+		Package:   name,
+		Service:   strcase.ToCamel(name),
+		Command:   strings.TrimSuffix(strings.ToLower(strcase.ToCamel(name)), "service"),
+		GoVersion: goVersion,
+	}, profiles...)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	protoFile := name + ".proto"
+	for _, f := range sortedNames(files) {
+		target := filepath.Join(g.dir, filepath.FromSlash(f))
+		if _, err = os.Stat(target); err == nil {
+			log.WithField("file", f).Warn("File exists already, it is not overwritten")
+			continue
+		}
+		if err = g.repo.WriteFile(target, bytes.NewReader(files[f])); err != nil {
+			return errors.Wrapf(err, "failed to write file '%s'", f)
+		}
+	}
+	if _, ok := files[protoFile]; ok {
+		return nil
+	}
+
+	return g.protoService.CreateFile(protoFile, name, strcase.ToCamel(name), pkg)
 }
 
-func (g generator) Service(args ...string) error {
-	err := g.downloadDependencies()
+// SetOutput sets the directory the service is generated to, the working
+// directory by default. It must be located in a Go module, which is not
+// necessarily its root, e.g. `services/foo` of a monorepo. The import paths
+// are derived from the module path and the location of dir in the module.
+// `protoc.yaml` is looked up in dir and its parents up to the module root.
+func (g *generator) SetOutput(dir string) error {
+	abs, err := filepath.Abs(dir)
 	if err != nil {
-		return errors.Wrap(err, "failed to download dependencies")
+		return err
 	}
+	g.dir = abs
+	g.protoService.SetConfigDir(abs)
+	return nil
+}
+
+// SetProfiles sets the profiles rendered by Init, profile.Default is used if
+// none is set.
+func (g *generator) SetProfiles(names ...string) {
+	g.profiles = names
+}
 
-	f, err := g.protoService.DetectFile(args...)
+// SetDryRun makes Service render the files without writing them to disk or
+// running `go mod tidy`. The files which would change are printed to w
+// instead, with a unified diff if diff is set.
+func (g *generator) SetDryRun(w io.Writer, diff bool) {
+	g.dryRun = w
+	g.diff = diff
+}
+
+// SetForce makes Service overwrite and remove generated files even if they
+// have been modified by hand.
+func (g *generator) SetForce(force bool) {
+	g.force = force
+}
+
+// SetDetectRenames makes Service rename the handlers of methods which have
+// most likely been renamed instead of moving them to handlers/removed.go. A
+// method is considered renamed if a single new method has the same signature
+// and types as a single removed one.
+func (g *generator) SetDetectRenames(detect bool) {
+	g.detectRenames = detect
+}
+
+// Service generates the service of the proto files. The files written are
+// recorded in the manifest, generated files modified by hand are neither
+// overwritten nor removed unless force is set. Files which were generated by
+// the previous run but are not generated anymore are removed.
+func (g generator) Service(args ...string) error {
+	files, err := g.protoService.DetectFiles(args...)
 	if err != nil {
 		return errors.Wrap(err, "proto file not found")
 	}
 
-	err = g.protoService.Parse(f)
+	root, module, err := g.repo.FindGoModule(g.dir)
 	if err != nil {
-		return errors.Wrapf(err, "failed to parse proto file '%s'", f)
+		return errors.Wrap(err, "failed to read file 'go.mod'")
 	}
-
-	err = g.protoService.CompileProto(f, g.dir, g.dir, "$GOPATH/src/github.com/googleapis", "$GOPATH/src/github.com/googleapis/googleapis")
+	// the service is located in a sub-directory of the module in a monorepo
+	sub, err := filepath.Rel(root, g.dir)
 	if err != nil {
-		return errors.Wrap(err, "protoc failed")
+		return err
 	}
+	sub = filepath.ToSlash(sub)
+	goPkg := path.Join(module, sub)
 
-	module, err := g.repo.GetGoModule(g.dir)
+	// a configured overlay is relative to `protoc.yaml` like its other
+	// paths, the default one to the service
+	templates := g.protoService.Config().Templates
+	if templates == "" {
+		templates = filepath.Join(g.dir, TemplatesPath)
+	}
+	tplFiles.SetOverlay(templates)
+
+	// the proto files are compiled to a temporary directory representing the
+	// module root, the files are written together with the other generated
+	// files
+	pbDir, err := os.MkdirTemp("", "hawk-")
 	if err != nil {
-		return errors.Wrap(err, "failed to read file 'go.mod'")
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(pbDir)
+	}()
+
+	services := make([]*proto.Service, 0)
+	defs := make([]*proto.Definition, 0, len(files))
+	pbPackage := ""
+	// the files imported by the proto files, mapped to the directory of the
+	// file importing them
+	imported := make(map[string]string)
+	for _, f := range files {
+		err = g.protoService.Parse(f)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse proto file '%s'", f)
+		}
+		def := g.protoService.Definition()
+		defs = append(defs, def)
+		for _, i := range g.protoService.Imports() {
+			if _, ok := imported[i]; !ok {
+				imported[i] = filepath.Dir(f)
+			}
+		}
+
+		importPath, out, err := goPackage(def, module, sub, pbDir)
+		if err != nil {
+			return errors.Wrapf(err, "invalid proto file '%s'", f)
+		}
+		if len(def.Services) > 0 {
+			if pbPackage != "" && pbPackage != importPath {
+				return errors.Errorf("the services of '%s' are declared in the Go package '%s', the other services in '%s'", f, importPath, pbPackage)
+			}
+			pbPackage = importPath
+			services = append(services, def.Services...)
+		}
+
+		if out == "" {
+			log.WithField("file", f).Infof("Go package '%s' is located outside of the module, the code is not generated", importPath)
+			continue
+		}
+		err = g.protoService.CompileProto(f, out, importPath, g.dir)
+		if err != nil {
+			return errors.Wrapf(err, "failed to compile proto file '%s'", f)
+		}
+	}
+	if err = g.compileImports(imported, files, module, sub, pbDir); err != nil {
+		return err
 	}
 
-	prevFiles, err := g.repo.OpenFiles(g.dir, filepath.Base(g.dir), "handlers")
+	// the handlers of multiple services are located in sub-packages
+	nested := []string{filepath.Base(g.dir), "handlers"}
+	if len(services) > 1 {
+		for _, svc := range services {
+			nested = append(nested, generic.ServicePackage(svc))
+		}
+	}
+	prevFiles, err := g.repo.OpenFiles(g.dir, nested...)
 	if err != nil {
 		return err
 	}
 
+	protoHash, err := ProtoHash(files...)
+	if err != nil {
+		return errors.Wrap(err, "failed to hash proto files")
+	}
+	protoRevision := ProtoRevision(files...)
+	if protoRevision == "" {
+		protoRevision = protoHash
+	}
+	hawkVersion, hawkDate := Version()
 	config := generic.Config{
-		GoPackage:     module,
-		PBPackage:     module,
-		Version:       "",
-		VersionDate:   "",
+		GoPackage:     goPkg,
+		PBPackage:     pbPackage,
+		Version:       hawkVersion,
+		VersionDate:   hawkDate,
+		ProtoHash:     protoHash,
+		ProtoRevision: protoRevision,
 		PreviousFiles: prevFiles,
 	}
-	files, err := g.generateGoKit(config)
+	codeGenFiles, err := g.generateGoKit(config, services)
 	if err != nil {
 		return errors.Wrap(err, "failed to generate service files")
 	}
 
-	for name, content := range files {
-		err = g.repo.WriteFile("./"+name, content)
+	pbFiles, err := g.repo.ReadDir(pbDir)
+	if err != nil {
+		return err
+	}
+	for name, content := range pbFiles {
+		// relative to the service, the package may be located outside of it
+		rel, err := filepath.Rel(g.dir, filepath.Join(root, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		codeGenFiles[filepath.ToSlash(rel)] = content
+	}
+	generated := make(map[string][]byte, len(codeGenFiles))
+	for name, content := range codeGenFiles {
+		if generated[name], err = io.ReadAll(content); err != nil {
+			return errors.Wrapf(err, "failed to render file '%s'", name)
+		}
+	}
+	if err = g.runPlugins(generated, plugin.NewRequest(files, defs, config)); err != nil {
+		return err
+	}
+
+	prev, err := g.repo.ReadManifest(g.dir)
+	if err != nil {
+		return err
+	}
+	if prev.Outdated() {
+		log.WithFields(log.Fields{"previous": prev.Version, "current": TemplateVersion()}).
+			Warn("The templates changed since the last generation, generated files may change although the proto files did not")
+	}
+	stale := prev.Stale(generated)
+
+	if g.dryRun != nil {
+		return g.printChanges(generated, stale)
+	}
+
+	if !g.force {
+		modified := make([]string, 0)
+		for _, name := range append(sortedNames(generated), stale...) {
+			current, err := os.ReadFile(filepath.Join(g.dir, filepath.FromSlash(name)))
+			if err == nil && prev.Modified(name, current) {
+				modified = append(modified, name)
+			}
+		}
+		if len(modified) > 0 {
+			return errors.Errorf("generated files have been modified, use --force to overwrite them: %s", strings.Join(modified, ", "))
+		}
+	}
+
+	manifest := NewManifest()
+	for name, content := range generated {
+		err = g.repo.WriteFile(filepath.Join(g.dir, filepath.FromSlash(name)), bytes.NewReader(content))
 		if err != nil {
 			return errors.Wrapf(err, "failed to write file '%s'", name)
 		}
+		manifest.Add(name, content)
+	}
+	for _, name := range stale {
+		log.WithField("file", name).Info("Removing file which is not generated anymore")
+		if err = g.repo.RemoveFile(filepath.Join(g.dir, filepath.FromSlash(name))); err != nil {
+			return errors.Wrapf(err, "failed to remove file '%s'", name)
+		}
+	}
+	if err = g.repo.WriteManifest(g.dir, manifest); err != nil {
+		return errors.Wrapf(err, "failed to write manifest '%s'", ManifestPath)
 	}
 
-	err = g.repo.GoModTidy()
+	err = g.repo.GoModTidy(root)
 	if err != nil {
 		return errors.Wrap(err, "`go mod tidy` failed")
 	}
@@ -119,74 +380,295 @@ func (g generator) Service(args ...string) error {
 	return nil
 }
 
-func (g generator) downloadDependencies() error {
-	return g.repo.GitClone(
-		os.Getenv("GOPATH")+"/src/",
-		"github.com/googleapis/googleapis",
-	)
+// runPlugins runs the plugins configured in `protoc.yaml` and adds their
+// files to generated. A plugin must not return a file generated by hawk or
+// another plugin.
+func (g generator) runPlugins(generated map[string][]byte, request *plugin.Request) error {
+	for _, config := range g.protoService.Config().Plugins {
+		log.WithField("plugin", plugin.Name(config)).Info("Running plugin")
+		files, err := plugin.Run(g.dir, config, request)
+		if err != nil {
+			return err
+		}
+		for name, content := range files {
+			if _, ok := generated[name]; ok {
+				return errors.Errorf("plugin '%s' returned the file '%s' which is generated already", plugin.Name(config), name)
+			}
+			generated[name] = content
+		}
+	}
+	return nil
+}
+
+// printChanges prints the generated files differing from the ones on disk and
+// the stale files to the writer of the dry run. ErrChanges is returned if
+// there is at least one.
+func (g generator) printChanges(files map[string][]byte, stale []string) error {
+	changed := false
+	for _, name := range sortedNames(files) {
+		generated := files[name]
+		current, err := os.ReadFile(filepath.Join(g.dir, filepath.FromSlash(name)))
+		status := "modified"
+		if os.IsNotExist(err) {
+			status = "new"
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to read file '%s'", name)
+		}
+		if err == nil && bytes.Equal(current, generated) {
+			continue
+		}
+		changed = true
+		if err = g.printChange(status, name, current, generated); err != nil {
+			return err
+		}
+	}
+	for _, name := range stale {
+		current, err := os.ReadFile(filepath.Join(g.dir, filepath.FromSlash(name)))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "failed to read file '%s'", name)
+		}
+		changed = true
+		if err = g.printChange("removed", name, current, nil); err != nil {
+			return err
+		}
+	}
+
+	if changed {
+		return ErrChanges
+	}
+	return nil
+}
+
+// printChange prints the status of the file or, in diff mode, its unified diff.
+func (g generator) printChange(status, name string, current, generated []byte) error {
+	if !g.diff {
+		_, err := fmt.Fprintf(g.dryRun, "%s: %s\n", status, name)
+		return err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(generated),
+		FromFile: name,
+		ToFile:   name + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(g.dryRun, diff)
+	return err
+}
+
+// splitLines splits the content into lines for a diff. Unlike
+// difflib.SplitLines, no empty line is added after the final newline.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
+
+// sortedNames returns the names of the files in ascending order.
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// compileImports compiles the imported files which are not passed as files
+// but declare a Go package located in the module, the code generated for the
+// proto files references their types. An imported file is compiled relative
+// to the directory of the file importing it, like it has been looked up.
+func (g generator) compileImports(imported map[string]string, files []string, module, sub, pbDir string) error {
+	passed := make(map[string]bool, len(files))
+	for _, f := range files {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return err
+		}
+		passed[abs] = true
+	}
+
+	names := make([]string, 0, len(imported))
+	for f := range imported {
+		names = append(names, f)
+	}
+	slices.Sort(names)
+	for _, f := range names {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return err
+		}
+		if passed[abs] {
+			continue
+		}
+		if err = g.protoService.Parse(f); err != nil {
+			return errors.Wrapf(err, "failed to parse imported proto file '%s'", f)
+		}
+		importPath, out, err := goPackage(g.protoService.Definition(), module, sub, pbDir)
+		if err != nil {
+			return errors.Wrapf(err, "invalid imported proto file '%s'", f)
+		}
+		if out == "" {
+			continue
+		}
+		log.WithField("file", f).Infof("Compiling the imported file to the Go package '%s' of the module", importPath)
+		if err = g.protoService.CompileProto(f, out, importPath, imported[f]); err != nil {
+			return errors.Wrapf(err, "failed to compile imported proto file '%s'", f)
+		}
+	}
+	return nil
+}
+
+// goPackage returns the import path of the Go package declared by the option
+// `go_package` of def and the directory to generate the package to. The
+// directory is empty if the package is located outside the module, its code
+// is expected to be provided by another module then (e.g. a shared schema).
+// Import paths starting with `.` are relative to the service, which is located
+// in sub, the slash-separated path relative to the module root dir.
+func goPackage(def *proto.Definition, module, sub, dir string) (string, string, error) {
+	importPath, _ := def.GoPackage()
+	switch {
+	case importPath == "":
+		return "", "", errors.New("option go_package is missing")
+	case importPath == "." || strings.HasPrefix(importPath, "./"):
+		return path.Join(module, sub, importPath), filepath.Join(dir, filepath.FromSlash(sub), filepath.FromSlash(importPath)), nil
+	case importPath == module || strings.HasPrefix(importPath, module+"/"):
+		rel := strings.TrimPrefix(importPath, module)
+		return importPath, filepath.Join(dir, filepath.FromSlash(rel)), nil
+	}
+	return importPath, "", nil
+}
+
+// sharedTemplates are rendered once for all services, the other templates are
+// rendered for each service.
+var sharedTemplates = map[string]bool{
+	"cmd/NAME/main.go.tpl":  true,
+	handlers.HookPath:       true,
+	"svc/build.go.tpl":      true,
+	"svc/config.go.tpl":     true,
+	"svc/server/run.go.tpl": true,
 }
 
-// generateGoKit returns a go-kit service generated from a service definition,
-// the package to the root of the generated service goPackage, the package
-// to the .pb.go service struct files (goPBPackage) and any previously generated files.
-func (g generator) generateGoKit(conf generic.Config) (map[string]io.Reader, error) {
-	if len(g.protoService.Definition().Services) == 0 {
+// generateGoKit returns a go-kit service generated from the service
+// definitions, the package to the root of the generated service goPackage,
+// the package to the .pb.go service struct files (goPBPackage) and any
+// previously generated files. Multiple services share the files listed in
+// sharedTemplates, the other files are placed in a sub-package per service.
+func (g generator) generateGoKit(conf generic.Config, services []*proto.Service) (map[string]io.Reader, error) {
+	if len(services) == 0 {
 		return nil, errors.New("no service found")
 	}
-	svc := g.protoService.Definition().Services[0]
+	if err := proto.CheckHttpPrefixes(services); err != nil {
+		return nil, err
+	}
+
+	// Remove the suffix "service" since it's added back in by templatePathToActual
+	svcName := strings.TrimSuffix(strings.ToLower(services[0].Name), "service")
+	if len(services) > 1 {
+		svcName = path.Base(conf.GoPackage)
+		packages := map[string]string{}
+		for _, svc := range services {
+			pkg := generic.ServicePackage(svc)
+			if other, ok := packages[pkg]; ok {
+				return nil, errors.Errorf("services '%s' and '%s' are both generated into the package '%s'", other, svc.Name, pkg)
+			}
+			packages[pkg] = svc.Name
+		}
+	}
 
 	codeGenFiles := make(map[string]io.Reader)
-	var err error
 
-	// Remove the suffix "service" since it's added back in by templatePathToActual
-	svcName := strings.TrimSuffix(strings.ToLower(svc.Name), "service")
-	helper := generic.NewData(svc, conf)
-	for _, tpl := range tplFiles.AssetNames() {
+	names, err := tplFiles.Names()
+	if err != nil {
+		return nil, err
+	}
+
+	shared, all := generic.NewServicesData(services, conf)
+	for _, tpl := range names {
 		parts := strings.Split(tpl, ".")
 		if len(parts) > 3 {
 			tpl = parts[0] + "." + strings.Join(parts[2:], ".")
 		}
 
-		// Re-derive the actual path for this file based on the service output
-		// path provided by the hawk main.go
-		actualPath := g.templatePathToActual(tpl, svcName)
-		if _, ok := codeGenFiles[actualPath]; ok {
-			continue
+		helpers := all
+		if sharedTemplates[tpl] {
+			helpers = []*generic.Data{shared}
 		}
+		for _, helper := range helpers {
+			// Re-derive the actual path for this file based on the service output
+			// path provided by the hawk main.go
+			actualPath := g.templatePathToActual(tpl, svcName, helper.Alias)
+			if _, ok := codeGenFiles[actualPath]; ok {
+				continue
+			}
 
-		var r generic.Renderable
-		switch tpl {
-		case handlers.ServerHandlerPath:
-			r, err = handlers.New(svc, conf.PreviousFiles[actualPath])
+			var r generic.Renderable
+			switch tpl {
+			case handlers.ServerHandlerPath:
+				h, err := handlers.New(helper.Service, conf.PreviousFiles[actualPath])
+				if err != nil {
+					return nil, errors.Wrapf(err, "cannot parse previous handler: %q", actualPath)
+				}
+				h.SetDetectRenames(g.detectRenames)
+				r = h
+			case handlers.HookPath:
+				r = handlers.NewHook(conf.PreviousFiles[actualPath])
+			case handlers.MiddlewaresPath:
+				m := handlers.NewMiddlewares()
+				m.Load(conf.PreviousFiles[actualPath])
+				r = m
+			}
+			file, err := g.repo.GenerateFile(tpl, r, helper)
 			if err != nil {
-				return nil, errors.Wrapf(err, "cannot parse previous handler: %q", tpl)
+				return nil, errors.Wrap(err, "cannot render template")
 			}
-		case handlers.HookPath:
-			r = handlers.NewHook(conf.PreviousFiles[actualPath])
-		case handlers.MiddlewaresPath:
-			m := handlers.NewMiddlewares()
-			m.Load(conf.PreviousFiles[actualPath])
-			r = m
-		}
-		file, err := g.repo.GenerateFile(tpl, r, helper)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot render template")
-		}
 
-		codeGenFiles[actualPath] = file
+			codeGenFiles[actualPath] = file
+
+			// the funcs removed from the handlers are kept in a separate file
+			if h, ok := r.(handlers.Handler); ok {
+				removedPath := g.templatePathToActual(handlers.RemovedPath, svcName, helper.Alias)
+				removed, err := h.RenderRemoved(conf.PreviousFiles[removedPath])
+				if err != nil {
+					return nil, errors.Wrapf(err, "cannot render removed handlers: %q", removedPath)
+				}
+				if removed != nil {
+					log.WithField("file", removedPath).WithField("functions", strings.Join(h.Removed(), ", ")).
+						Warn("Functions removed from the handlers, they are not defined by the service anymore")
+					codeGenFiles[removedPath] = removed
+				}
+			}
+		}
 	}
 
 	return codeGenFiles, nil
 }
 
-// templatePathToActual accepts a templateFilePath and the svcName of the
-// service and returns what the relative file path of what should be written to
-// disk
-func (g generator) templatePathToActual(tplPath, svcName string) string {
+// templatePathToActual accepts a templateFilePath, the svcName of the
+// service and the package of the service (empty for a single service) and
+// returns what the relative file path of what should be written to disk
+func (g generator) templatePathToActual(tplPath, svcName, pkg string) string {
 	// Switch "NAME" in path with svcName.
 	// i.e. for svcName = addsvc; /NAME -> /addsvc-service/addsvc
 	actual := strings.Replace(tplPath, "NAME", svcName, -1)
 
+	// i.e. for pkg = admin; svc/endpoints.go -> svc/admin/endpoints.go
+	if pkg != "" {
+		dir, file, _ := strings.Cut(actual, "/")
+		actual = dir + "/" + pkg + "/" + file
+	}
+
 	actual = strings.TrimSuffix(actual, ".tpl")
 
 	return actual
@@ -1,12 +1,16 @@
 package kit
 
 import (
-	templateFileAssets "github.com/niiigoo/hawk/kit/template"
+	"bytes"
+	"errors"
+	"github.com/niiigoo/hawk/kit/generic"
+	tplFiles "github.com/niiigoo/hawk/kit/template"
 	"github.com/niiigoo/hawk/kit/testHelper"
 	parser2 "github.com/niiigoo/hawk/proto"
 	"go/format"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -29,22 +33,29 @@ func init() {
 	}
 	tmp.generator = generator{
 		protoService: tmp.parser,
+		repo:         NewRepository(),
 	}
 	log.SetLevel(log.DebugLevel)
 }
 
 func TestTemplatePathToActual(t *testing.T) {
 	pathToWants := map[string]string{
-		"NAME-service/":                "package-service/",
-		"NAME-service/test.gotemplate": "package-service/test.go",
-		"NAME-service/NAME":            "package-service/package",
+		"NAME-service/":             "package-service/",
+		"NAME-service/test.go.tpl":  "package-service/test.go",
+		"NAME-service/NAME":         "package-service/package",
+		"cmd/NAME/main.go.tpl":      "cmd/package/main.go",
+		"handlers/handlers.go.tpl":  "handlers/handlers.go",
+		"svc/transport_http.go.tpl": "svc/transport_http.go",
 	}
 
 	for path, want := range pathToWants {
-		if got := tmp.generator.templatePathToActual(path, "package"); got != want {
+		if got := tmp.generator.templatePathToActual(path, "package", ""); got != want {
 			t.Fatalf("\n`%v` got\n`%v` wanted", got, want)
 		}
 	}
+	if got, want := tmp.generator.templatePathToActual("svc/endpoints.go.tpl", "package", "admin"), "svc/admin/endpoints.go"; got != want {
+		t.Fatalf("\n`%v` got\n`%v` wanted", got, want)
+	}
 }
 
 func TestApplyTemplateFromPath(t *testing.T) {
@@ -82,17 +93,14 @@ func TestApplyTemplateFromPath(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	conf := gengokit.Config{
+	conf := generic.Config{
 		GoPackage: "github.com/metaverse/truss",
 		PBPackage: "github.com/niiigoo/hawk/kit/gengokit/general-service",
 	}
 
-	te, err := gengokit.NewData(tmp.parser.Definition(), conf)
-	if err != nil {
-		t.Fatal(err)
-	}
+	te := generic.NewData(tmp.parser.Definition().Services[0], conf)
 
-	end, err := tmp.generator.applyTemplateFromPath("svc/endpoints.go.tpl", te)
+	end, err := tmp.generator.repo.GenerateFile("svc/endpoints.go.tpl", nil, te)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -117,25 +125,6 @@ func svcMethodsNames(methods []*parser2.Method) []string {
 	return mNames
 }
 
-func stringToTemplateExector(def, importPath string) (*gengokit.Data, error) {
-	err := tmp.parser.ParseString(def)
-	if err != nil {
-		return nil, err
-	}
-
-	conf := gengokit.Config{
-		GoPackage: importPath,
-		PBPackage: importPath,
-	}
-
-	te, err := gengokit.NewData(tmp.parser.Definition(), conf)
-	if err != nil {
-		return nil, err
-	}
-
-	return te, nil
-}
-
 func TestAllTemplates(t *testing.T) {
 	const goPackage = "github.com/niiigoo/hawk/kit/gengokit"
 	const goPBPackage = "github.com/niiigoo/hawk/kit/gengokit/general-service"
@@ -213,67 +202,42 @@ func TestAllTemplates(t *testing.T) {
 	}
 	sd1 := tmp.parser.Definition()
 
-	err = tmp.parser.ParseString(def)
+	err = tmp.parser.ParseString(def2)
 	if err != nil {
 		t.Fatal(err)
 	}
 	sd2 := tmp.parser.Definition()
 
-	conf := gengokit.Config{
-		GoPackage: "github.com/niiigoo/hawk/kit/gengokit",
-		PBPackage: "github.com/niiigoo/hawk/kit/gengokit/general-service",
+	conf := generic.Config{
+		GoPackage: goPackage,
+		PBPackage: goPBPackage,
 	}
 
-	data1, err := gengokit.NewData(sd1, conf)
+	firstCode, err := testGenerateFiles(conf, sd1, nil)
 	if err != nil {
-		t.Fatal(err)
+		t.Fatalf("failed to format on first generation\n\nERROR:\n\n%s", err)
 	}
 
-	data2, err := gengokit.NewData(sd2, conf)
+	secondCode, err := testGenerateFiles(conf, sd1, firstCode)
 	if err != nil {
-		t.Fatal(err)
+		t.Fatalf("failed to format on second identical generation\n\nERROR: %s", err)
 	}
-
-	for _, templFP := range templateFileAssets.AssetNames() {
-		var prev io.Reader
-
-		firstCode, err := testGenerateResponseFile(templFP, data1, prev)
-		if err != nil {
-			t.Fatalf("%s failed to format on first generation\n\nERROR:\n\n%s\n\nCODE:\n\n%s", templFP, err, firstCode)
-		}
-
-		// store the file to pass back to testGenerateResponseFile for second generation
-		prev = strings.NewReader(firstCode)
-
-		secondCode, err := testGenerateResponseFile(templFP, data1, prev)
-		if err != nil {
-			t.Fatalf("%s failed to format on second identical generation\n\nERROR: %s\nCODE:\n\n%s",
-				templFP, err, secondCode)
-		}
-
-		if len(firstCode) != len(secondCode) {
-			t.Fatal("Generated code differs after regeneration with same definition\n" + diff(firstCode, secondCode))
-		}
-
-		// store the file to pass back to testGenerateResponseFile for third generation
-		prev = strings.NewReader(secondCode)
-
-		// pass in data2 created from def2
-		addRPCCode, err := testGenerateResponseFile(templFP, data2, prev)
-		if err != nil {
-			t.Fatalf("%s failed to format on third generation with 1 rpc added\n\nERROR: %s\nCODE:\n\n%s",
-				templFP, err, addRPCCode)
+	for name, code := range firstCode {
+		if code != secondCode[name] {
+			t.Fatalf("Generated code of %s differs after regeneration with same definition\n%s", name, diff(code, secondCode[name]))
 		}
+	}
 
-		// store the file to pass back to testGenerateResponseFile for fourth generation
-		prev = strings.NewReader(addRPCCode)
+	// pass in sd2 created from def2
+	addRPCCode, err := testGenerateFiles(conf, sd2, secondCode)
+	if err != nil {
+		t.Fatalf("failed to format on third generation with 1 rpc added\n\nERROR: %s", err)
+	}
 
-		// pass in data1  create from def1
-		_, err = testGenerateResponseFile(templFP, data1, prev)
-		if err != nil {
-			t.Fatalf("%s failed to format on fourth generation with 1 rpc removed\n\nERROR: %s\nCODE:\n\n%s",
-				templFP, err, addRPCCode)
-		}
+	// pass in sd1 created from def
+	_, err = testGenerateFiles(conf, sd1, addRPCCode)
+	if err != nil {
+		t.Fatalf("failed to format on fourth generation with 1 rpc removed\n\nERROR: %s", err)
 	}
 }
 
@@ -284,29 +248,33 @@ func diff(a, b string) string {
 	)
 }
 
-// testGenerateResponseFile reads the output of generateResponseFile into a
-// string which it returns as this logic needs to be repeated in tests. In
-// addition, this function will return an error if the code fails to format,
-// while generateResponseFile will not.
-func testGenerateResponseFile(templPath string, data *gengokit.Data, prev io.Reader) (string, error) {
-	code, err := tmp.generator.generateResponseFile(templPath, data, prev)
-	if err != nil {
-		return "", err
+// testGenerateFiles generates the service of def, prev are the previously
+// generated files. An error is returned if a Go file fails to format.
+func testGenerateFiles(conf generic.Config, def *parser2.Definition, prev map[string]string) (map[string]string, error) {
+	conf.PreviousFiles = make(map[string]io.Reader, len(prev))
+	for name, code := range prev {
+		conf.PreviousFiles[name] = strings.NewReader(code)
 	}
-
-	// read the code off the io.Reader
-	codeBytes, err := io.ReadAll(code)
+	files, err := tmp.generator.generateGoKit(conf, def.Services)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	// format the code
-	formatted, err := testFormat(string(codeBytes))
-	if err != nil {
-		return string(codeBytes), err
+	res := make(map[string]string, len(files))
+	for name, file := range files {
+		code := new(bytes.Buffer)
+		if _, err = io.Copy(code, file); err != nil {
+			return nil, err
+		}
+		res[name] = code.String()
+		if !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if _, err = testFormat(code.String()); err != nil {
+			return nil, err
+		}
 	}
-
-	return formatted, nil
+	return res, nil
 }
 
 // testFormat takes a string representing golang code and attempts to return a
@@ -320,3 +288,347 @@ func testFormat(code string) (string, error) {
 
 	return string(formatted), nil
 }
+
+// offlineRepository does not update the dependencies of the generated
+// module, the tests do not download modules.
+type offlineRepository struct {
+	Repository
+}
+
+func (offlineRepository) GoModTidy(string) error {
+	return nil
+}
+
+const greeterProto = `syntax = "proto3";
+package greeter;
+option go_package = ".;greeter";
+import "google/api/annotations.proto";
+
+message HelloRequest {
+	string name = 1;
+}
+message HelloResponse {
+	string message = 1;
+}
+service Greeter {
+	rpc Hello(HelloRequest) returns (HelloResponse) {
+		option (google.api.http) = {
+			get: "/hello/{name}"
+		};
+	}
+}
+`
+
+// newTestProject writes the files to a temporary directory and returns a
+// generator of the service located in out, relative to the directory.
+func newTestProject(t *testing.T, out string, files map[string]string) (*generator, string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g := &generator{
+		protoService: parser2.NewService(),
+		repo:         offlineRepository{NewRepository()},
+	}
+	if err := g.SetOutput(filepath.Join(dir, filepath.FromSlash(out))); err != nil {
+		t.Fatal(err)
+	}
+	return g, dir
+}
+
+func TestService_DryRun(t *testing.T) {
+	g, dir := newTestProject(t, ".", map[string]string{
+		"go.mod":        "module example.com/greeter\n\ngo 1.22\n",
+		"greeter.proto": greeterProto,
+	})
+	proto := filepath.Join(dir, "greeter.proto")
+
+	out := new(bytes.Buffer)
+	g.SetDryRun(out, false)
+	if err := g.Service(proto); !errors.Is(err, ErrChanges) {
+		t.Fatalf("Dry run of a new service returned %v, want %v", err, ErrChanges)
+	}
+	for _, line := range []string{"new: greeter.pb.go\n", "new: handlers/handlers.go\n", "new: svc/endpoints.go\n"} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("Output of the dry run does not contain %q:\n%s", line, out)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "svc")); !os.IsNotExist(err) {
+		t.Errorf("Dry run wrote files: %v", err)
+	}
+
+	g.SetDryRun(nil, false)
+	if err := g.Service(proto); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	g.SetDryRun(out, true)
+	if err := g.Service(proto); err != nil {
+		t.Fatalf("Dry run of an up-to-date service returned %v", err)
+	}
+	if out.Len() > 0 {
+		t.Errorf("Dry run of an up-to-date service printed:\n%s", out)
+	}
+
+	endpoints := filepath.Join(dir, "svc", "endpoints.go")
+	code, err := os.ReadFile(endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(endpoints, append(code, "// changed\n"...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = g.Service(proto); !errors.Is(err, ErrChanges) {
+		t.Fatalf("Dry run of a changed service returned %v, want %v", err, ErrChanges)
+	}
+	for _, part := range []string{"--- svc/endpoints.go\n+++ svc/endpoints.go (generated)\n", "\n-// changed\n"} {
+		if !strings.Contains(out.String(), part) {
+			t.Errorf("Diff does not contain %q:\n%s", part, out)
+		}
+	}
+}
+
+func TestPrintChanges(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{"a.txt": "one\ntwo\n", "same.txt": "same\n", "c.txt": "old\n"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string][]byte{
+		"a.txt":    []byte("one\nthree\n"),
+		"b.txt":    []byte("new\n"),
+		"same.txt": []byte("same\n"),
+	}
+
+	tests := []struct {
+		diff bool
+		want string
+	}{
+		{false, "modified: a.txt\nnew: b.txt\nremoved: c.txt\n"},
+		{true, `--- a.txt
++++ a.txt (generated)
+@@ -1,2 +1,2 @@
+ one
+-two
++three
+--- b.txt
++++ b.txt (generated)
+@@ -0,0 +1 @@
++new
+--- c.txt
++++ c.txt (generated)
+@@ -1 +0,0 @@
+-old
+`},
+	}
+	for _, test := range tests {
+		out := new(bytes.Buffer)
+		g := generator{dir: dir, dryRun: out, diff: test.diff}
+		if err := g.printChanges(files, []string{"c.txt", "missing.txt"}); !errors.Is(err, ErrChanges) {
+			t.Errorf("printChanges returned %v, want %v", err, ErrChanges)
+		}
+		if out.String() != test.want {
+			t.Errorf("printChanges (diff: %t) printed\n%s\nwant\n%s", test.diff, out, test.want)
+		}
+	}
+
+	g := generator{dir: dir, dryRun: new(bytes.Buffer)}
+	if err := g.printChanges(map[string][]byte{"same.txt": []byte("same\n")}, nil); err != nil {
+		t.Errorf("printChanges of unchanged files returned %v", err)
+	}
+}
+
+func TestGoPackage(t *testing.T) {
+	dir := filepath.FromSlash("/src/mono")
+	tests := []struct {
+		name       string
+		goPackage  string
+		sub        string
+		importPath string
+		out        string
+		err        bool
+	}{
+		{"service dir", ".;foo", "services/foo", "example.com/mono/services/foo", filepath.Join(dir, "services", "foo"), false},
+		{"relative", "./pb;pb", "services/foo", "example.com/mono/services/foo/pb", filepath.Join(dir, "services", "foo", "pb"), false},
+		{"module root", ".;foo", ".", "example.com/mono", dir, false},
+		{"module package", "example.com/mono/api/foo;foo", "services/foo", "example.com/mono/api/foo", filepath.Join(dir, "api", "foo"), false},
+		{"module prefix", "example.com/monorepo/foo", "services/foo", "example.com/monorepo/foo", "", false},
+		{"external package", "example.com/schema/foo;foo", "services/foo", "example.com/schema/foo", "", false},
+		{"missing", "", "services/foo", "", "", true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src := "syntax = \"proto3\";\npackage foo;\n"
+			if test.goPackage != "" {
+				src += "option go_package = \"" + test.goPackage + "\";\n"
+			}
+			p := parser2.NewService()
+			if err := p.ParseString(src); err != nil {
+				t.Fatal(err)
+			}
+
+			importPath, out, err := goPackage(p.Definition(), "example.com/mono", test.sub, dir)
+			if (err != nil) != test.err {
+				t.Fatalf("goPackage returned error %v, want error: %t", err, test.err)
+			}
+			if importPath != test.importPath || out != test.out {
+				t.Errorf("goPackage = (%q, %q), want (%q, %q)", importPath, out, test.importPath, test.out)
+			}
+		})
+	}
+}
+
+// TestService_Monorepo generates the service located in `services/foo` of a
+// module, `protoc.yaml` is found in the module root.
+func TestService_Monorepo(t *testing.T) {
+	g, dir := newTestProject(t, "services/foo", map[string]string{
+		"go.mod":      "module example.com/mono\n\ngo 1.22\n",
+		"protoc.yaml": "imports:\n  - api\ntemplates: templates\n",
+		"api/common/common.proto": `syntax = "proto3";
+package common;
+option go_package = "example.com/mono/api/common;common";
+
+message Page {
+	int32 size = 1;
+}
+`,
+		"templates/NOTES.md.tpl": "overlay of the module\n",
+		"services/foo/foo.proto": strings.Replace(greeterProto, `".;greeter"`, `"./pb;greeter"`, 1) +
+			"import \"common/common.proto\";\nmessage Paged {\n\tcommon.Page page = 1;\n}\n",
+	})
+	// the overlay is used by the following tests otherwise
+	t.Cleanup(func() {
+		tplFiles.SetOverlay("")
+	})
+	if err := g.Service(filepath.Join(dir, "services", "foo", "foo.proto")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"pb/foo.pb.go", "pb/foo_grpc.pb.go", "svc/endpoints.go", "NOTES.md"} {
+		if _, err := os.Stat(filepath.Join(dir, "services", "foo", filepath.FromSlash(name))); err != nil {
+			t.Errorf("File %s not generated: %v", name, err)
+		}
+	}
+	assertFile(t, filepath.Join(dir, "services", "foo", "NOTES.md"), "overlay of the module\n")
+}
+
+// commonProto declares messages in another Go package of the module, they are
+// used by importingProto.
+const commonProto = `syntax = "proto3";
+package common;
+option go_package = "example.com/greeter/common;common";
+
+message Page {
+	int32 size = 1;
+}
+`
+
+const importingProto = `syntax = "proto3";
+package greeter;
+option go_package = "./pb;pb";
+import "google/api/annotations.proto";
+import "google/protobuf/empty.proto";
+import "common/common.proto";
+
+service Greeter {
+	rpc List(common.Page) returns (common.Page) {
+		option (google.api.http) = {
+			get: "/pages/{size}"
+		};
+	}
+	rpc Watch(common.Page) returns (stream common.Page) {}
+	rpc Ping(google.protobuf.Empty) returns (google.protobuf.Empty) {
+		option (google.api.http) = {
+			post: "/ping"
+			body: "*"
+		};
+	}
+}
+`
+
+// TestService_Build generates services referencing types which are not
+// declared at the top level of the proto file of the service and builds them.
+func TestService_Build(t *testing.T) {
+	if testing.Short() {
+		t.Skip("downloads the dependencies of the generated service")
+	}
+	hawk, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod := "module example.com/greeter\n\ngo 1.22\n\n" +
+		"require (\n\tgithub.com/niiigoo/hawk v0.0.0\n\tgithub.com/niiigoo/hawk/pkg v0.0.0\n)\n\n" +
+		"replace github.com/niiigoo/hawk => " + hawk + "\n\n" +
+		"replace github.com/niiigoo/hawk/pkg => " + filepath.Join(hawk, "pkg") + "\n"
+
+	tests := []struct {
+		name  string
+		files map[string]string
+		args  []string
+	}{
+		{
+			name: "nested messages",
+			files: map[string]string{
+				"greeter.proto": `syntax = "proto3";
+package greeter;
+option go_package = ".;greeter";
+import "google/api/annotations.proto";
+
+message Outer {
+	message Inner {
+		string name = 1;
+	}
+}
+service Greeter {
+	rpc Hello(Outer.Inner) returns (Outer.Inner) {
+		option (google.api.http) = {
+			get: "/hello/{name}"
+		};
+	}
+	rpc Watch(greeter.Outer.Inner) returns (stream Outer.Inner) {}
+}
+`,
+			},
+			args: []string{"greeter.proto"},
+		},
+		{
+			name:  "imported messages",
+			files: map[string]string{"common/common.proto": commonProto, "greeter.proto": importingProto},
+			args:  []string{"greeter.proto"},
+		},
+		{
+			name:  "imported messages passed",
+			files: map[string]string{"common/common.proto": commonProto, "greeter.proto": importingProto},
+			args:  []string{"greeter.proto", "common/common.proto"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.files["go.mod"] = goMod
+			g, dir := newTestProject(t, ".", test.files)
+			g.repo = NewRepository()
+			args := make([]string, len(test.args))
+			for i, arg := range test.args {
+				args[i] = filepath.Join(dir, filepath.FromSlash(arg))
+			}
+			if err := g.Service(args...); err != nil {
+				t.Fatalf("Service failed: %v", err)
+			}
+
+			cmd := exec.Command("go", "build", "./...")
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("go build failed: %v\n%s", err, out)
+			}
+		})
+	}
+}
@@ -1,10 +1,18 @@
-package handlers
+package {{.HandlersPackage}}
+
+{{- $unary := false}}
+{{- range .Service.Methods}}{{if not .Streaming}}{{$unary = true}}{{end}}{{end}}
 
 import (
+	{{- if $unary}}
 	"context"
+	{{- end}}
 	"github.com/sirupsen/logrus"
 
 	pb "{{.PBImportPath -}}"
+	{{- range $alias, $path := .Service.GoImports}}
+	{{$alias}} "{{$path}}"
+	{{- end}}
 )
 
 var Logger *logrus.Entry
@@ -29,12 +37,12 @@ type {{ToLower .Service.Name}}Service struct{
                 return nil
             }
 		{{ else if $i.ResponseStream }}
-            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(in *pb.{{GoName .Request}}, stream pb.{{GoName $te.Service.Name}}_{{GoName .Name}}Server) error {
+            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(in *{{.GoRequest}}, stream pb.{{GoName $te.Service.Name}}_{{GoName .Name}}Server) error {
                 return nil
             }
 		{{ else }}
-            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(ctx context.Context, in *pb.{{GoName .Request}}) (*pb.{{GoName .Response}}, error){
-                var resp pb.{{GoName .Response}}
+            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(ctx context.Context, in *{{.GoRequest}}) (*{{.GoResponse}}, error){
+                var resp {{.GoResponse}}
                 return &resp, nil
             }
 		{{ end }}
@@ -6,12 +6,12 @@
                 return nil
             }
 		{{ else if $i.ResponseStream }}
-            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(in *pb.{{GoName .Request}}, stream pb.{{GoName $te.ServiceName}}_{{GoName .Name}}Server) error {
+            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(in *{{.GoRequest}}, stream pb.{{GoName $te.ServiceName}}_{{GoName .Name}}Server) error {
                 return nil
             }
 		{{ else }}
-            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(ctx context.Context, in *pb.{{GoName .Request}}) (*pb.{{GoName .Response}}, error){
-                var resp pb.{{GoName .Response}}
+            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(ctx context.Context, in *{{.GoRequest}}) (*{{.GoResponse}}, error){
+                var resp {{.GoResponse}}
                 return &resp, nil
             }
 		{{ end }}
@@ -1,9 +1,9 @@
-package handlers
+package {{.HandlersPackage}}
 
 import (
 	"context"
 	pb "{{.PBImportPath -}}"
-	"{{.ImportPath -}} /svc"
+	svc "{{.SvcImportPath}}"
 	"net/url"
 	"strings"
 
@@ -34,6 +34,11 @@ func WrapEndpoints(service pb.{{.Service.Name}}Server, in svc.Endpoints) svc.End
 	// github.com/niiigoo/hawk/middleware/endpoint.go for an example.
 	// in.WrapAllLabeledExcept(errorCounter(statsdCounter), "Status", "Ping")
 
+	// The streaming methods have their own endpoints, pass a svc.StreamMiddleware
+	// or a svc.LabeledStreamMiddleware to wrap them. The middleware may replace
+	// the context, it is passed to the handler as the context of the stream.
+	// in.WrapAllStreamsLabeledExcept(streamCounter(statsdCounter), "Watch")
+
 	// How to apply a middleware to a single endpoint.
 	// in.ExampleEndpoint = authMiddleware(in.ExampleEndpoint)
 
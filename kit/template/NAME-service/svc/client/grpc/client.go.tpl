@@ -12,16 +12,21 @@ import (
 	"google.golang.org/grpc/metadata"
 	"github.com/pkg/errors"
 
-	"github.com/go-kit/kit/endpoint"
 	grpctransport "github.com/go-kit/kit/transport/grpc"
 
 	// This Service
-	"{{.ImportPath -}} /svc"
+	svc "{{.SvcImportPath}}"
 	pb "{{.PBImportPath -}}"
+	{{- range $alias, $path := .Service.GoImports}}
+	{{$alias}} "{{$path}}"
+	{{- end}}
 )
 
 // New returns an service backed by a gRPC client connection. It is the
 // responsibility of the caller to dial, and later close, the connection.
+{{- if .Service.StreamingUsed}}
+// The streaming methods are not available, use NewStreamClient instead.
+{{- end}}
 func New(conn *grpc.ClientConn, options ...ClientOption) (pb.{{.Service.Name}}Server, error) {
 	var cc clientConfig
 
@@ -32,62 +37,116 @@ func New(conn *grpc.ClientConn, options ...ClientOption) (pb.{{.Service.Name}}Se
 		}
 	}
 
-	clientOptions := []grpctransport.ClientOption{
-		grpctransport.ClientBefore(
-			contextValuesToGRPCMetadata(cc.headers)),
-	}
+	endpoints := svc.NewEndpoints()
 	{{- with $te := .}}
-		{{- with $pkgName := $te.PackageName}}
-			{{- range $i := $te.Service.Methods}}
-				var {{ToLower $i.Name}}Endpoint endpoint.Endpoint
-				{
-					{{ToLower $i.Name}}Endpoint = grpctransport.NewClient(
-						conn,
-						"{{$pkgName}}.{{$te.Service.Name}}",
-						"{{$i.Name}}",
-						EncodeGRPC{{$i.Name}}Request,
-						DecodeGRPC{{$i.Name}}Response,
-						pb.{{GoName $i.Response}}{},
-						clientOptions...,
-					).Endpoint()
-				}
-			{{end}}
-		{{end}}
-	{{end}}
-
-    endpoints := svc.NewEndpoints()
-	{{range $i := .Service.Methods -}}
-		endpoints.{{$i.Name}}Endpoint = {{ToLower $i.Name}}Endpoint
-	{{end}}
+		{{- range $i := $te.Service.Methods}}{{if not $i.Streaming}}
+			endpoints.{{$i.Name}}Endpoint = grpctransport.NewClient(
+				conn,
+				"{{with $te.PackageName}}{{.}}.{{end}}{{$te.Service.Name}}",
+				"{{$i.Name}}",
+				EncodeGRPC{{$i.Name}}Request,
+				DecodeGRPC{{$i.Name}}Response,
+				{{$i.GoResponse}}{},
+				cc.clientOptions()...,
+			).Endpoint()
+		{{- end}}{{end}}
+	{{- end}}
 
 	return endpoints, nil
 }
+{{- if .Service.StreamingUsed}}
+
+// StreamClient provides the streaming methods of the {{.Service.Name}} service.
+// The context values selected by CtxValuesToSend are sent as metadata, like
+// for the service returned by New.
+type StreamClient interface {
+	{{- range $i := .Service.Methods}}
+		{{- if $i.RequestStream}}
+			{{$i.Name}}(ctx context.Context, opts ...grpc.CallOption) (pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Client, error)
+		{{- else if $i.ResponseStream}}
+			{{$i.Name}}(ctx context.Context, in *{{$i.GoRequest}}, opts ...grpc.CallOption) (pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Client, error)
+		{{- end}}
+	{{- end}}
+}
+
+// NewStreamClient returns the streaming methods backed by a gRPC client
+// connection. It is the responsibility of the caller to dial, and later close,
+// the connection.
+func NewStreamClient(conn *grpc.ClientConn, options ...ClientOption) (StreamClient, error) {
+	var cc clientConfig
+
+	for _, f := range options {
+		err := f(&cc)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot apply option")
+		}
+	}
+
+	return &streamClient{
+		client:  pb.New{{GoName .Service.Name}}Client(conn),
+		headers: cc.headers,
+	}, nil
+}
+
+type streamClient struct {
+	client  pb.{{GoName .Service.Name}}Client
+	headers []string
+}
 
-// GRPC Client Decode
 {{range $i := .Service.Methods}}
+	{{- if $i.RequestStream}}
+		func (c *streamClient) {{$i.Name}}(ctx context.Context, opts ...grpc.CallOption) (pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Client, error) {
+			return c.client.{{$i.Name}}(c.outgoingContext(ctx), opts...)
+		}
+	{{else if $i.ResponseStream}}
+		func (c *streamClient) {{$i.Name}}(ctx context.Context, in *{{$i.GoRequest}}, opts ...grpc.CallOption) (pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Client, error) {
+			return c.client.{{$i.Name}}(c.outgoingContext(ctx), in, opts...)
+		}
+	{{end}}
+{{- end}}
+
+// outgoingContext adds the context values selected by CtxValuesToSend to the
+// outgoing metadata.
+func (c *streamClient) outgoingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	contextValuesToGRPCMetadata(c.headers)(ctx, &md)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+{{- end}}
+
+// GRPC Client Decode
+{{range $i := .Service.Methods}}{{if not $i.Streaming}}
 // DecodeGRPC{{$i.Name}}Response is a transport/grpc.DecodeResponseFunc that converts a
 // gRPC {{ToLower $i.Name}} reply to a user-domain {{ToLower $i.Name}} response. Primarily useful in a client.
 func DecodeGRPC{{$i.Name}}Response(_ context.Context, grpcReply interface{}) (interface{}, error) {
-	reply := grpcReply.(*pb.{{GoName $i.Response}})
+	reply := grpcReply.(*{{$i.GoResponse}})
 	return reply, nil
 }
-{{end}}
+{{end}}{{end}}
 
 // GRPC Client Encode
-{{range $i := .Service.Methods}}
+{{range $i := .Service.Methods}}{{if not $i.Streaming}}
 // EncodeGRPC{{$i.Name}}Request is a transport/grpc.EncodeRequestFunc that converts a
 // user-domain {{ToLower $i.Name}} request to a gRPC {{ToLower $i.Name}} request. Primarily useful in a client.
 func EncodeGRPC{{$i.Name}}Request(_ context.Context, request interface{}) (interface{}, error) {
-	req := request.(*pb.{{GoName $i.Request}})
+	req := request.(*{{$i.GoRequest}})
 	return req, nil
 }
-{{end}}
+{{end}}{{end}}
 
 
 type clientConfig struct {
 	headers []string
 }
 
+func (cc clientConfig) clientOptions() []grpctransport.ClientOption {
+	return []grpctransport.ClientOption{
+		grpctransport.ClientBefore(
+			contextValuesToGRPCMetadata(cc.headers)),
+	}
+}
+
 // ClientOption is a function that modifies the client config
 type ClientOption func(*clientConfig) error
 
@@ -3,7 +3,7 @@
 // Version: {{.Version}}
 // Version Date: {{.VersionDate}}
 
-package svc
+package {{.SvcPackage}}
 
 // This file contains methods to make individual endpoints from services,
 // request and response types to serve those endpoints, as well as encoders and
@@ -17,8 +17,12 @@ import (
 	transport "github.com/go-kit/kit/transport/http"
 
 	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc"
 
 	pb "{{.PBImportPath -}}"
+	{{- range $alias, $path := .Service.GoImports}}
+	{{$alias}} "{{$path}}"
+	{{- end}}
 )
 
 // Endpoints collects all of the endpoints that compose an add service. It's
@@ -42,12 +46,25 @@ type Endpoints struct {
 	httpHandlerFuncs     map[string]func(http.ResponseWriter, *http.Request)
 
 {{range $i := .Service.Methods}}
-	{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
+	{{ if $i.Streaming }}
+		{{$i.Name}}Endpoint	StreamEndpoint
+	{{ else }}
 		{{$i.Name}}Endpoint	endpoint.Endpoint
 	{{ end }}
 {{- end}}
 }
 
+// StreamEndpoint is the endpoint of a streaming method. The request is the
+// first message of a server-streaming method and nil otherwise, the stream
+// is the gRPC stream of the method, e.g. pb.{{GoName .Service.Name}}_MethodServer.
+// Middlewares may replace the context, the handler receives it as the context
+// of its stream.
+type StreamEndpoint func(ctx context.Context, request interface{}, stream grpc.ServerStream) error
+
+// StreamMiddleware is a chainable behavior modifier for a StreamEndpoint,
+// like endpoint.Middleware for unary endpoints.
+type StreamMiddleware func(StreamEndpoint) StreamEndpoint
+
 func NewEndpoints() Endpoints {
 	return Endpoints{
 		httpServerOptions:	  make(map[string][]transport.ServerOption),
@@ -60,12 +77,26 @@ func NewEndpoints() Endpoints {
 // Endpoints
 {{range $i := .Service.Methods}}
 	{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-		func (e Endpoints) {{$i.Name}}(ctx context.Context, in *pb.{{GoName $i.Request}}) (*pb.{{GoName $i.Response}}, error) {
+		func (e Endpoints) {{$i.Name}}(ctx context.Context, in *{{$i.GoRequest}}) (*{{$i.GoResponse}}, error) {
 			response, err := e.{{$i.Name}}Endpoint(ctx, in)
 			if err != nil {
 				return nil, err
 			}
-			return response.(*pb.{{GoName $i.Response}}), nil
+			return response.(*{{$i.GoResponse}}), nil
+		}
+	{{ else if $i.RequestStream }}
+		func (e Endpoints) {{$i.Name}}(stream pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Server) error {
+			if e.{{$i.Name}}Endpoint == nil {
+				return e.Unimplemented{{GoName $.Service.Name}}Server.{{$i.Name}}(stream)
+			}
+			return e.{{$i.Name}}Endpoint(stream.Context(), nil, stream)
+		}
+	{{ else }}
+		func (e Endpoints) {{$i.Name}}(in *{{$i.GoRequest}}, stream pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Server) error {
+			if e.{{$i.Name}}Endpoint == nil {
+				return e.Unimplemented{{GoName $.Service.Name}}Server.{{$i.Name}}(in, stream)
+			}
+			return e.{{$i.Name}}Endpoint(stream.Context(), in, stream)
 		}
 	{{ end }}
 {{end}}
@@ -76,7 +107,7 @@ func NewEndpoints() Endpoints {
 		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 			func Make{{$i.Name}}Endpoint(s pb.{{$te.Service.Name}}Server) endpoint.Endpoint {
 				return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-					req := request.(*pb.{{GoName $i.Request}})
+					req := request.(*{{$i.GoRequest}})
 					v, err := s.{{$i.Name}}(ctx, req)
 					if err != nil {
 						return nil, err
@@ -84,10 +115,34 @@ func NewEndpoints() Endpoints {
 					return v, nil
 				}
 			}
+		{{ else }}
+			func Make{{$i.Name}}Endpoint(s pb.{{$te.Service.Name}}Server) StreamEndpoint {
+				return func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+					wrapped := &grpc.GenericServerStream[{{$i.GoRequest}}, {{$i.GoResponse}}]{
+						ServerStream: contextStream{ServerStream: stream, ctx: ctx},
+					}
+					{{- if $i.RequestStream}}
+						return s.{{$i.Name}}(wrapped)
+					{{- else}}
+						return s.{{$i.Name}}(request.(*{{$i.GoRequest}}), wrapped)
+					{{- end}}
+				}
+			}
 		{{ end }}
 	{{end}}
 {{end}}
 
+// contextStream replaces the context of a gRPC stream by the one passed
+// through the stream middlewares.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
 // WrapAllExcept wraps each Endpoint field of struct Endpoints with a
 // go-kit/kit/endpoint.Middleware.
 // Use this for applying a set of middlewares to every endpoint in the service.
@@ -110,13 +165,14 @@ func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...st
 	}
 
 	for inc := range included {
+		switch inc {
 		{{- range $i := .Service.Methods}}
-			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-				if inc == "{{$i.Name}}" {
+			{{- if not $i.Streaming }}
+				case "{{$i.Name}}":
 					e.{{$i.Name}}Endpoint = middleware(e.{{$i.Name}}Endpoint)
-				}
-			{{ end }}
+			{{- end }}
 		{{- end}}
+		}
 	}
 }
 
@@ -146,14 +202,60 @@ func (e *Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoi
 	}
 
 	for inc := range included {
+		switch inc {
 		{{- range $i := .Service.Methods}}
-			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-				if inc == "{{$i.Name}}" {
+			{{- if not $i.Streaming }}
+				case "{{$i.Name}}":
 					e.{{$i.Name}}Endpoint = middleware("{{$i.Name}}", e.{{$i.Name}}Endpoint)
-				}
+			{{- end }}
+		{{- end}}
+		}
+	}
+}
+
+// WrapAllStreamsExcept wraps each StreamEndpoint field of struct Endpoints
+// with a StreamMiddleware. See method WrapAllExcept for details on excluded
+// functionality.
+// WrapAllStreamsExcept(middleware, "Watch")
+func (e *Endpoints) WrapAllStreamsExcept(middleware StreamMiddleware, excluded ...string) {
+	e.WrapAllStreamsLabeledExcept(func(_ string, next StreamEndpoint) StreamEndpoint {
+		return middleware(next)
+	}, excluded...)
+}
+
+// LabeledStreamMiddleware will get passed the endpoint name when passed to
+// WrapAllStreamsLabeledExcept, like LabeledMiddleware for unary endpoints.
+type LabeledStreamMiddleware func(string, StreamEndpoint) StreamEndpoint
+
+// WrapAllStreamsLabeledExcept wraps each StreamEndpoint field of struct
+// Endpoints with a LabeledStreamMiddleware, which will receive the name of the
+// endpoint. See method WrapAllExcept for details on excluded functionality.
+func (e *Endpoints) WrapAllStreamsLabeledExcept(middleware func(string, StreamEndpoint) StreamEndpoint, excluded ...string) {
+	included := map[string]struct{}{
+		{{- range $i := .Service.Methods}}
+			{{ if $i.Streaming }}
+				"{{$i.Name}}": {},
 			{{ end }}
 		{{- end}}
 	}
+
+	for _, ex := range excluded {
+		if _, ok := included[ex]; !ok {
+			panic(fmt.Sprintf("Excluded endpoint '%s' does not exist; see middlewares/endpoints.go", ex))
+		}
+		delete(included, ex)
+	}
+
+	for inc := range included {
+		switch inc {
+		{{- range $i := .Service.Methods}}
+			{{- if $i.Streaming }}
+				case "{{$i.Name}}":
+					e.{{$i.Name}}Endpoint = middleware("{{$i.Name}}", e.{{$i.Name}}Endpoint)
+			{{- end }}
+		{{- end}}
+		}
+	}
 }
 
 // WrapAllWithHttpOptionExcept wraps each Endpoint entry of filed HttpServerOptions of struct Endpoints with a
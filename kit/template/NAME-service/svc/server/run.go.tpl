@@ -7,6 +7,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"github.com/sirupsen/logrus"
@@ -24,6 +25,15 @@ import (
 	pb "{{.PBImportPath -}}"
 	"{{.ImportPath -}} /handlers"
 	"{{.ImportPath -}} /svc"
+	{{- range .Services}}
+		{{- if .Alias}}
+			{{.Alias}}handlers "{{.HandlersImportPath}}"
+			{{.Alias}}svc "{{.SvcImportPath}}"
+		{{- end}}
+	{{- end}}
+	{{- if gt (len .Services) 1}}
+		"github.com/gorilla/mux"
+	{{- end}}
 )
 
 var DefaultConfig svc.Config
@@ -44,53 +54,49 @@ func init() {
 	}
 }
 
-func NewEndpoints(service pb.{{.Service.Name}}Server) svc.Endpoints {
+{{range $s := .Services}}
+// New{{GoName .Alias}}Endpoints creates the endpoints of the {{.Service.Name}} service.
+func New{{GoName .Alias}}Endpoints(service pb.{{.Service.Name}}Server) {{.Alias}}svc.Endpoints {
 	// Business domain.
 
-	// Wrap Service with middlewares. See handlers/middlewares.go
-	service = handlers.WrapService(service)
+	// Wrap Service with middlewares. See handlers/{{with .Alias}}{{.}}/{{end}}middlewares.go
+	service = {{.Alias}}handlers.WrapService(service)
 
 	// Endpoint domain.
 	var (
 	{{range $i := .Service.Methods -}}
-		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-			{{ToLower $i.Name}}Endpoint = svc.Make{{$i.Name}}Endpoint(service)
-		{{ end }}
+		{{ToLower $i.Name}}Endpoint = {{$s.Alias}}svc.Make{{$i.Name}}Endpoint(service)
 	{{end}}
 	)
 
-	endpoints := svc.NewEndpoints()
+	endpoints := {{.Alias}}svc.NewEndpoints()
 	{{range $i := .Service.Methods -}}
-		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-			endpoints.{{$i.Name}}Endpoint = {{ToLower $i.Name}}Endpoint
-		{{ end }}
+		endpoints.{{$i.Name}}Endpoint = {{ToLower $i.Name}}Endpoint
 	{{end}}
 
-	// Wrap selected Endpoints with middlewares. See handlers/middlewares.go
-	endpoints = handlers.WrapEndpoints(service, endpoints)
+	// Wrap selected Endpoints with middlewares. See handlers/{{with .Alias}}{{.}}/{{end}}middlewares.go
+	endpoints = {{.Alias}}handlers.WrapEndpoints(service, endpoints)
 
 	return endpoints
 }
+{{end}}
 
 // Run starts a new http server, gRPC server, and a debug server with the
 // passed config and logger
 func Run(cfg svc.Config) {
-	service := handlers.NewService()
-	endpoints := NewEndpoints(service)
-
-	if cfg.GenericHTTPResponseEncoder == nil {
-		cfg.GenericHTTPResponseEncoder = svc.EncodeHTTPGenericResponse
-	}
-
-	// WebSocket config
-	wsCfg := svc.WebSocketConfig{
-		Guard: func(ctx context.Context, r *http.Request) (context.Context, error) {
-			return handlers.WebSocketGuard(ctx, service, r)
-		},
-		OriginChecker: func(r *http.Request) bool {
-			return handlers.WebSocketOriginChecker(service, r)
-		},
-	}
+	{{- range .Services}}
+		service{{GoName .Alias}} := {{.Alias}}handlers.NewService()
+		endpoints{{GoName .Alias}} := New{{GoName .Alias}}Endpoints(service{{GoName .Alias}})
+	{{- end}}
+	logger := {{(index .Services 0).Alias}}handlers.Logger
+
+	buildInfo := svc.ReadBuildInfo()
+	logger.WithFields(logrus.Fields{
+		"version":  buildInfo.Version,
+		"hawk":     buildInfo.HawkVersion,
+		"proto":    buildInfo.ProtoRevision,
+		"revision": buildInfo.Revision,
+	}).Info("starting")
 
 	// Mechanical domain.
 	errc := make(chan error)
@@ -100,7 +106,7 @@ func Run(cfg svc.Config) {
 
 	// Debug listener.
 	go func() {
-		handlers.Logger.WithFields(logrus.Fields{
+		logger.WithFields(logrus.Fields{
 			"layer": "debug",
 			"addr":	 cfg.DebugAddr,
 		}).Info("listening")
@@ -111,6 +117,10 @@ func Run(cfg svc.Config) {
 		m.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 		m.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 		m.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
+		m.Handle("/debug/buildinfo", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(buildInfo)
+		}))
 
 		errc <- http.ListenAndServe(cfg.DebugAddr, m)
 	}()
@@ -118,7 +128,7 @@ func Run(cfg svc.Config) {
 	// HTTP, gRPC and WebSocket listener.
 	l, err := net.Listen("tcp", cfg.ServiceAddr)
 	if err != nil {
-		handlers.Logger.WithError(err).Error("service listener error")
+		logger.WithError(err).Error("service listener error")
 		return
 	}
 	defer l.Close()
@@ -130,8 +140,9 @@ func Run(cfg svc.Config) {
 
 	// gRPC transport.
 	s := grpc.NewServer()
-	srv := svc.MakeGRPCServer(endpoints)
-	pb.Register{{.Service.Name}}Server(s, srv)
+	{{- range .Services}}
+		pb.Register{{.Service.Name}}Server(s, {{.Alias}}svc.MakeGRPCServer(endpoints{{GoName .Alias}}))
+	{{- end}}
 	reflection.Register(s)
 
 	go func() {
@@ -142,7 +153,21 @@ func Run(cfg svc.Config) {
 	}()
 
 	// HTTP transport.
-	h := svc.MakeHTTPHandler(handlers.Logger, endpoints, cfg.GenericHTTPResponseEncoder, wsCfg)
+	{{- if gt (len .Services) 1}}
+		h := mux.NewRouter()
+	{{- end}}
+	{{- range .Services}}
+		{{- if or (eq (len $.Services) 1) .Service.HttpExposed}}
+			{{ if gt (len $.Services) 1 }}h.PathPrefix("{{.Service.HttpPathPrefix}}").Handler({{else}}h := {{end}}{{.Alias}}svc.MakeHTTPHandler({{.Alias}}handlers.Logger, endpoints{{GoName .Alias}}, cfg.GenericHTTPResponseEncoder, {{.Alias}}svc.WebSocketConfig{
+				Guard: func(ctx context.Context, r *http.Request) (context.Context, error) {
+					return {{.Alias}}handlers.WebSocketGuard(ctx, service{{GoName .Alias}}, r)
+				},
+				OriginChecker: func(r *http.Request) bool {
+					return {{.Alias}}handlers.WebSocketOriginChecker(service{{GoName .Alias}}, r)
+				},
+			}){{if gt (len $.Services) 1}}){{end}}
+		{{- end}}
+	{{- end}}
 	go func() {
 		errc <- http.Serve(httpListener, h)
 	}()
@@ -152,11 +177,11 @@ func Run(cfg svc.Config) {
 		errc <- tcpMux.Serve()
 	}()
 
-	handlers.Logger.WithFields(logrus.Fields{
+	logger.WithFields(logrus.Fields{
 		"layer": "service",
 		"addr":  cfg.ServiceAddr,
 	}).Info("listening")
 
 	// Run!
-	handlers.Logger.WithError(<-errc).Info("exit")
+	logger.WithError(<-errc).Info("exit")
 }
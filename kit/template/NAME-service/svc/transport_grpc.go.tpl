@@ -3,7 +3,7 @@
 // Version: {{.Version}}
 // Version Date: {{.VersionDate}}
 
-package svc
+package {{.SvcPackage}}
 
 // This file provides server-side bindings for the gRPC transport.
 // It utilizes the transport/grpc.Server.
@@ -12,12 +12,16 @@ import (
 	"net/http"
 	"context"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	grpctransport "github.com/go-kit/kit/transport/grpc"
 
 	// This Service
 	pb "{{.PBImportPath -}}"
+	{{- range $alias, $path := .Service.GoImports}}
+	{{$alias}} "{{$path}}"
+	{{- end}}
 )
 
 // MakeGRPCServer makes a set of endpoints available as a gRPC {{.Service.Name}}Server.
@@ -29,12 +33,16 @@ func MakeGRPCServer(endpoints Endpoints, options ...grpctransport.ServerOption)
 	return &grpcServer{
 	// {{ ToLower .Service.Name }}
 	{{range $i := .Service.Methods}}
-		{{ToLower $i.Name}}: grpctransport.NewServer(
-			endpoints.{{$i.Name}}Endpoint,
-			DecodeGRPC{{$i.Name}}Request,
-			EncodeGRPC{{$i.Name}}Response,
-			serverOptions...,
-		),
+		{{- if $i.Streaming}}
+			{{ToLower $i.Name}}: endpoints.{{$i.Name}}Endpoint,
+		{{- else}}
+			{{ToLower $i.Name}}: grpctransport.NewServer(
+				endpoints.{{$i.Name}}Endpoint,
+				DecodeGRPC{{$i.Name}}Request,
+				EncodeGRPC{{$i.Name}}Response,
+				serverOptions...,
+			),
+		{{- end}}
 	{{- end}}
 	}
 }
@@ -44,40 +52,54 @@ type grpcServer struct {
     pb.Unimplemented{{GoName .Service.Name}}Server
 
 {{range $i := .Service.Methods}}
-	{{ToLower $i.Name}}   grpctransport.Handler
+	{{- if $i.Streaming}}
+		{{ToLower $i.Name}}   StreamEndpoint
+	{{- else}}
+		{{ToLower $i.Name}}   grpctransport.Handler
+	{{- end}}
 {{- end}}
 }
 
 // Methods for grpcServer to implement {{GoName .Service.Name}}Server interface
 {{range $i := .Service.Methods}}
-func (s *grpcServer) {{GoName $i.Name}}(ctx context.Context, req *pb.{{GoName $i.Request}}) (*pb.{{GoName $i.Response}}, error) {
+{{- if $i.RequestStream}}
+func (s *grpcServer) {{GoName $i.Name}}(stream pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Server) error {
+	return s.{{ToLower $i.Name}}(streamContext(stream), nil, stream)
+}
+{{else if $i.ResponseStream}}
+func (s *grpcServer) {{GoName $i.Name}}(req *{{$i.GoRequest}}, stream pb.{{GoName $.Service.Name}}_{{GoName $i.Name}}Server) error {
+	return s.{{ToLower $i.Name}}(streamContext(stream), req, stream)
+}
+{{else}}
+func (s *grpcServer) {{GoName $i.Name}}(ctx context.Context, req *{{$i.GoRequest}}) (*{{$i.GoResponse}}, error) {
 	_, rep, err := s.{{ToLower $i.Name}}.ServeGRPC(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return rep.(*pb.{{GoName $i.Response}}), nil
+	return rep.(*{{$i.GoResponse}}), nil
 }
 {{end}}
+{{- end}}
 
 // Server Decode
-{{range $i := .Service.Methods}}
+{{range $i := .Service.Methods}}{{if not $i.Streaming}}
 // DecodeGRPC{{$i.Name}}Request is a transport/grpc.DecodeRequestFunc that converts a
 // gRPC {{ToLower $i.Name}} request to a user-domain {{ToLower $i.Name}} request. Primarily useful in a server.
 func DecodeGRPC{{$i.Name}}Request(_ context.Context, grpcReq interface{}) (interface{}, error) {
-	req := grpcReq.(*pb.{{GoName $i.Request}})
+	req := grpcReq.(*{{$i.GoRequest}})
 	return req, nil
 }
-{{end}}
+{{end}}{{end}}
 
 // Server Encode
-{{range $i := .Service.Methods}}
+{{range $i := .Service.Methods}}{{if not $i.Streaming}}
 // EncodeGRPC{{$i.Name}}Response is a transport/grpc.EncodeResponseFunc that converts a
 // user-domain {{ToLower $i.Name}} response to a gRPC {{ToLower $i.Name}} reply. Primarily useful in a server.
 func EncodeGRPC{{$i.Name}}Response(_ context.Context, response interface{}) (interface{}, error) {
-	resp := response.(*pb.{{GoName $i.Response}})
+	resp := response.(*{{$i.GoResponse}})
 	return resp, nil
 }
-{{end}}
+{{end}}{{end}}
 
 // Helpers
 
@@ -95,3 +117,11 @@ func metadataToContext(ctx context.Context, md metadata.MD) context.Context {
 
 	return ctx
 }
+
+// streamContext returns the context of a stream with its metadata added, like
+// the context of unary requests.
+func streamContext(stream grpc.ServerStream) context.Context {
+	ctx := stream.Context()
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadataToContext(ctx, md)
+}
@@ -3,7 +3,7 @@
 // Version: {{.Version}}
 // Version Date: {{.VersionDate}}
 
-package svc
+package {{.SvcPackage}}
 
 import (
 	"context"
@@ -18,6 +18,9 @@ import (
 	"time"
 	// This service
 	pb "{{.PBImportPath -}}"
+	{{- range $alias, $path := .Service.GoImports}}
+	{{$alias}} "{{$path}}"
+	{{- end}}
 )
 
 const (
@@ -254,7 +257,7 @@ func (m *Message) encodeError(err error) {
 {{range $i := .Service.Methods}}
 	{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 		func (p *Pool) decoder{{$i.Name}}(data json.RawMessage) (interface{}, error) {
-			r := &pb.{{GoName $i.Request}}{}
+			r := &{{$i.GoRequest}}{}
 			return r, json.Unmarshal(data, &r)
 		}
 	{{ end }}
@@ -0,0 +1,87 @@
+package template
+
+import (
+	"github.com/pkg/errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// overlay is the directory of the templates overriding the embedded ones, it
+// is empty if no overlay is used.
+var overlay string
+
+// SetOverlay sets the directory of templates overriding the embedded assets
+// by their path, e.g. `svc/server/run.go.tpl`. Templates not embedded are
+// added to the generated files. A directory which does not exist is ignored.
+func SetOverlay(dir string) {
+	overlay = dir
+}
+
+// Read returns the template name, taken from the overlay if it contains the
+// file and from the embedded assets otherwise.
+func Read(name string) ([]byte, error) {
+	if overlay != "" {
+		data, err := os.ReadFile(filepath.Join(overlay, filepath.FromSlash(name)))
+		if err == nil {
+			return data, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "failed to read template '%s'", name)
+		}
+	}
+	return Asset(name)
+}
+
+// Names returns the names of the embedded assets and the templates of the
+// overlay, i.e. all files with the extension `.tpl`, sorted by name.
+func Names() ([]string, error) {
+	names := AssetNames()
+	if overlay != "" {
+		err := filepath.WalkDir(overlay, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".tpl") {
+				return err
+			}
+			name, err := filepath.Rel(overlay, path)
+			if err != nil {
+				return err
+			}
+			name = filepath.ToSlash(name)
+			if _, err = Asset(name); err != nil {
+				names = append(names, name)
+			}
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "failed to read templates of '%s'", overlay)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Export writes the embedded assets to dir to start an overlay from. Existing
+// files are kept unless force is set. The names of the written files are
+// returned.
+func Export(dir string, force bool) ([]string, error) {
+	names := AssetNames()
+	sort.Strings(names)
+
+	written := make([]string, 0, len(names))
+	for _, name := range names {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if _, err := os.Stat(target); err == nil && !force {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return nil, errors.Wrapf(err, "failed to create directory '%s'", filepath.Dir(target))
+		}
+		if err := os.WriteFile(target, MustAsset(name), 0644); err != nil {
+			return nil, errors.Wrapf(err, "failed to write template '%s'", target)
+		}
+		written = append(written, name)
+	}
+	return written, nil
+}
@@ -0,0 +1,61 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestOverlay(t *testing.T) {
+	dir := t.TempDir()
+	defer SetOverlay("")
+
+	written, err := Export(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != len(AssetNames()) {
+		t.Fatalf("Exported %d templates, want %d", len(written), len(AssetNames()))
+	}
+
+	run := filepath.Join(dir, "svc", "server", "run.go.tpl")
+	if err = os.WriteFile(run, []byte("package server // custom"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	extra := filepath.Join(dir, "svc", "extra.go.tpl")
+	if err = os.WriteFile(extra, []byte("package {{.SvcPackage}}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// existing files are kept
+	if written, err = Export(dir, false); err != nil || len(written) != 0 {
+		t.Fatalf("Export overwrote %v, error: %v", written, err)
+	}
+
+	SetOverlay(dir)
+	data, err := Read("svc/server/run.go.tpl")
+	if err != nil || string(data) != "package server // custom" {
+		t.Fatalf("Template not taken from overlay: %q, error: %v", data, err)
+	}
+	names, err := Names()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(names, "svc/extra.go.tpl") || len(names) != len(AssetNames())+1 {
+		t.Fatalf("Names does not contain the additional template once: %v", names)
+	}
+
+	// templates missing in the overlay are embedded
+	if err = os.Remove(filepath.Join(dir, "svc", "config.go.tpl")); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = Read("svc/config.go.tpl"); err != nil || string(data) != string(MustAsset("svc/config.go.tpl")) {
+		t.Fatalf("Embedded template not used: %v", err)
+	}
+
+	SetOverlay(filepath.Join(dir, "missing"))
+	if names, err = Names(); err != nil || len(names) != len(AssetNames()) {
+		t.Fatalf("Missing overlay not ignored: %v, error: %v", names, err)
+	}
+}
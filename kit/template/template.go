@@ -5,6 +5,7 @@
 // NAME-service/handlers/handlers.methods.go.tpl
 // NAME-service/handlers/hooks.go.tpl
 // NAME-service/handlers/middlewares.go.tpl
+// NAME-service/svc/build.go.tpl
 // NAME-service/svc/client/grpc/client.go.tpl
 // NAME-service/svc/client/http/client.go.tpl
 // NAME-service/svc/config.go.tpl
@@ -89,7 +90,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _cmdNameMainGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x3f\x8f\xe2\x30\x14\xc4\xeb\xbc\x4f\xf1\x94\x2a\x14\x67\xf7\x48\x54\xe4\x0a\x9a\x03\x01\xb7\xbd\x49\xc6\x8e\x45\x70\x90\xed\x04\xad\x22\x7f\xf7\x95\x03\xbb\xa2\xd8\xca\x7f\x7e\xf3\x34\xf3\x46\x4a\xde\x0e\x2d\xd8\xc0\xc1\xab\x88\x96\x2f\x9f\xdc\xa9\xc7\x55\x70\xbd\xe7\x7f\xfb\x33\xff\xad\x77\x67\x41\x52\xf2\x11\x7e\x74\xce\x3a\xb3\x70\x7e\xd8\xbe\xe7\x61\x82\x7f\x78\x1b\xc1\xb1\xb3\x81\xb5\xed\xb1\x68\x3f\xe0\x83\x1d\xdc\x9a\xe7\x59\xbc\xee\x29\xbd\x01\xae\x55\xc4\x3b\xcd\xef\x94\x88\xee\xaa\xb9\x2a\x03\xbe\x29\xeb\x88\xec\xed\x3e\xf8\xc8\x15\x15\xa5\xee\x95\x29\x89\x0a\x29\xf9\x9c\xad\x4e\xf0\x93\x6d\x40\x45\x39\xcf\x62\xb7\xe8\x0e\x2a\x76\xfc\x27\x25\x96\x61\x6a\x64\x80\x9f\xe0\xcb\xdf\x05\x9d\x72\x6d\x0f\x1f\x4a\x5a\x11\xe9\xd1\x35\x8b\x61\xb5\xe2\x79\x71\xf8\x7f\x6f\x55\x04\xab\xb6\xf5\x08\x01\x81\xad\xe6\xd8\x21\x37\x33\x81\x2f\x80\xfb\xd9\x3c\xc2\xe5\xca\x72\xbc\x40\x45\x3e\xc4\x41\xf9\x80\x6a\x45\x54\x34\xda\xf0\x7a\xc3\xcf\x28\xa2\x86\x56\x63\x1f\xb7\x83\xd3\xd6\x3c\xe1\x86\xbf\x93\x88\x13\x5e\xa4\x6a\xb4\xc9\xc3\xaf\xa9\xe3\xe8\x9e\x3f\x89\xbe\x02\x00\x00\xff\xff\xa6\x55\x21\xaa\xad\x01\x00\x00")
+var _cmdNameMainGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\xb1\x6e\xc2\x30\x18\x84\xe7\xfc\x4f\xf1\x2b\x53\x32\x34\xde\x91\x98\x48\x07\x96\x82\x80\x76\x37\xc9\xd9\xb1\x08\x0e\xb2\x9d\xa0\x2a\xf2\xbb\x57\x0e\xb4\x62\xe8\x64\x5b\xdf\x9d\xee\x7c\x42\xf0\x66\x68\xc1\x1a\x16\x4e\x06\xb4\x7c\xfe\xe6\x4e\xde\x2f\x15\xd7\x3b\xfe\xd8\x9d\xf8\xbd\xde\x9e\x2a\x12\x82\x0f\x70\xa3\xb5\xc6\xea\x85\xf3\xdd\xf4\x3d\x0f\x13\xdc\xdd\x99\x00\x0e\x9d\xf1\xac\x4c\x8f\x45\xfb\x05\xe7\xcd\x60\x57\x3c\xcf\xd5\xf3\x1e\xe3\x0b\xe0\x5a\x06\xbc\xd2\xf4\x8e\x91\xe8\x26\x9b\x8b\xd4\xe0\xab\x34\x96\xc8\x5c\x6f\x83\x0b\x5c\x50\x96\xab\x5e\xea\x9c\x28\x13\x82\x4f\x29\xea\x08\x37\x99\x06\x94\xe5\xf3\x5c\x6d\x17\xdd\x5e\x86\x8e\xdf\x62\x64\xe1\xa7\x46\x78\xb8\x09\x2e\xff\x5f\xd0\x49\xdb\xf6\x70\x3e\xa7\x92\x48\x8d\xb6\x59\x02\x8b\x92\xe7\x25\xe1\xf3\xd6\xca\x00\x96\x6d\xeb\xe0\x3d\x3c\x1b\xc5\xa1\x43\x5a\x66\x02\x9f\x01\xfb\xf7\xf3\x00\x9b\x26\x4b\xf5\x3c\x65\xe9\xa8\xf6\xd2\x79\x14\x25\x51\xd6\x28\xcd\xab\x35\x3f\xaa\x54\x35\x94\x1c\xfb\xb0\x19\xac\x32\xfa\x01\xd7\xfc\xdb\xa4\x3a\xe2\x49\x8a\x46\xe9\x64\x7e\xba\x0e\xa3\x2d\x1a\xa5\x4b\x8a\xf4\x33\x00\xa6\x55\x21\xaa\xad\x01\x00\x00")
 
 func cmdNameMainGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -109,7 +110,7 @@ func cmdNameMainGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _handlersHandlersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x94\x41\x8f\xd3\x3c\x10\x86\xcf\x99\x5f\x31\x8a\xaa\x55\xb2\xea\xba\xf7\x4f\xfa\x2e\x20\x16\x81\x96\xb2\xa2\x20\x8e\xc8\x9b\x4c\x13\x8b\xc4\x0e\xf6\xa4\xdd\x95\xe5\xff\x8e\xdc\x98\x92\x76\x55\xb8\xac\x84\x2f\x89\xc7\xf3\x8e\xfd\xbc\x63\x79\x90\xd5\x77\xd9\x10\xb6\x52\xd7\x1d\x59\x07\xa0\xfa\xc1\x58\xc6\x02\xb2\xbc\x32\x9a\xe9\x91\x73\xc8\xf2\x46\x71\x3b\x3e\x88\xca\xf4\x2b\xa7\xec\x38\x38\xd2\xab\xce\x34\x76\x74\x39\x40\x36\x3c\x60\xee\xbd\xb8\x7f\xf5\xee\x20\xbe\x97\xdc\xe2\x4d\x08\x39\x94\x00\x3b\x69\xf1\xce\x34\x0d\x59\xbc\x9e\x14\xe2\x8d\x66\xfb\x04\xb0\x5a\xe1\x9a\xf6\x1b\xb2\x3b\x55\x11\x5a\xe2\xd1\x6a\x87\x12\xb5\x54\x3b\x5a\xa2\x63\xc9\xd4\x91\x73\xa8\xfa\xa1\xa3\x9e\x34\x4b\x56\x46\xa3\xd9\x62\x12\x09\xd8\x8e\xba\x9a\x55\x29\x4a\x1c\x1e\x84\xf7\x6f\xcd\x5a\xf6\x84\xe2\x57\x5e\x9c\x85\x10\x67\x64\xd1\x43\xd6\x99\x06\xff\xfb\x1f\xd3\x79\xd6\xb4\x2f\xca\x43\x50\x6c\x88\x6f\x8d\xed\x25\x33\xd9\xe2\x2a\xad\xbf\xdf\x7c\x5c\x1f\xa3\x3e\x94\x90\x25\xa0\x43\x05\xf1\x55\x71\x7b\xab\xa8\xab\x8b\xdc\x4d\xfb\xe5\xcb\xe8\xc7\x67\x73\x67\xf6\x64\xcf\x4f\x91\x97\x00\xd9\x44\x8b\x17\x93\xd2\xcc\x07\x08\x00\xfc\x34\xd0\x5f\x53\xd1\xb1\x1d\x2b\xf6\xb1\x1b\xe2\x8b\x3e\x7a\x46\xf5\x9f\xed\x88\x1b\x78\xbf\x57\xdc\xe2\x82\x29\xba\x22\x30\x04\xc8\xbc\xb7\x52\x37\x84\x0b\x15\x63\x0b\xa6\xa3\xfa\x03\x71\x6b\x6a\x17\x93\x32\xef\x51\x6d\x71\xa1\xc4\x27\xfa\x31\x92\xe3\x0d\x5b\x92\x7d\x2c\x80\xb3\x71\xe8\x52\xe1\x66\x0c\xf3\x7a\x27\x18\x25\x7a\x9f\x22\x85\x9b\x8a\xcd\x3b\xfa\x5c\xf7\xed\x37\xde\x1c\xab\x44\xb2\xd6\xc4\x66\xe3\xd9\x48\xd6\x6b\xd5\x9d\x2c\x25\x1a\xea\x1c\x1d\x91\xdc\x60\xb4\xa3\x17\x65\x52\x1a\xaf\x4f\xae\x68\x32\x2e\x84\x78\xe3\xff\x11\xef\xcb\xa0\x55\xfc\x88\xe9\xc9\x10\xaf\xa7\xef\x12\x2f\xf3\x96\x58\x9c\xaf\x4c\x7e\x47\x2b\x0e\x30\xe5\x73\x98\xf8\x9a\x58\x72\x03\x5e\x50\x5e\xa2\xbf\x8a\xa2\xe5\x45\x13\x74\x9d\xee\x3c\xe9\x3a\x04\xf0\xfe\x06\xa7\xbf\x9f\x01\x00\x00\xff\xff\xc5\x90\xf0\xc5\x20\x05\x00\x00")
+var _handlersHandlersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x41\x6b\xdc\x3c\x10\x3d\x5b\xbf\x62\x30\x4b\xb0\x83\xa3\xbd\x07\x72\xf9\x3e\x9a\xb4\x25\xdd\x86\x6e\x4b\x8f\x45\xb1\x67\xbd\xa2\xb6\xe4\x4a\xe3\x6c\x82\xd0\x7f\x2f\xb2\xb4\x1b\x27\xcb\xb6\x97\x40\x7d\x31\x7e\x9a\x79\xf3\xde\xcc\x58\x83\xa8\x7f\x8a\x16\xc1\x39\xfe\x5e\xa8\xa6\x43\x63\xef\x22\xe4\x3d\x63\xce\x5d\xc0\x62\x54\xc2\x3c\xc1\xe5\x15\x6c\x44\x67\xd1\xfb\x09\x35\x42\xb5\x08\x7c\x8d\xe6\x41\xd6\xc8\x3f\x21\x6d\x75\x63\xbd\x77\x4e\x6e\x40\x69\x02\xbe\x26\x83\xa2\x97\xaa\x0d\x60\x22\xb9\x02\x32\x23\x06\x00\x55\x73\x78\x31\x26\xfb\x41\x1b\x82\x82\x65\x81\x5b\x6e\x52\x51\xef\x59\x96\xd7\x5a\x11\x3e\x52\x1e\xcf\x62\x42\x96\xb7\x92\xb6\xe3\x3d\xaf\x75\xbf\xb4\xd2\x8c\x83\x45\xb5\xec\x74\x6b\x46\x9b\x33\x96\x0d\xf7\x90\x3b\xc7\xef\xfe\xfb\x30\x11\xdf\x09\xda\xc2\x85\xf7\x89\x23\x6a\x5f\x88\x4e\x0a\x5b\xc1\x62\x08\xa7\x97\x57\xcf\x66\x6e\x74\x4c\xb3\xa1\xbe\x73\x31\xd0\xfb\x40\x39\x05\x1f\x88\xa2\x98\x92\xb1\x07\x61\xe0\x56\xb7\x2d\x1a\x38\x8f\x2a\xf8\x3b\x45\xe6\x89\xb1\xe5\x12\x56\xb8\x4b\xcc\x60\x90\x46\xa3\x2c\x08\x50\x42\x3e\x60\x05\x96\x04\x61\x87\xd6\x82\xec\x87\x0e\x7b\x54\x24\x48\x6a\x05\x7a\x03\x7b\x39\x6c\x33\xaa\x7a\xc6\x52\x94\x30\xdc\x73\xe7\x6e\xf4\x4a\xf4\xb3\x19\x84\x2f\xef\x43\x10\x1a\x70\x2c\xeb\x74\x1b\xa6\x96\xf4\xac\x70\x57\x94\x13\xc8\xd7\x48\xd7\xda\xf4\x82\x08\x4d\x71\x96\xce\x3f\xae\x3f\xaf\x0e\xa8\xf3\x25\xcb\x92\xa1\x89\x81\x7f\x97\xb4\xbd\x96\xd8\x35\x45\x6e\x63\xbd\xbc\x0a\x0d\xf9\xaa\x6f\xf5\x0e\xcd\x6b\x15\x79\xc9\x58\x16\xdd\xc2\xc9\xa0\xf4\xe5\x3c\xf3\x8c\xd1\xd3\x80\x7f\x0d\x05\x4b\x66\xac\xc9\x85\x09\xf3\x6f\xea\xd0\x33\x6c\xfe\xdc\x8e\x50\xc0\xb9\x9d\xa4\x2d\x2c\x08\x43\x57\x38\xc4\xe1\xa6\x5d\x90\x01\x5b\x10\x1e\x2f\x34\xcb\x32\xe7\xa6\x95\x94\xfc\x0b\xfe\x1a\xd1\x52\xdc\xec\x40\x00\xb3\x67\x9a\x52\x61\x67\x1e\xe6\x7c\x2f\x6c\x94\xe1\x77\x8b\x48\x61\x23\xd9\x7c\xa2\xc7\x79\x3f\x9e\xed\xcd\x6d\x95\x80\xc6\xe8\x30\xec\xbd\x88\xfd\x93\x5a\xaf\x64\xf7\xe2\x28\xb9\xc1\xce\xe2\xc1\x92\x1d\xb4\xb2\xf8\xa6\x9e\xa4\x82\x73\xe7\xf8\x8d\x4e\x0d\xf3\xbe\x82\x7f\xe6\xf3\x6d\x2c\xd5\xf4\x08\xe9\x26\xe2\xff\xc7\x77\x05\xc7\x3e\x4b\x28\xf6\x48\xec\x6b\xb0\x3e\x89\x2f\x8f\xc5\x87\x5b\xc3\xa0\x1d\xe0\x55\xc6\x29\x97\x67\x21\xb8\x3a\x69\x56\x35\x69\xa7\xe3\xb5\xe4\xdc\x05\xa0\x6a\xbc\x67\xbf\x07\x00\x5b\x7e\x50\x21\xe4\x05\x00\x00")
 
 func handlersHandlersGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -124,12 +125,12 @@ func handlersHandlersGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "handlers/handlers.go.tpl", size: 1312, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "handlers/handlers.go.tpl", size: 1508, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _handlersHandlersMethodsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\xc1\x4e\xf3\x30\x10\x84\xcf\xcd\x53\xcc\x21\xfa\x95\xfc\x0a\x7e\x00\x24\x4e\x1c\xb8\x00\x07\xca\x1d\x85\x64\x4b\x2d\xb5\x76\x58\x3b\x6d\xa5\xd5\xbe\x3b\x4a\x9d\x06\x4a\x95\x5b\x25\xf6\x62\x7b\x47\xb3\xf6\x37\x72\x26\x82\xbd\x8d\x6b\xe4\x91\x70\x7b\x07\xa3\x9a\x01\x80\x08\xd7\xee\x83\x90\xdb\x63\xf7\x89\xe2\xda\xb7\x41\x35\x5b\x2c\x44\x60\x57\xc8\xad\x79\xa1\xcf\x9e\x42\x5c\x46\xa6\x7a\x8b\xd1\x78\xaa\x55\xef\x1a\x14\x01\x22\xaf\xfe\xd1\xef\x89\x87\x1b\xcc\x92\x78\x67\x1b\x7a\xae\xb7\xa4\x3a\x1e\x4a\x88\x98\xd4\x29\x42\x9a\xd5\xbd\x1b\x91\x07\x3f\x34\x2f\x6d\x6f\x93\x64\xbe\xe7\x10\x97\x20\x66\xcf\x90\xb3\x67\x0c\xc5\x14\x7b\x76\x70\x76\x73\x26\x8d\x2c\xb4\x09\x34\x01\x85\xce\xbb\x40\xd7\x24\xb2\x0e\xff\x7f\xe2\x9c\x52\x53\xad\xf0\x57\xb4\x57\x01\x6b\xe2\x01\x8d\x77\x91\x0e\xd1\xdc\xa7\xb5\xc2\x3c\x6d\x89\xe2\xb7\x92\xc2\x1e\x82\x38\xb2\x94\x97\x2c\xbb\x9a\xc1\x14\x3a\xcc\x38\xe7\xe0\xff\x0d\xa6\x6a\x36\x03\xd7\x62\xfa\xe6\xe4\x5a\xd5\x4c\xe4\x06\x69\xf7\x15\x00\x00\xff\xff\x66\x64\x40\x3b\x11\x03\x00\x00")
+var _handlersHandlersMethodsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x91\xc1\x6a\xc3\x30\x0c\x86\xcf\xcd\x53\xfc\x87\x30\x92\x91\xf9\x01\x06\x3b\xed\xd0\xcb\xb6\xc3\xba\xfb\xc8\x12\x75\x35\xb4\x76\x26\x3b\x6d\x41\xe8\xdd\x87\x9b\xa4\xd0\x85\xde\x0a\xc3\x06\xe3\x5f\xfe\x65\x7d\x52\x26\x82\x83\x8d\x1b\xe4\x91\xf0\xf8\x04\xa3\x9a\x01\x80\x08\xd7\xee\x9b\x90\xdb\x93\xfa\x4a\x71\xe3\xdb\xa0\x9a\x2d\x16\x22\xb0\x6b\xe4\xd6\xbc\xd3\x4f\x4f\x21\xae\x22\x53\xbd\xc3\x68\x9c\xd6\xba\x77\x0d\x8a\x00\x91\x0f\xff\xe2\x0f\xc4\xe9\x07\xb3\x22\xde\xdb\x86\xde\xea\x1d\xa9\x8e\x97\x12\x22\x66\x50\x8a\x30\xe4\xea\xbe\x8c\xc8\xd2\x27\x71\x6e\xfb\x3c\x87\x46\x57\xca\x43\x5c\x82\x98\x3d\x43\x2e\xca\x48\x9b\x29\xf6\xec\xe0\xec\xf6\x22\x34\xb2\xd0\x36\xd0\x19\x28\x74\xde\x05\xba\x25\x91\x75\xb8\x17\x31\x4b\x3f\x76\x4b\xb5\xc2\x7f\x51\xde\x04\xa8\x89\x47\x34\xde\x45\x3a\x46\xf3\x3c\x9c\x15\xe6\x94\x25\x8a\x49\x19\x9a\x9a\xc0\x4f\x13\x2a\xe7\xb5\xef\x6b\x06\x53\xe8\xf0\xc7\x71\x0d\xf2\x2e\x3d\xae\xae\xb2\xba\x76\x42\x15\x21\xd7\xaa\x66\x22\x0f\x20\xd7\xaa\x66\xbf\x03\x00\x51\xa9\xec\xbd\xf1\x02\x00\x00")
 
 func handlersHandlersMethodsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -144,12 +145,12 @@ func handlersHandlersMethodsGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "handlers/handlers.methods.go.tpl", size: 785, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "handlers/handlers.methods.go.tpl", size: 753, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _handlersHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\xd1\xaa\xe2\x30\x10\x86\xaf\x33\x4f\x31\x14\x16\x5a\xb0\x2d\x7b\xbb\xb8\x57\x22\xbb\xbd\x38\x22\x47\x5f\x60\x88\x93\xa6\xd8\x26\x32\x99\x2a\x22\x7d\xf7\x43\xad\x07\x8e\x37\x21\x5f\x86\x3f\xff\x97\x5c\xc8\x9e\xa9\x65\xf4\x14\x4e\x3d\x4b\x02\xe8\x86\x4b\x14\xc5\x1c\x4c\xe6\x06\xcd\xc0\x64\x8f\x47\xd5\x3c\x0f\xf7\xa4\x1e\xcb\x69\xc2\x3a\x5d\xed\x3c\x89\x69\x59\xeb\xd4\xb5\x81\xfa\x19\xd2\x3d\x59\xea\xfb\x0c\x0a\x00\x37\x06\x8b\x07\xd6\x4d\x0c\xae\x6b\x73\xeb\x5a\x4c\x57\x5b\x2d\x58\xfc\xd8\xe3\x03\x8c\xb0\x8e\x12\xd0\xba\x16\xa6\x57\xb4\x09\xca\x22\xe3\x45\xff\x2f\x76\x39\x8b\x6c\x3c\x05\xb4\x9e\xc2\xba\x44\x16\x89\x52\xcc\x61\x8b\x7f\xfe\xe2\x40\x67\xce\xe7\x11\xc6\x54\x1d\x9e\x46\x2b\xfc\x5d\x80\x59\xec\xaa\x5d\xd4\xce\xdd\x73\xbb\xc2\x97\x64\x75\x68\xfe\x35\xbb\xe3\x1b\x1f\xb7\x9f\x1f\x05\x18\x65\x19\xba\x40\xca\xdb\xb9\x63\xbe\xdd\x0d\x5a\x3d\xc1\xe5\xd9\xaf\x94\xad\x70\x5d\xda\x02\xc0\xd4\x35\xee\x7b\xb2\x8c\x37\x4f\xca\x57\x16\x4c\x7e\xd4\x53\xbc\x85\xe5\x53\xbb\xd0\xe2\x3d\x8e\x78\xa3\xa0\xe8\x59\x18\xc0\x7c\xbf\x63\x5d\xe2\x7b\x11\x4c\xf0\x15\x00\x00\xff\xff\xf0\x19\x98\x3b\x92\x01\x00\x00")
+var _handlersHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\xd1\xaa\xe2\x30\x10\x86\xaf\x33\x4f\x31\x14\x16\x5a\xb0\x2d\x7b\xbb\xb8\x57\x22\xbb\xbd\x38\x22\x47\x5f\x60\x88\x93\xa6\xd8\x26\x32\x99\x2a\x22\x7d\xf7\x43\xad\x07\x8e\x37\x21\x5f\x86\x3f\xff\x97\x5c\xc8\x9e\xa9\x65\xf4\x14\x4e\x3d\x4b\x02\xe8\x86\x4b\x14\xc5\x1c\x4c\xe6\x06\xcd\xc0\x64\x8f\x47\xd5\x3c\x0f\xf7\xa4\x1e\xcb\x69\xc2\x3a\x5d\xed\x3c\x89\x69\x59\xeb\xd4\xb5\x81\xfa\x19\xd2\x3d\x59\xea\xfb\x0c\x0a\x00\x37\x06\x8b\x07\xd6\x4d\x0c\xae\x6b\x73\xeb\x5a\x4c\x57\x5b\x2d\x58\xfc\xd8\xe3\x03\x8c\xb0\x8e\x12\xd0\xba\x16\xa6\x57\xb4\x09\xca\x22\xe3\x45\xff\x2f\x76\x39\x8b\x6c\x3c\x05\xb4\x9e\xc2\xba\x44\x16\x89\x52\xcc\x61\x8b\x7f\xfe\xe2\x40\x67\xce\xe7\x11\xc6\x54\x1d\x9e\x46\x2b\xfc\x5d\x80\x59\xec\xaa\x5d\xd4\xce\xdd\x73\xbb\xc2\x97\x64\x75\x68\xfe\x35\xbb\xe3\x1b\x1f\xb7\x9f\x1f\x05\x18\x65\x19\xba\x40\xca\xdb\xb9\x63\xbe\xdd\x0d\x5a\x3d\xc1\xe5\xd9\xaf\x94\xad\x70\x5d\xda\x02\xc0\xd4\x35\xee\x7b\xb2\x8c\x37\x4f\xca\x57\x16\x4c\x7e\xd4\x53\xbc\x85\xe5\x53\xbb\xd0\xe2\x3d\x8e\x78\xa3\xa0\xe8\x59\x18\xc0\x7c\xbf\x63\x5d\xe2\x7b\x11\x4c\xf0\x35\x00\xf0\x19\x98\x3b\x92\x01\x00\x00")
 
 func handlersHooksGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +170,7 @@ func handlersHooksGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _handlersMiddlewaresGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\xdd\x6e\xdb\xb8\x12\xbe\x96\x9e\x62\xaa\x9b\x23\x1d\x38\x52\xaf\x7b\x10\xe0\xec\x06\x69\x53\x20\x6d\x83\x26\x45\x17\x28\x8a\x0d\x2d\x8e\x24\x22\x14\xa9\x92\xa3\x38\x46\xe0\x77\x5f\x0c\x45\xd9\x56\xda\x66\xbb\xd8\xab\x58\x1c\xce\x37\xdf\xfc\x33\x83\xa8\xef\x44\x8b\xd0\x09\x23\x35\x3a\x9f\xa6\xaa\x1f\xac\x23\xc8\xd3\x24\xab\xad\x21\x7c\xa0\x2c\x4d\x86\x35\x64\x8f\x8f\xe5\xd5\xef\x6f\x83\xf4\x4a\x50\x07\x27\xbb\x5d\x96\x26\x7c\xbc\x3c\x84\xca\xdf\xd7\x2c\x31\x48\xd5\xe8\x34\xff\xf4\xe4\x94\x69\x7d\x96\xa6\x49\xd6\x2a\xea\xc6\x75\x59\xdb\xbe\x6a\xad\x6d\x35\x56\xe3\xa8\x64\xb6\x94\x18\xa5\x54\x6b\x6d\xd5\x89\xcd\x5d\x35\xdc\xb5\x55\xaf\xa4\xd4\xb8\x11\x0e\x9f\xdc\xf4\xca\x8d\x83\x47\x53\x69\xdb\xba\xd1\xcf\x86\x3b\xa2\x21\x4b\x8b\x34\xad\x2a\xf8\xec\xc4\x70\x6e\xe4\x60\x95\x21\x0f\xa2\xae\x71\x20\x0f\xd4\x21\x78\x74\xf7\xaa\xc6\xff\x78\x40\x43\xca\x21\xd4\x56\x6b\xac\x49\x59\x03\xb6\x01\x9c\x95\x56\xe0\x2d\x50\x27\x08\x04\x03\x7a\x24\x16\x1f\x38\x79\xa8\x85\x81\x35\xc2\xc6\x89\x61\x40\x09\xc2\xd9\xd1\x48\xc0\x7b\x74\xdb\xa3\x7b\x90\x63\xd9\x96\xab\xc0\xc1\x7b\x86\xd2\xb6\x6d\x95\x69\x41\x18\x09\xca\x78\x72\x63\x8f\x86\x04\x33\x28\x56\xe1\xd4\x52\x87\xce\xef\x91\x3d\x06\x82\xf7\xa8\xb7\xb3\x15\x6f\x7b\x64\x2c\x3c\xf8\x68\x24\x18\x4b\xb3\x6e\xb4\x7a\x90\x3b\xfc\x36\x2a\x17\xec\x8e\xd4\xb1\xef\xb5\x20\xa6\x1d\x78\x15\x25\xa3\xbd\xb7\x84\x93\xcf\x1c\xa9\x46\x19\xa1\x8f\x3d\x99\xf9\x6c\x94\xd6\xec\x39\x5f\xb2\x23\xa1\xeb\xad\xa7\xa3\x8b\x0c\x95\xab\x12\x4b\x10\xc3\xa0\x15\x4a\x68\x94\xf3\x54\xa4\xcd\x68\xea\x65\x6a\xf2\x98\x0e\x18\xd6\xe5\xe3\x63\x79\x3d\x7d\x95\xef\x45\x8f\xbb\x1d\x7f\xa1\x5b\x81\x32\xe0\xef\xeb\x72\xaf\x54\x2c\x3f\xe1\x31\x4d\x94\x29\x19\xf7\x37\xad\x2f\xc5\x1a\x35\xca\xf3\x07\x4e\x79\x7e\x20\x55\x9e\x09\xaa\xbb\x2b\x61\x54\x5d\xa4\x69\x52\x55\x70\x25\xbc\x07\x71\xec\xe0\xd6\x8e\xb0\x11\x86\xf6\xbc\xc9\xc6\x7c\xce\x71\x2c\x83\xa6\x1d\x38\x59\x42\xeb\x2d\x0c\x0c\xa2\xcc\x51\xa0\xd7\x5b\x30\xa2\x8f\x71\xdc\x23\x92\xe5\x88\xe1\x43\xad\x47\x89\x32\xa0\x70\x86\xc2\x8f\x03\xf9\xc8\x9a\x33\xf4\x6e\x4f\x6b\x05\xd9\x35\x09\x1a\x7d\xb6\x82\xec\x4a\x99\x36\x3b\x76\x40\x19\x10\x21\x1e\xd1\xf1\x77\xff\xdc\x9d\x9b\x0e\x3d\x2e\x4a\xbb\x45\x0a\x9e\xb1\x4e\x87\x47\xce\x05\xcf\x44\xe8\x24\xe5\xa6\xb4\x82\x70\x6d\xa8\x60\xd8\x74\x68\x66\x5b\x33\xb2\xda\xf7\xc9\xe8\x27\x06\x1b\xa7\x08\xa1\x45\x83\x4e\xd5\xd0\x23\xf1\x9f\x56\x70\xd9\x72\x75\x1e\xd3\x08\x21\xac\x85\x09\x58\x0e\xc3\x94\x3a\xe6\x33\xd1\x69\xac\x83\xc6\x21\x4e\x26\x7f\x36\x51\x0e\xb8\xd5\xde\xfb\xd6\x06\x65\x61\x00\x1f\x44\x3f\x68\x7c\x9a\x8f\x65\x31\xa1\x73\xd6\x9d\xd9\xd1\x10\xba\xdc\x93\x20\x2f\xe3\x57\xf1\xd3\x1c\x5d\xd8\x0d\x3b\xcd\x51\xd9\x2e\x8b\x8d\x4f\xc1\x2b\xd3\x6a\x7c\x92\x0f\x65\xca\xf3\x89\xcf\x5c\xe3\x70\x0a\xcb\x9a\xc8\xbf\xbf\x53\xa4\x29\x00\x40\x55\xc1\xb5\xed\x97\xe9\x24\x0b\xaa\x1f\x9c\xbd\x9f\x7a\x76\x1e\x40\xb6\x09\x53\x01\x3d\xf9\x59\xf5\x93\x47\xb8\x3d\x6a\x9a\x37\x48\x97\xb6\x6d\xd1\xdd\x4e\x15\x6c\xb0\x51\x04\x8d\xb3\x3d\x28\xfa\xa5\xb6\x9b\xe9\x5d\x4e\x46\xf3\x09\x6e\x05\x46\xe9\xa2\xf8\x25\x84\x49\xe3\xc6\x9e\x4d\xbb\x29\x22\x2c\x95\x3f\x2b\xea\x2e\x88\x86\x0f\xa1\x33\xff\x16\xe3\xe2\xe6\xe6\x6a\xcf\x84\xa7\x52\xee\xe0\xbf\xbc\x3f\xca\x8f\x53\x40\x0a\x98\x76\x4b\xf9\x5a\xa1\x96\x61\xc4\x24\xcd\xf4\xf3\xd5\xe9\x52\xc6\xa2\x24\xeb\x91\x3a\x2b\xb3\x57\xe0\xca\x77\xe1\xe7\x2a\x1c\xf3\x32\x7c\xc5\xb1\x75\xe5\xa7\x8f\x97\xe5\x75\x58\x8a\x79\xc1\xc2\x5d\x9a\x26\x9c\xed\x98\xc6\x50\x89\x31\x1d\x27\x6b\x11\x9a\xc5\x89\x5a\x99\x36\x4d\x12\xd5\x80\x92\x6c\xd9\x95\x17\x28\x24\x3a\x4e\x4c\x9e\xfd\x71\x12\xe9\x9e\xbc\x95\x59\xf1\x3f\xbe\xf3\xe2\x14\xb2\x2c\xd0\x8d\x7c\xbf\x64\x11\xf4\x4f\x25\xb3\xaf\x70\x0a\x4a\xb2\x71\x40\xed\xf1\xd9\x7b\xbc\xa2\xcb\xf7\xb8\x99\x39\xff\x84\xb2\x47\xef\x95\x35\xbf\x4e\xf9\x3a\x2a\x3c\x47\x39\x82\x2e\x29\xb3\x71\x87\x34\x3a\x03\xd3\xbd\x34\xd9\x15\xdc\x66\xf1\x50\x99\x74\x97\x1e\x76\x4c\xdc\x25\xb9\x32\xcf\x2c\x97\xe2\x19\x19\x53\x5a\x40\xf3\xbb\x02\xd7\xd7\xb6\xbe\x43\x7a\x33\x0a\x27\x61\x70\x96\xb0\x8e\x2f\x8b\xcd\x2c\x3b\xb4\x33\x8f\x56\xa8\xad\x31\xf1\x81\xa1\x3c\x58\xa3\xb7\x30\x0e\xad\x13\x12\x25\xa8\x26\xa8\xb6\x8c\xc6\xf8\xcc\x3e\xdc\x94\x16\x7d\xd8\xe7\x71\xec\xf1\x8c\xe2\xf1\x33\x41\x4e\xb4\x50\x42\x7c\xaf\x31\xf0\xbd\xd0\x4a\x86\x94\x84\x1e\x57\x0d\x92\xea\x91\x9b\x9c\x16\x24\xc2\xa6\xbf\x8d\x7b\xf7\x16\xba\x69\x9a\x03\x6d\x07\x84\xdb\xc7\xc7\x1b\x7b\x69\x37\xe8\xe0\x07\x21\x09\xf7\xf9\x9d\x11\x27\x7a\x2d\x3c\x95\x31\xe0\x8b\xb8\xe4\x35\x3d\xcc\xd4\xca\xd8\x72\x2b\x58\xac\xfa\x37\x96\x71\x7f\x64\x85\x5b\xf2\xbb\x6e\xcc\xbf\x43\x0b\xd1\x28\x8e\x72\x54\xd3\x43\x98\x2a\x4f\x33\xf5\xc1\xa9\x56\x99\xb3\x0e\xeb\x3b\x74\x50\xf3\xdf\xc9\x63\x1b\x04\xd0\x85\xea\x9c\xe3\x14\x9b\x00\xd6\xd8\x58\x87\x31\x51\x3c\x2c\x7f\x10\xc4\xff\x7b\x44\x58\xbc\x6d\x9d\xd2\x5a\x54\x1b\x5c\xfb\xa9\x10\x38\x1b\x3d\xe3\x48\x24\xa1\xb4\x7f\x12\xac\x05\xb5\xfc\xdf\xc4\x67\x6d\xad\xe6\x58\x44\x9f\x8e\xda\xee\x4b\x36\x59\xc9\xbe\xa6\xdc\x93\x1a\x4d\x3e\x5d\x2a\xe0\xf4\x14\x5e\x86\xbe\x8b\x11\x24\x37\x62\x9a\xec\xd2\x64\x0c\xe1\x65\x94\xd1\xe9\xf2\x4a\x38\x8f\x51\xe9\xcb\xcb\xaf\x45\xc0\x61\xf9\x8b\x53\x8e\xf7\x31\x42\x23\xb4\x9f\x20\xe2\x41\xfc\x2f\xa0\x3c\xff\x36\x0a\xfd\xda\x6a\x99\x8f\xe5\x85\xf5\xb4\x62\x7e\x96\xdf\x84\xbb\xf4\xaf\x00\x00\x00\xff\xff\x7e\x81\x2b\x55\x91\x0c\x00\x00")
+var _handlersMiddlewaresGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x5d\x6f\xdb\xb8\x12\x7d\xb6\x7e\xc5\x54\x2f\x57\xba\x70\xe8\x3e\xe7\xc2\xc0\xdd\x0d\xd2\xa6\x40\xda\x1a\x75\x8a\x2e\x50\x14\x1b\x5a\x1a\x49\x44\x28\x52\x25\x47\x76\x0c\xc3\xff\x7d\x31\x14\xe5\x8f\xb4\xc9\x76\xb1\x4f\x96\x44\xce\xe1\x99\x73\x38\x43\xba\x93\xc5\x83\xac\x11\x76\x3b\x71\x23\x4d\xa9\xd1\xf9\xc5\xf0\x69\xbf\x4f\x12\xd5\x76\xd6\x11\x64\xc9\x24\x2d\xac\x21\x7c\xa4\x34\x99\x74\x2b\x48\x77\x3b\xb1\xf8\xfd\x5d\x18\x5d\x48\x6a\xe0\x62\xbf\x4f\x93\x89\x5f\x17\x61\x68\xb9\x2e\x8e\x63\x61\x24\x35\x48\xb3\xde\x69\x7e\xf4\xe4\x94\xa9\x7d\x9a\x24\x93\xb4\x56\xd4\xf4\x2b\x51\xd8\x76\x56\x5b\x5b\x6b\x9c\xf5\xbd\x2a\xd3\xf3\x11\xa3\x94\xaa\xad\x9d\x35\x72\xf3\x30\xeb\x1e\xea\x59\xab\xca\x52\xe3\x46\x3a\x7c\x32\xd3\x2b\xd7\x77\x1e\xcd\x4c\xdb\xda\xf5\x7e\x5c\xb8\x21\xea\xd2\x24\x4f\x92\xd9\x0c\xbe\x38\xd9\x5d\x9b\xb2\xb3\xca\x90\x07\x59\x14\xd8\x91\x07\x6a\x10\x3c\xba\xb5\x2a\xf0\x3f\x1e\xd0\x90\x72\x08\x85\xd5\x1a\x0b\x52\xd6\x80\xad\x00\xc7\xa0\x29\x78\x0b\xd4\x48\x02\xc9\x80\x1e\x89\x87\x8f\x9c\x3c\x14\xd2\xc0\x0a\x61\xe3\x64\xd7\x61\x09\xd2\xd9\xde\x94\x80\x6b\x74\xdb\x93\x79\x90\xa1\xa8\xc5\x34\x70\xf0\x9e\xa1\xb4\xad\x6b\x65\x6a\x90\xa6\x04\x65\x3c\xb9\xbe\x45\x43\x92\x19\xe4\xd3\xf0\xd5\x52\x83\xce\x1f\x90\x3d\x06\x82\x6b\xd4\xdb\x71\x15\x6f\x5b\x64\xac\x03\xdd\x10\x67\x2c\x8d\xb1\x71\xd5\xe3\xb8\xc3\xef\xbd\x62\x4b\x40\xf6\xd4\x70\xee\x85\x24\xa6\x1d\x78\xe5\x82\xd1\x3e\x58\xc2\x21\x67\x56\xaa\x52\x46\xea\xd3\x4c\x46\x3e\x1b\xa5\x35\x67\xce\x93\x6c\x4f\xe8\x5a\xeb\xe9\x64\x22\x43\x65\x4a\xa0\x00\xd9\x75\x5a\x61\x09\x95\x72\x9e\xf2\xa4\xea\x4d\x71\x6e\x4d\x16\xed\x80\x6e\x25\x78\x4b\x0d\x6f\xe2\x83\x6c\x71\xbf\x5f\xa2\x5b\xa3\x9b\x82\x32\xe0\xd7\x85\x38\x04\xe5\xe7\xaf\xb0\x4b\x26\xca\x08\xc6\xfd\x4d\xeb\x5b\xb9\x42\x8d\xe5\xf5\x23\x5b\x9e\x1d\x49\x89\x2b\x49\x45\xb3\x90\x46\x15\x79\x92\x4c\x66\x33\x58\x48\xef\x41\x9e\x26\xb8\xb5\x3d\x6c\xa4\xa1\x03\x6f\xb2\xd1\xcf\x51\x47\x11\x22\x6d\xc7\x66\x49\xad\xb7\xd0\x31\x88\x32\x27\x46\xac\xb6\x60\x64\x1b\x75\x3c\x20\x92\x65\xc5\xf0\xb1\xd0\x7d\x89\x65\x40\x61\x87\xc2\xc3\x91\x7c\x64\xcd\x0e\xbd\x3f\xd0\x9a\x42\xba\x24\x49\xbd\x4f\xa7\x90\x2e\x94\xa9\xd3\xd3\x04\x94\x01\x19\xf4\x88\x89\xbf\xff\xe7\xe9\xdc\x35\xe8\xf1\x44\x07\x0f\x35\x52\xc8\x8c\x25\x68\xf0\x24\xb9\x90\x99\x0c\x95\xa4\xdc\x60\x2b\x48\x57\x87\x1d\x0c\x9b\x06\xcd\xb8\xd6\x88\xac\x0e\x75\xd2\x07\x34\x0b\x1b\xa7\x08\xa1\x46\x83\x4e\x15\xd0\x22\xf1\x4f\x2d\x79\xdb\xf2\xee\x3c\xa5\x11\x24\x2c\xa4\x09\x58\x0e\xb9\xd7\x9c\xf1\x19\x84\xae\xac\x83\xca\x21\x0e\x4b\x3e\xd7\x51\x8e\xb8\xb3\x31\x5c\xd4\x36\x04\x4b\x03\xf8\x28\xdb\x4e\xe3\x53\x3f\xce\x37\x13\x3a\x67\xdd\x95\xed\x0d\xa1\xcb\x3c\x49\xf2\x65\x7c\xcb\x9f\xf5\xe8\x8e\x5b\x0e\x39\x94\x6d\x48\x0e\xa9\xb1\xa5\x87\x46\xae\x31\x6a\x68\x37\x27\x9b\x67\x1a\x54\x8f\x86\x2e\x43\xd8\xd1\xcf\x80\x67\xdd\xb9\xdd\x4f\x27\x0d\x0a\xcb\x8e\xd1\x5b\x11\x96\x3f\x66\x0e\xad\xdc\x82\xc3\x4e\xcb\x62\x40\x63\x31\x63\xcb\x9f\x82\x22\x50\xfe\x60\x3b\x37\x3f\x84\x66\x38\x2d\x40\xfa\xd3\xb9\xdc\x09\xe9\x90\xd8\x53\xd1\x06\x4a\xfe\x5c\xbb\x61\xea\xb3\xe2\x7d\xe1\xe2\x1c\x35\xbb\xb1\x1b\x4e\x83\x77\xd2\xf6\xbc\x40\xf9\x2b\x78\x65\x6a\x7d\xdc\x04\x87\xe5\xaf\x07\x0f\xc7\xbe\x00\x73\x38\xaf\xa3\xec\xc7\x39\x79\x92\x00\x00\xcc\x66\xb0\xb4\xed\x79\x09\x90\x05\xd5\x76\xce\x0e\x46\x1d\x9a\xb6\xad\x42\x27\x45\x4f\x7e\x0c\xfd\xec\x11\xee\x8f\xa1\xe2\x2d\xd2\xad\xad\x6b\x74\xf7\x9c\xc5\x0a\x0d\x56\x8a\xa0\x72\xb6\x05\x45\xbf\xd4\xaa\x46\x7a\x0c\xa3\x4c\x9d\xf1\x2f\x37\x42\xa3\x74\x9e\xff\x12\xc2\x10\x71\x67\xaf\x06\xc3\x22\xc2\x79\xf0\x17\x45\xcd\x0d\x51\xf7\x31\x74\xb3\xbf\xc5\xb8\xb9\xbb\x5b\x1c\x98\x70\x27\xcf\x1c\xfc\x97\xcf\x5c\xf1\x69\x10\x24\x67\x91\x5c\xef\xc5\x1b\x85\xba\x0c\x6d\x79\x52\x0d\x8f\x97\xf3\xf3\x31\x1e\x9a\xa4\x43\x35\xa4\x97\xe0\xc4\xfb\xf0\x38\x0d\x9f\xf9\x02\x71\xc9\xda\x3a\xf1\xf9\xd3\xad\x58\x86\x8b\x44\x96\xf3\xe0\x3e\x49\x26\xec\x76\xb4\x31\x54\x6f\xb4\xe3\x62\x25\xc3\xbe\x75\xb2\x50\xa6\x4e\x26\x13\x55\x81\x2a\xe1\x72\x0e\x4e\xdc\xa0\x2c\xd1\xb1\x31\x59\xfa\xc7\x45\xa4\x7b\xf1\xae\x4c\xf3\xff\xf1\x9c\x57\x73\x48\xd3\x40\x37\xf2\xfd\x9a\x46\xd0\x3f\x55\x99\x7e\x83\x39\xa8\x92\x17\x07\xd4\x1e\x5f\x9c\xc7\xd7\x1a\xf1\x01\x37\x23\xe7\x67\x28\x7b\xf4\x5e\x59\xf3\xeb\x94\x97\x31\xe0\x25\xca\x11\x74\xa4\x32\x50\xe6\xc5\x1d\x52\xef\x0c\x0c\xf3\x92\xc9\x3e\xe7\x32\x8b\x1f\x95\x49\xf6\xc9\xf1\x5c\x8e\xe7\x6f\xa6\xcc\x0b\x07\x72\xfe\xc2\x18\xec\x9e\x40\xf3\x5d\x0c\x57\x4b\x5b\x3c\x20\xbd\xed\xa5\x2b\xa1\x73\x96\xb0\x88\xb7\xb1\xcd\x38\x76\x2c\xe7\xd0\xb3\x0a\x6b\x4c\xbc\x94\x29\x0f\xd6\xe8\x2d\xf4\x5d\xed\x64\x89\x25\xa8\x2a\x84\xd6\x8c\xc6\x57\x0d\x66\x1f\x66\x96\x16\x7d\xb8\x03\xc5\xa3\x82\xfb\x3a\xb7\xec\xa1\x0d\x0e\x19\x63\x79\x68\x62\xca\xc3\x5a\x6a\x55\x06\x4b\x18\x51\xab\x0a\x49\xb5\x38\xb6\xb7\x23\x89\x70\x3b\xba\x8f\x77\x95\x7b\x68\x62\x3b\xa4\x6d\x87\x70\xbf\xdb\xdd\xd9\x5b\xbb\x41\x07\x3f\x91\x24\xcc\xe7\xbb\x59\x3c\x05\x0b\xe9\x49\x44\xc1\xcf\x74\xc9\x0a\x7a\x1c\xa9\x89\x58\x72\xd3\xf1\xb6\x3a\x28\xfe\xd6\xf2\xbd\xe8\x67\xab\x70\x73\xf8\xa1\x1a\xb3\x1f\xd0\xc2\x01\x96\x9f\x78\x54\xd0\x63\xe8\x2a\x4f\x9d\xfa\xe8\x54\xad\xcc\x55\x83\xc5\x03\x3a\x28\xf8\x77\xc8\xd8\x86\x01\x68\x42\x41\x8d\x3a\xc5\x22\x80\x15\x56\xd6\x61\x34\x8a\x8f\xbb\x9f\x88\xf8\x7f\x8f\x78\x7a\x46\xd7\xd6\x29\xad\xe5\x6c\x83\x2b\x1f\x36\x49\x70\xa3\x65\x9c\x12\x49\x2a\xed\x9f\x88\x75\x46\x2d\xfb\x37\xfa\xac\xac\xd5\xac\x45\xcc\xe9\xa4\xec\xbe\xa6\xc3\x2a\xe9\xb7\x84\x6b\x52\xa3\xc9\x86\x49\x39\xcc\xe7\xf0\x9a\x83\x46\x05\xc9\xf5\x98\x4c\xf6\xc9\xa4\x9f\xf2\x66\xe3\xe2\xed\x9d\x16\x0b\xe9\x3c\xc6\xa0\xaf\xaf\xbf\x71\xdf\xad\xc2\xf8\xab\x39\xeb\x7d\x8a\x50\x49\xed\x07\x88\xf8\x21\xfe\x73\x12\xd7\xdf\x7b\xa9\xdf\x58\x5d\x66\xbd\xb8\xb1\x9e\xa6\xe0\xc4\x8d\xf5\x94\x27\xfb\xe4\xaf\x01\x00\x99\x0f\xe6\x5f\xd1\x0d\x00\x00")
 
 func handlersMiddlewaresGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -184,12 +185,32 @@ func handlersMiddlewaresGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "handlers/middlewares.go.tpl", size: 3217, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "handlers/middlewares.go.tpl", size: 3537, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _svcClientGrpcClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4f\x6f\xdb\x3a\x12\x3f\x8b\x9f\x62\xd6\x08\x16\x52\xa0\xd0\xf7\x2e\x7c\xa9\xd3\x2d\xba\xd8\xa6\x46\x1a\xf4\x1d\x8a\xa2\x60\xa8\x91\x4c\x58\x26\x55\x92\xb6\x13\x08\xfa\xee\x0f\x43\x52\x8e\x9c\x3a\x7e\x3d\x18\x16\x39\xc3\xf9\xf3\xfb\xcd\x70\x38\x9f\xc3\xd2\x54\x08\x0d\x6a\xb4\xc2\x63\x05\x8f\xcf\xb0\x16\x87\x0d\x87\xdb\x2f\x70\xf7\xe5\x01\x3e\xdc\x7e\x7a\xe0\x6c\x3e\x87\x7b\xb4\x3b\xad\x95\x6e\x82\x1c\x0e\xaa\x6d\xc1\xec\xd1\x1e\xac\xf2\x08\x7e\xad\x1c\xd4\xaa\xc5\xa0\xfb\x0d\xad\x53\x46\xbf\x83\xbe\xe7\xe9\x7b\x18\x26\x02\xb8\x15\x1e\xa7\x52\x5a\x0f\x03\x23\x95\x95\x90\x1b\xd1\x20\x34\xb6\x93\xd0\x59\xb3\x57\x15\x3a\x10\xd0\xdc\xaf\x96\x20\x5b\x85\xda\x43\x6d\x2c\xf8\x35\x92\x81\xaf\x68\xf7\x4a\x22\xbf\x13\x5b\x1c\x06\x70\x69\xc9\xba\x89\x19\xc6\xd4\xb6\x33\xd6\x43\xce\xb2\x99\x34\xda\xe3\x93\x9f\xb1\x6c\xd6\x18\xd3\xb4\xc8\x1b\xd3\x0a\xdd\x70\x63\x9b\x39\x69\xbf\x2d\x99\x6f\xd1\x8b\x4a\x78\x11\x54\x94\x5f\xef\x1e\xb9\x34\xdb\x79\xb7\x69\xe6\x68\xad\xb1\x6e\xc6\x4e\x25\x8d\xb9\xd9\x28\x3f\xa7\x1f\xea\xaa\x33\x4a\x93\x63\xb2\xe5\xad\xd0\x2e\x04\xf5\x86\xfe\x51\x21\x05\xc5\xb2\xf9\x1c\x1e\x08\xe6\x94\x32\xcb\x66\x7d\xcf\x3f\x85\xcc\x56\xc2\xaf\xe1\x66\x18\x60\xee\xf6\x94\x40\xf7\x08\x24\x5c\xbd\x3f\x15\xcf\x58\x11\x30\xbe\xc3\x03\x58\xf4\x3b\xab\x1d\x08\x3d\x82\x06\x8f\x42\x6e\x62\x09\x9c\xc2\x2d\x8d\xd6\x28\xbd\x32\x9a\xc3\x27\x0f\xca\x11\xf8\x64\xc7\xa2\xeb\x8c\x76\xea\x51\xb5\xca\x3f\x83\xa9\x03\x2b\x52\xb4\x2d\x5a\xf0\x06\x2a\x25\xda\x12\x84\xae\xa0\x15\x1e\x2d\xc8\xd6\x38\x2c\xa3\xd2\x8b\x4d\x56\xef\xb4\xa4\x98\x72\xda\x84\x6b\xca\x97\x2f\x83\xeb\xa5\xd1\xba\x04\xd3\x91\x9e\x03\xce\xd3\xf6\x97\xb0\x51\x40\xde\x3d\xf2\xdf\x6a\x80\x56\x68\x4b\x08\x8c\x14\xd0\xb3\x6c\x2f\x2c\x48\x99\xb2\x59\x1a\x5d\xab\x86\xb1\x8c\x8a\xe8\x67\x09\x35\xbc\x5b\x80\x15\xba\xc1\xa3\x9f\x9e\x65\x19\x5a\x4b\x82\x3a\xff\xb7\x94\x05\xcb\x32\x55\x93\x41\xf8\xd7\x02\xb4\x6a\x83\x46\x16\x11\xa4\x75\x72\xe6\xf8\x5f\x56\x74\x39\x5a\x5b\xc2\x4c\x0a\xad\x8d\x07\xd1\x75\xed\x73\xb2\x3c\x23\x43\x03\xcb\x06\xc6\x32\x39\x49\xc4\x91\xa7\xef\x3f\x4e\xca\xe2\x24\x53\x72\x77\x4e\xfa\x1e\x6b\x63\x31\xa7\x60\x52\x59\x7f\x13\xed\x0e\xdd\x83\xf9\x78\xbf\x5a\x7e\x4e\xd5\x9a\x4b\xc9\xd7\x28\x2a\xb4\xae\x28\x4a\x72\x9f\xf5\xfd\x0d\x1c\x94\x5f\xc3\x95\x47\x72\xce\x87\x81\x65\x93\xdd\x6e\xd3\x10\x98\x24\xba\xf2\xc8\x53\x4f\x46\x7c\xc9\x1b\x69\x46\xcc\xae\xd4\xa8\x34\xb2\xf0\x19\xfd\xda\x54\x2e\x2a\x06\xec\xfb\xfe\xc1\xfc\xdf\x1c\xd0\xc2\x95\x4a\x24\x7d\x48\xdd\x00\x63\x5b\xf0\x71\x27\x9c\x0a\xf8\x92\x9b\xb7\x0f\x2e\xe0\x14\x91\x3b\x3c\x44\x50\xf2\x78\x96\x10\xd1\x65\xfa\x9e\xf5\xfd\x98\xd3\x30\xf0\xbe\x9f\xc6\x1b\x37\x67\x53\x55\xf5\x7a\xf3\x83\x96\xa6\x42\x02\x75\x22\xbd\xc7\x5f\x3b\x74\x7e\xd4\xb9\xc5\xb3\x3a\xa1\x43\x70\x54\x0a\x05\xfb\xd1\x04\x70\xaf\x14\x1f\xc5\xc3\xd0\x0f\xa3\xca\x49\x65\x70\xce\xd3\x7e\x71\x44\x28\x2f\xc2\x4e\x22\x02\x75\x95\xc8\x4b\x5f\xe3\x07\x03\x80\x23\xbc\xa1\xc4\xdc\x5e\x12\x4c\xa3\x1d\x47\x86\xfa\x7e\xca\xe3\x6b\x12\xe9\xd2\xa0\x66\x18\x4f\xf0\x49\x6a\x13\x26\x2e\xf0\x34\x89\x67\x6c\x98\xa3\xb5\x92\x7a\x87\xc5\x6b\x9f\x70\x83\x48\x20\x44\x24\xd9\xe5\xd0\xe2\x40\xb9\x08\x3a\xdd\x54\x02\x4e\x2f\x52\x1e\x4f\x8c\x2a\xff\xa5\xab\xc7\xaf\x45\xb8\xe4\xf6\x68\xbd\x03\x41\x76\xc3\xf5\x77\x26\x2d\xb0\x48\xfd\xec\x0d\x08\xd8\x39\xb4\x37\x95\xd9\x0a\xa5\xdf\x50\x8d\x3e\x38\xac\xac\xda\x0a\xab\xda\x67\x3a\x53\xef\x5a\x50\x1a\x44\xba\x8f\xd2\xf5\x77\x31\x91\xfc\x27\xa4\xfe\xe6\xcb\xf8\x5f\x86\xea\xbf\x0f\xc1\x28\xed\xd1\xd6\x42\x62\x3f\x14\x90\x4f\x56\xd3\x3b\x30\xc6\xfd\x6e\xf1\x72\x8e\xe7\xd7\x6f\x56\x63\x71\x24\x2b\x9c\x1b\x89\x3a\x52\xf9\x8a\xb0\xd8\x1e\x7f\x44\xd8\xa5\x4e\x3a\xcb\x57\x3c\x90\x34\xde\xa2\xeb\x9f\xa9\x88\x0e\x02\x6f\x17\xb8\x0d\x5a\x7f\xc4\xd7\xa5\x3c\xce\xd1\x35\x46\xf0\x87\x64\xfd\x0a\x53\x29\xc5\x73\x86\xa8\x20\x38\xe5\xe9\xd7\x6f\x2c\x31\xff\xdc\xe1\xc9\xd8\x03\xe7\xed\x4e\x7a\xf2\x91\x26\x02\x7c\xff\xe1\xbc\x55\xba\x49\x7d\x38\x1d\x3b\x91\x0f\x4a\x37\xac\x02\xee\x5b\x53\xa9\x5a\xa1\x8b\x43\xfc\xf8\x3e\xa0\x91\x1a\xbc\x9d\x9c\xa7\xa3\xf9\xf5\x34\x80\x22\x66\xc9\x22\x88\x4b\xff\x34\x0e\xac\xaf\xa8\xab\x7c\x83\xcf\x61\xca\xc7\x88\x8a\x53\x63\xfd\x31\xd7\x60\xd6\xc0\x39\xc3\x61\x32\x9b\x71\xdc\xc1\x02\xc8\x24\x9b\xce\x6a\x9a\x7f\x43\xf2\x7f\x69\x68\x86\x58\x46\x70\x0a\x38\x37\x7e\xa7\x45\xf9\x2a\x3a\xe9\x9f\x7e\xaf\x81\x6d\x05\xd7\xe3\x13\x92\x7f\xbe\x2d\x5e\x6b\x84\xe0\x69\x60\x76\x42\x4d\x99\xc9\xc6\xb7\xca\xe6\xe5\xad\x12\xc2\x0b\x63\x52\xd5\xb0\x2f\xc1\x04\x99\xf4\x4f\x3c\x64\x93\x6f\x0a\x9e\xa7\xd8\xff\x43\xc2\x38\x51\xa3\xe1\x05\xbd\x4a\x08\xef\xb0\x2c\x61\x53\xc2\x3e\xcc\x94\x21\xbc\x4f\xe2\x6b\x27\xaa\x4e\xdf\x3b\xd7\xdb\x0a\x16\x70\x4c\xe0\x7f\x46\xe9\xfc\x7a\x5b\x95\x2f\x5b\x2b\x3a\x13\xad\x72\xce\x8b\x62\x34\x97\x90\x91\xfe\x29\xa2\xff\x77\x00\x00\x00\xff\xff\x8b\x8c\x74\x6f\x77\x0c\x00\x00")
+var _svcBuildGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x4d\x8f\xa4\x36\x10\x3d\xdb\xbf\xa2\x82\x14\x69\x46\x62\x41\x49\x6e\x2d\xf5\x25\x99\x68\x77\x14\x6d\x76\x35\x19\x8d\x72\x0b\x06\x0a\xa8\x34\xd8\x1d\xdb\xd0\x6a\xb5\xf8\xef\x91\x0d\xa6\xa1\xa7\x93\xbd\xe1\x7a\xf5\xea\xf3\x51\x69\x0a\xbf\xa8\x12\xa1\x46\x89\x5a\x58\x2c\x21\x3f\x43\x23\x4e\x87\x04\x9e\xbe\xc0\xef\x5f\x5e\xe1\xd7\xa7\xe7\xd7\x84\xa7\x29\xbc\xa0\xee\xa5\x24\x59\x7b\x1c\x4e\xd4\xb6\xa0\x06\xd4\x27\x4d\x16\xc1\x36\x64\xa0\xa2\x16\xbd\xef\x1b\x6a\x43\x4a\xee\xe0\x72\x49\xe6\xef\x71\x5c\x01\xf0\x24\x2c\xae\x51\xf7\x1e\x47\xce\x8f\xa2\x38\x88\x1a\xc1\x0c\x05\xe7\xd4\x1d\x95\xb6\xf0\xc0\x59\xa4\x7b\x69\xa9\xc3\xb4\xc4\xbc\xaf\x23\xfe\xc8\x79\xa1\xa4\xf1\x58\x9a\xc2\x27\x71\x3a\x84\xc8\x64\xc0\x36\x08\xc3\xfc\x54\xd5\x54\xae\xb3\x19\xd4\x03\x15\x08\x8d\x30\x90\x23\xca\x55\xd7\x27\xb2\x0d\x67\xeb\x38\x7b\xb8\x5c\x8e\x9a\xa4\xad\x20\xfa\xfe\x9f\x08\x56\x7d\xb0\x34\x85\xaf\x5a\x59\xf5\x49\x98\x06\xa8\x44\x69\xa9\x22\x9c\x12\x1f\x1d\xe0\x27\x61\xbe\x95\xb4\xd2\xaa\xe3\xec\x1a\xe9\x5d\xca\x05\x5a\x27\x7d\xc1\x81\xd6\x9d\xd6\x64\x41\x07\x9b\xaa\xde\x15\xf1\x90\x39\x8f\x12\x4d\xa1\x29\xc7\xec\x31\xf6\xa1\x9c\xd7\x35\x33\x79\x9a\xdb\xfc\x80\x20\x95\x9d\xc6\x53\xa8\xae\x23\x6b\xb1\xe4\x6c\x9b\xf9\x7e\xa1\x01\x1e\x47\xb7\x9f\xd5\xb2\xdf\xaf\x64\x35\x98\x04\x9e\x2d\x14\x42\x42\xee\x4c\x16\x84\x85\xbc\xa7\xb6\x04\xb7\xef\x18\x30\xa9\xbd\xa2\xb2\x0f\x6d\x59\xb5\xa2\x36\x10\x7d\xf8\xd3\x09\xe7\xd9\x8b\xe3\xab\xb0\xcd\x38\xa6\x66\x28\xc2\x7e\xf6\xc3\x0f\xc9\x8f\xc9\x4f\x51\x16\x83\xb2\x0d\xea\x13\x19\xbc\x49\xcf\xe7\xfe\x3b\x41\x12\x3a\x55\xf6\x2d\xba\x61\xf6\x06\xcb\x84\x0f\x42\x2f\x85\x1b\xab\x49\xd6\xbe\x97\x9f\x5d\x4d\xcf\xb2\x52\xcb\x24\xa7\xa6\xa6\x5a\x6f\x5a\xe2\xf6\x7c\xc4\x15\xc5\x58\xdd\x17\x16\x2e\x9c\x85\xc8\x00\x30\x47\x87\xec\x6f\xa3\xe4\x2e\x9a\xcb\x8b\xb2\xad\x0c\xb7\x4e\xcd\x15\x71\x8e\xd7\x05\xde\x46\x3b\x06\xc4\xb9\x7d\x54\x21\xda\xad\x5b\x1d\x90\x28\xbb\x27\xb0\xff\x56\x76\x7e\x76\x0d\x93\xde\xa8\xef\x56\x26\x77\x4a\x0a\xd8\x9c\x2f\x3c\xe3\xe5\xeb\x95\x3a\x04\x21\x4b\xf8\xac\x4a\x97\xba\x04\xa1\x11\xac\x38\xa0\xf4\xff\xcb\x66\x95\x85\x92\x56\xab\xd6\x87\x22\x59\x29\xdd\x09\xeb\x12\x63\x97\x63\x59\x4e\xc7\x2c\xab\xd5\x24\xa8\x2c\x76\xdc\xb3\x8f\x87\xdd\xd1\x9e\x81\x2a\xaf\x75\x31\x08\x6a\x45\xde\x22\x67\xa1\x8a\x3b\xfb\x09\x4d\xc6\xaa\x23\xeb\xf9\x51\x76\x25\xf8\xb2\xef\x13\x1c\xb4\x25\x2d\xad\xb9\x2c\xb9\x52\x2d\x40\x20\x75\x33\xb4\x21\x8c\x5e\x81\x2f\x28\xca\xab\xa4\x34\xda\x5e\xcb\xb5\x06\xdd\x00\x82\x10\xc3\xa5\xce\x49\x0a\x7d\x4e\x78\xd5\xcb\x62\x1b\xe0\xe1\x71\xa5\xcf\x0b\x67\x9e\xbd\xdb\x5f\x8d\x17\xce\x82\x5a\x77\xae\x4e\x80\xf0\x5b\xc4\x9c\xad\x25\xea\xd0\xd5\xd3\xa1\x8b\x2e\x1d\x06\xd7\x3b\xb3\x60\x61\x6c\xbb\xad\xe2\x62\xce\x46\xce\x7c\x3b\x31\xa8\x03\xec\xf6\xe0\x0f\x7e\x72\x53\x3a\x67\x54\xc1\x77\xea\xe0\xfe\x28\x36\x4d\x02\x5c\x03\x9e\x4e\x95\xff\x0e\xf7\x00\xf6\x7b\x88\x22\xef\xb9\x35\x4f\xaa\x48\x3e\x0b\x92\xc1\x38\xf1\x9d\xd7\x47\x75\xeb\xb7\x58\x38\xab\x94\x86\xbf\x62\x77\xad\xac\x1b\xf2\x6e\x0f\x5a\xc8\x7a\xde\x43\xf2\xc7\x64\x36\x3e\xa5\x39\x91\x2d\x9a\xe0\x9a\xfc\x86\x67\x6f\x2e\x84\x41\x88\x86\xc2\x24\x41\x25\xd1\x8e\xb3\xb9\xc2\x30\x0d\xd8\x2f\xbc\x37\xd1\xf6\xb8\xe1\xb9\xeb\x78\x87\xe3\xc4\xf6\xbf\xbc\x20\xb0\x15\x77\x91\xe3\x0d\xcf\x4f\xce\xea\x1e\x23\xce\xdc\x60\x46\xbe\x99\xf5\xc8\xff\x1d\x00\xce\x16\x9d\x82\x3d\x08\x00\x00")
+
+func svcBuildGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_svcBuildGoTpl,
+		"svc/build.go.tpl",
+	)
+}
+
+func svcBuildGoTpl() (*asset, error) {
+	bytes, err := svcBuildGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/build.go.tpl", size: 2109, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _svcClientGrpcClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\x38\x12\x7f\x16\x3f\xc5\x9c\x11\x14\x52\xa0\xd0\xef\x39\xe4\x65\x9d\x6e\xd1\xc3\x35\x0d\xd2\xdc\xde\xc3\x62\x51\x30\xd2\x58\x26\x2c\x93\x2a\x49\xdb\x09\x04\x7d\xf7\xc3\x90\x94\x2c\xb9\x8e\xb3\xed\xe1\xfe\x3c\x14\x8d\xc8\xe1\x70\x66\x7e\x33\xbf\x19\x7a\x3e\x87\x85\x2e\x11\x2a\x54\x68\x84\xc3\x12\x9e\x5e\x60\x25\xf6\x6b\x0e\xb7\x9f\xe1\xee\xf3\x23\xbc\xbf\xfd\xf8\xc8\xd9\x7c\x0e\x0f\x68\xb6\x4a\x49\x55\xf9\x7d\xd8\xcb\xba\x06\xbd\x43\xb3\x37\xd2\x21\xb8\x95\xb4\xb0\x94\x35\x7a\xd9\xdf\xd0\x58\xa9\xd5\x35\xb4\x2d\x8f\x7f\x77\xdd\x68\x03\x6e\x85\xc3\xf1\x2e\x7d\x77\x1d\x23\x91\x7b\x51\xac\x45\x85\x50\x99\xa6\x80\xc6\xe8\x9d\x2c\xd1\x82\x80\xea\xe1\x7e\x01\x45\x2d\x51\x39\x58\x6a\x03\x6e\x85\xa4\xe0\x0b\x9a\x9d\x2c\x90\xdf\x89\x0d\x76\x1d\xd8\xf8\xc9\x9a\x91\x1a\xc6\xe4\xa6\xd1\xc6\x41\xca\x92\x59\xa1\x95\xc3\x67\x37\x63\xc9\xac\xd2\xba\xaa\x91\x57\xba\x16\xaa\xe2\xda\x54\x73\xba\xf4\xf5\x9d\xf9\x06\x9d\x28\x85\x13\x5e\x44\xba\xd5\xf6\x89\x17\x7a\x33\x6f\xd6\xd5\x1c\x8d\xd1\xc6\xce\x18\x4b\x48\xd2\x19\xa1\xac\xbf\x72\x2c\x57\xe9\xab\xb5\x74\x73\xfa\x37\x08\xc4\x2b\x59\x32\x9f\xc3\x23\x05\x31\x3a\xc4\x12\xbb\x2b\x60\x46\x1e\xee\x8a\x8f\xde\xfc\x7b\xe1\x56\x5d\x37\x63\x49\xf3\xe4\x37\xee\x7f\x39\xac\xc3\x95\xdf\x69\xdb\x2b\x30\x42\x55\x08\x17\xa2\x96\xc2\xe6\x70\xd1\x08\xb7\x82\xeb\x1b\x18\x22\xf5\x41\x87\x63\xb6\xeb\x58\xd2\xb6\x41\xb0\xeb\x48\xa5\x17\x1e\x14\xa1\x2a\xbb\x8e\x65\x1e\x95\x3b\xdc\x83\x41\xb7\x35\xca\x82\x50\x7d\x98\xe1\x49\x14\xeb\x90\x34\x53\x80\x0a\xad\x14\x16\x4e\x6a\xc5\xe1\xa3\x03\x69\x09\x2e\xd2\x63\xd0\x36\x5a\x59\xf9\x24\x6b\xe9\x5e\x40\x2f\x69\x03\x0a\x51\xd7\x68\xc0\x69\x28\xa5\xa8\x73\x10\xaa\x84\x5a\x38\x34\x50\xd4\xda\x62\x1e\x84\x0e\x3a\x19\xf9\x29\x97\x07\x9f\xbe\x38\x83\x62\x23\x55\xf5\x0f\x8b\x64\xb4\x0f\x26\x82\xed\x97\x61\x83\x6e\xa5\x4b\x0b\xc2\x20\x28\xed\x40\xec\x84\xac\xc5\x53\x8d\x39\x6c\x2d\xc2\x1d\xee\x83\x8a\x45\xb0\x5f\x2a\xeb\x50\x94\x9c\x1d\xe2\xb0\xdc\xaa\x82\xe4\x52\xf2\x0d\x2e\x2b\xd3\x14\x3c\x48\x2f\xb4\x52\x39\xe8\x86\xdc\xb5\xc0\x79\x5c\xfe\xec\x17\x32\x48\x9b\x27\xfe\x5d\xa2\x92\xe1\x68\x72\xf0\x69\x93\x41\xcb\x92\x9d\x30\x50\x14\x31\x80\x0b\xad\x96\xb2\x62\x2c\xa1\x4c\xff\x9a\xc3\x92\x10\x0c\xc0\xf6\xf7\xb4\x2c\x49\xd0\x18\xda\x58\xa6\xef\x8a\x22\x63\x49\x22\x97\xa4\x10\xfe\x72\x03\x4a\xd6\xa4\x34\x49\x02\x68\xf4\x1d\x2f\xb3\xfc\x9f\x46\x34\x29\x1a\x93\xc3\xac\x10\xca\x47\xa3\x69\xea\x97\xa8\x79\x46\x8a\x3a\x96\x74\x8c\x25\xa8\xca\x46\x4b\xe5\x2c\xdd\x62\x77\x05\xbf\xc3\xfd\xfb\x7e\x2d\xcd\x28\x7d\xae\x60\x2f\xdd\x0a\x2e\x1c\x92\x0c\xa7\x9c\x1a\x67\xa1\xa4\xd5\x0b\x87\x83\xfb\x9f\x02\x10\x5d\xd7\xb6\x72\xe9\xa1\xb8\x90\x07\xf8\xfc\xf1\xc3\xb5\xbc\x6d\x2f\x64\x8c\x58\x7f\x2f\xdc\xc0\xa4\xc2\xc8\xa6\x10\xf0\x94\xce\x26\x04\x4f\xee\xff\x9a\xb5\x6d\x6f\x1b\x8f\xac\x12\x54\xb5\x2d\xef\x3a\xde\xb6\x3e\xc1\xdb\x76\x6c\x5e\x10\x98\x0d\x0a\x2e\xe4\x74\xe9\xbd\x2a\x74\x89\x1f\x1e\xee\x17\xa3\xbd\x07\xfc\xb6\x45\xeb\x82\xc4\x2d\x9e\x94\xf0\x69\x8f\x41\xc4\x6f\x7c\xd0\xfd\x62\xd7\xb5\x5d\xd8\x28\x0a\x5e\x8c\x72\xc7\xa6\x19\xe7\xdc\x6f\x65\xbc\xf7\x9f\xc2\x7e\x28\xcf\xe8\xc4\xb8\x60\x59\x8f\xf9\x10\xc6\x9c\xe0\x67\xdd\x9b\x55\x43\x05\x3a\x29\x83\x81\x7e\xdd\xc9\x62\xd2\xcb\xb7\x58\x38\xd6\x61\xe4\x5c\xd8\x89\x7a\x8b\x16\x2c\xd6\x58\xc4\x66\xb3\x70\xcf\xbf\xf9\xd5\x47\xfd\x05\x55\xe9\x0b\xd4\x52\x09\x0a\x0b\x3d\xdd\xe6\x50\xcb\x35\x92\x75\x3d\xf1\xf7\xfc\x13\x3c\x0d\x9a\xee\x70\xcf\x99\x7b\x69\x70\xea\x83\x54\x0e\xcd\x52\x14\x08\xed\x84\x1f\xe5\x84\x14\x87\xb4\x8c\xc1\x95\x4b\xb8\x90\x3c\x02\x1b\xf4\x85\xdc\x1c\x81\x9a\x16\xee\xb9\x77\x8d\x2f\xc2\xff\x9e\x07\x3c\x09\x50\x92\xf2\x85\xa8\xeb\x29\x0f\x7c\xd0\x14\x23\xb8\x38\x0a\xd9\xd7\xc3\x4e\xaf\x3e\x60\xd0\x53\x44\xb4\x0b\x6b\x8b\x83\x71\x21\x7d\x7e\xc8\x3a\xa9\xe0\x72\x48\x3f\xef\x5c\xd7\xfd\xc7\x6d\x3e\xce\xd0\xae\x6f\x29\x13\xa0\xfa\xf6\x72\x3a\xd7\x5e\xe9\x34\xa4\xe8\x64\xb3\xf9\xc9\x4e\x43\xfa\x8e\x9b\x4d\xcf\xfc\x63\x6b\x7f\xbc\x0b\x8c\x4f\xff\xbf\xf3\x7e\x3c\xfc\xce\x8e\x6c\x26\xad\x81\x9c\xae\x01\x9a\x27\xa2\xdd\x01\xff\xa3\xc4\x18\x85\x28\x23\xf6\x5a\xa1\x28\xd1\xd8\x6b\x28\x0a\x1e\xff\xce\x59\xd2\xf5\xb4\x14\x8a\x76\x7c\x17\x35\xee\x6d\xe1\x28\x3c\x71\xa0\x80\x71\x22\x9e\xbc\x8e\xf5\xd7\xc0\xef\x7f\x58\x67\xa4\xaa\x58\xc7\x58\xdb\xbe\x55\xee\xe7\xaa\xdd\x23\x9f\x16\x70\x39\x36\x2e\x83\xff\x15\x07\x4c\x90\xed\x5b\xc5\xb8\x47\xa6\x05\xd7\x5b\x57\x69\xa9\xaa\x68\x0b\x71\x54\xe6\xd3\xd2\x72\xce\x23\xc4\x6d\x7b\x9e\x46\x7e\xd6\xed\xff\x2a\xb9\xfc\x7c\x30\xa4\x3a\x11\x10\xcf\x51\x07\x8a\x22\x1e\x38\x3a\x0d\xa2\x2c\x2d\xb8\x1f\x6b\x67\x4e\xf7\x93\x6f\xaf\x6d\xe8\x6a\x9c\xbd\x12\xe7\xa3\x7b\x4f\xc5\x3a\x3b\x5e\xa0\x68\x6c\xca\x1c\xbe\x52\x9a\x0f\x37\xfc\x6a\xf4\xe6\xf3\xf7\xda\x32\x92\x85\x1b\xd8\x94\x7c\xa1\x9b\x17\x9a\x28\xa2\xba\xde\x76\x1a\x5f\x3e\x45\x2d\xe9\x50\xb4\x19\x1d\xce\xe1\xdd\xa6\xcc\x06\x8e\x18\xee\xba\xc3\xfd\x89\xab\x72\x20\xe1\xe3\xc8\x92\x7a\x88\xa5\x1e\xe6\xa5\x37\x0b\xf5\xb5\x71\x71\x3e\x87\xb3\x13\x17\xb5\x03\x01\xd3\xe7\x16\x0f\x27\x7a\x91\x5f\x29\xdd\xdd\x4a\xf8\x67\xcb\x0e\x8d\xb3\x20\xc8\x4a\xdf\x66\xda\xf6\x51\xff\x5d\xef\xd1\x1c\xf2\x10\x0c\x12\x6d\x3a\x0d\x82\x1e\x0f\xe6\xaa\xd4\x1b\x21\xd5\x2b\xa2\xe1\x0e\x0e\xf7\x46\x6e\x84\x91\xf5\x0b\x9d\x59\x6e\x6b\x90\x0a\x44\xa4\xfd\x98\x0a\x67\x1d\x49\xbf\x1e\x63\x9e\xfb\x41\xf8\xc1\x1b\x33\x4c\x39\x6d\x97\x41\x3a\xfa\x1a\x97\x4b\xb0\xfb\xfa\xe6\x70\x8e\xa7\x97\xdf\x0d\xa3\x07\x6c\xbd\xfc\x61\x78\x9c\x0c\x9c\xc7\x30\xbe\x57\xff\x2e\x8c\xe7\x46\xeb\x93\x28\x86\x03\x51\xe2\x35\x10\xdf\x06\xc8\x1f\xa7\xb7\x67\x1c\x2c\xce\x48\xfd\x29\x14\xcf\xf9\x71\x0a\xc4\xde\x82\x3f\x09\xe1\x37\xaa\xf0\xde\x9e\xf4\x3b\xbe\x1d\xa3\xf7\xed\x35\xec\x42\xc7\x1d\x8f\x1c\xa3\x8e\x7b\xaa\x8d\x46\xa6\x9a\x8e\x29\x19\x1c\x3d\x56\xe0\xf7\x3f\xa6\x4f\xb3\xf1\x08\x14\x12\xd0\x73\xc6\x39\x31\x62\xf5\x53\xbb\xbf\xe0\x52\x1b\xf4\x4f\xbc\xb3\x5c\x75\x20\x2b\x1a\x3d\xfa\x59\x73\x62\x88\xcf\x25\xf2\xc8\x7f\xf9\xc2\xdf\xe8\x52\x2e\x65\x7c\xe8\x1c\x7e\xc3\xa0\x59\xcc\x87\x6a\x72\x9e\x8e\xa6\x97\xd3\x48\xf8\x22\x8b\x71\x3a\xea\x01\xe9\x1a\x5f\xfc\x34\x10\xc2\x99\xc1\x2b\x51\xa1\xb3\xa9\x86\x53\x8a\x29\x76\x89\xee\x3d\x83\x1b\x20\x95\x6c\xe8\x7e\x04\x72\xd2\x0d\x38\x9d\x8b\x0f\x1d\x1c\x90\xcd\x8e\x5e\xd2\xc1\xb0\x98\x4a\xbe\xa0\x8e\xac\x3b\xd9\xf5\x37\x25\x5c\x0e\x5d\xe0\xd3\xed\xc9\xd6\xe4\x7f\xdd\x68\x84\x1c\xa7\x55\xd2\x0f\xb9\xeb\xc3\x90\xeb\xcd\x23\x79\xfa\x29\x63\x97\x83\xf6\x7b\x85\x7b\xe6\xbe\x33\xa5\xeb\x8c\xa7\xd1\xf6\xbf\xd2\xa6\x17\x4d\x82\xe2\x1b\x10\x4d\x43\xf1\xf6\x9f\x39\xac\x73\xd8\x51\x7f\xa7\xa1\xd6\xff\x9a\x41\x3a\xfd\xde\x64\x50\xbe\x0c\xad\xb0\x77\xe0\x6f\x5a\xaa\xf4\x92\x5a\xe9\xb0\x74\x4f\x67\x52\x7f\x92\x66\xa8\xac\x57\x17\x23\x53\xb8\x67\x96\x74\xac\x63\xff\x1a\x00\x83\x05\xa1\xf5\x4d\x15\x00\x00")
 
 func svcClientGrpcClientGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -204,12 +225,12 @@ func svcClientGrpcClientGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/client/grpc/client.go.tpl", size: 3191, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "svc/client/grpc/client.go.tpl", size: 5453, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _svcClientHttpClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x31\x0e\xc2\x30\x0c\x05\xd0\xbd\xa7\xf0\xdc\xc1\x3e\x04\x4b\x47\x24\x72\x81\x28\x7c\x5a\x84\x49\x22\x63\x89\xc1\xf2\xdd\x91\x50\xf7\xf7\x22\x64\xa5\x1b\x40\x47\xfd\xbe\x64\x47\x87\x55\x1f\x26\x87\xfb\x14\xc7\x7b\x6a\x75\x7c\xb8\xe9\x13\xdd\x79\x1f\xf4\x18\x46\x6d\xdc\x41\xab\x64\x2e\x11\xad\xaa\x12\x6f\xa5\x5c\x37\xe8\x84\xf1\xe5\x4f\xcb\x59\x89\x33\x97\x5f\x00\x00\x00\xff\xff\x39\x4e\x2a\xed\x65\x00\x00\x00")
+var _svcClientHttpClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x65\x00\x9a\xff\x7b\x7b\x2f\x2a\x20\x53\x65\x65\x20\x68\x61\x77\x6b\x2f\x67\x65\x6e\x65\x72\x61\x74\x6f\x72\x2f\x68\x74\x74\x70\x2f\x74\x65\x6d\x70\x6c\x61\x74\x65\x73\x2e\x63\x6c\x69\x65\x6e\x74\x2e\x67\x6f\x20\x66\x6f\x72\x20\x63\x6f\x64\x65\x20\x2a\x2f\x7d\x7d\x0a\x7b\x7b\x63\x61\x6c\x6c\x20\x2e\x48\x54\x54\x50\x48\x65\x6c\x70\x65\x72\x2e\x43\x6c\x69\x65\x6e\x74\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x2e\x7d\x7d\x0a\x03\x00\x39\x4e\x2a\xed\x65\x00\x00\x00")
 
 func svcClientHttpClientGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -229,7 +250,7 @@ func svcClientHttpClientGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _svcConfigGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xcf\x4a\x34\x41\x0c\xc4\xcf\x93\xa7\x08\x7b\xfa\xbe\x83\xdb\xcf\x20\xfe\x3d\x8a\xee\x0b\xf4\xa6\x33\x3d\x61\xdd\x74\x9b\xa4\x07\x44\x7c\x77\x19\x64\x11\xc4\x82\x3a\x14\xf5\x2b\xa8\x9e\xe9\x94\x2b\xa3\xaf\x04\x20\xe7\xde\x2c\xf0\x1f\x4c\xbb\x2a\xb1\x8c\xe3\x9e\xda\x39\xd5\x76\x75\x92\x48\x9b\xc3\xb2\xfa\xc6\xa4\x25\xa2\xef\xe0\x3f\x40\x4a\x78\xd3\x74\x96\x8a\xd4\x34\xb2\xa8\x63\x2c\x8c\xc6\x6f\x43\x8c\x0b\xce\xc2\xaf\xc5\x71\x6e\x86\x36\x54\x45\x2b\x66\x74\xb6\x95\x0d\xe2\xbd\xf3\x65\xed\x61\x83\x02\x3f\x60\x7a\x61\x5b\x85\xf8\xba\x14\xc3\x5f\xf2\x30\xd1\x0a\xd3\x2d\x1f\x47\xfd\x0b\xf8\x41\x1e\x58\xd9\x84\x1e\x0f\x87\xa7\x67\xf6\xde\xd4\xf9\x4e\xa9\x15\x36\xdc\xbe\xef\xbf\xc3\xa5\xba\x1f\x4a\xf0\x09\x5f\x01\x00\x00\xff\xff\x09\x4c\x07\xd5\x0e\x01\x00\x00")
+var _svcConfigGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xcf\x4a\x34\x41\x0c\xc4\xcf\x93\xa7\x08\x7b\xfa\xbe\x83\xdb\xcf\x20\xfe\x3d\x8a\xee\x0b\xf4\xa6\x33\x3d\x61\xdd\x74\x9b\xa4\x07\x44\x7c\x77\x19\x64\x11\xc4\x82\x3a\x14\xf5\x2b\xa8\x9e\xe9\x94\x2b\xa3\xaf\x04\x20\xe7\xde\x2c\xf0\x1f\x4c\xbb\x2a\xb1\x8c\xe3\x9e\xda\x39\xd5\x76\x75\x92\x48\x9b\xc3\xb2\xfa\xc6\xa4\x25\xa2\xef\xe0\x3f\x40\x4a\x78\xd3\x74\x96\x8a\xd4\x34\xb2\xa8\x63\x2c\x8c\xc6\x6f\x43\x8c\x0b\xce\xc2\xaf\xc5\x71\x6e\x86\x36\x54\x45\x2b\x66\x74\xb6\x95\x0d\xe2\xbd\xf3\x65\xed\x61\x83\x02\x3f\x60\x7a\x61\x5b\x85\xf8\xba\x14\xc3\x5f\xf2\x30\xd1\x0a\xd3\x2d\x1f\x47\xfd\x0b\xf8\x41\x1e\x58\xd9\x84\x1e\x0f\x87\xa7\x67\xf6\xde\xd4\xf9\x4e\xa9\x15\x36\xdc\xbe\xef\xbf\xc3\xa5\xba\x1f\x4a\xf0\x09\x5f\x03\x00\x09\x4c\x07\xd5\x0e\x01\x00\x00")
 
 func svcConfigGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -249,7 +270,7 @@ func svcConfigGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _svcEndpointsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4b\x8f\xdb\x46\x12\x3e\x8b\xbf\xa2\x22\x78\x61\xc9\xa0\x39\xd9\xeb\x18\x73\xd8\xb5\x9d\xd8\xc0\xfa\x81\x8c\xb3\x3e\x18\x46\xd0\x22\x4b\x52\x63\xc8\x6e\xa6\xbb\x25\xcd\x2c\xc1\xff\xbe\xa8\x7e\x90\x4d\x91\xd2\xcc\xc4\x48\x16\x0b\xf8\x10\x58\xd3\x8f\xea\xaa\xef\xfb\xaa\xfa\xc1\x5c\x5c\xc0\x4b\x59\x20\x6c\x50\xa0\x62\x06\x0b\x58\xdd\xc1\x96\x1d\x6e\x32\x78\xf5\x01\xde\x7f\xf8\x04\xaf\x5f\xbd\xfd\x94\x25\x17\x17\xf0\x0b\xaa\x9d\x10\x5c\x6c\x6c\x3f\x1c\x78\x59\x82\xdc\xa3\x3a\x28\x6e\x10\xcc\x96\x6b\x58\xf3\x12\xed\xd8\x7f\xa3\xd2\x5c\x8a\x4b\x68\x9a\xcc\xff\x6e\xdb\xa8\x03\x5e\x31\x83\x71\x2f\xfd\xdd\xb6\x49\x52\xb3\xfc\x86\x6d\x10\xf4\x3e\x4f\x68\xfc\xa7\x60\x16\x72\x29\x0c\xe3\x42\x43\x85\x66\x2b\x0b\x0d\x46\x42\xc5\x6e\x10\xb8\x28\xf8\x9e\x17\x3b\x56\x02\x8a\xa2\x96\x5c\x18\x0d\x6b\x25\x2b\xd0\xa8\xf6\x3c\x47\x9d\x92\x25\x85\xbf\xef\x50\x1b\x60\xa2\x00\x85\xba\x96\x42\x23\x98\xbb\x1a\xad\x25\x1a\x4a\x41\x48\x8d\xbd\x95\x14\x98\x86\x03\x96\x25\xfd\x8b\x22\x97\x05\x2a\x4d\x06\xc8\x5e\x81\xfe\xef\xb5\x54\x7e\xa2\xb5\x96\xda\x06\x46\xe0\xac\x41\xee\x14\xe8\x5d\x5d\x4b\x45\xd0\x1a\xc5\x84\xa6\xdf\xb4\x1c\x67\x25\xff\x0f\x33\x5c\x0a\xb2\xb6\x96\xaa\x62\x46\x67\x49\xc2\x2b\x3b\x62\x91\xcc\xe6\xeb\xca\xcc\x93\xd9\x9c\x22\xc7\x5b\xfb\x53\xa0\xb9\xd8\x1a\x53\xcf\x93\x59\x6f\x6c\xbe\xe1\x66\xbb\x5b\x65\xb9\xac\x2e\x36\xf2\xf9\x0d\x37\x17\xf4\x5f\x37\xc0\xcf\x48\x66\x27\x06\x86\x78\x69\x48\xbd\x82\x79\xd3\x64\x1f\xff\xf9\xd6\xba\xf1\x91\x99\x2d\x3c\x6f\xdb\x79\xb2\xb4\x74\xbc\xee\x00\xce\x65\x59\x62\x6e\x74\x88\xd4\x6c\x23\xe0\xc0\x6c\x99\x81\x5c\x56\x35\xc1\xc2\x04\xb0\xa2\x08\x6c\x64\xf0\xd6\x3c\xd5\x64\xac\x42\x26\x0c\x81\xbf\x42\xd8\x69\x2c\x08\x65\x06\x5b\x2c\x6b\x54\xa0\x8d\xda\xe5\x26\xa5\x6e\xbf\xd4\xf4\x4a\x5c\x18\x09\x8c\xcc\x69\x2e\x36\x25\x42\xcd\x14\xab\xd0\xa0\x22\x21\x52\xfb\x5b\x01\xcc\xf1\xab\x52\xe0\xe6\xa9\xa6\xc5\xd6\xbb\xd2\xf2\xb4\xde\x89\x9c\x38\xf0\x2e\x0b\x24\x9a\x24\xc8\xda\x66\x03\x48\x9a\x5b\xa3\x7a\x1e\x16\x24\x83\x2b\xa6\xb9\xce\xe0\x27\xa9\x00\x6f\x59\x55\x97\x98\xc2\x9d\xdc\x41\xc5\x37\x5b\x03\x35\xd3\xa4\x91\x08\x2a\x72\xb0\x5b\xc8\xad\x53\x2b\x59\xec\x72\xb4\x30\x30\x01\x44\x50\xf6\x86\x89\xa2\x24\x1f\x0f\xdc\x6c\x01\x59\xbe\xf5\x52\x87\x45\x58\x7d\x09\x07\xae\xb0\x80\x5d\xed\x8c\xea\x1a\x73\xbe\xe6\x39\xd4\xcc\x6c\x33\x58\xbc\xb5\xfe\x71\x4d\xf6\x57\x6c\x55\xde\x01\x83\x8a\x6b\xe3\xd2\x04\x0a\xd4\x7c\x23\x68\x2a\x17\x7b\x79\x83\x16\xca\x6b\x47\x4b\x97\x56\xd6\x45\x1c\x92\xed\xc8\x20\x13\x01\xc9\x6c\x19\xa3\x9b\x97\x1c\x85\x19\xa2\x1b\x11\xd7\x67\x68\x79\x47\x79\xec\xcc\x61\x71\x8e\x46\xca\x25\x87\x15\x27\x84\x2b\x74\xb2\xea\xfd\xe5\xc2\xa0\x5a\x33\x12\xd4\x34\x13\x64\xac\x5b\x6c\xba\x4a\xec\x68\x31\x18\x26\x4a\xf6\x1e\x0f\x2f\x7d\x3c\xb9\xac\x56\x5c\x58\x9c\x2a\xef\x62\x44\x6c\xea\x6b\x89\xd9\x29\x01\xdc\x2a\x99\x1c\xcc\x59\x59\xa2\x72\x62\xf6\xce\x66\x89\x0d\x67\x04\x68\x43\x09\x97\xfd\x2a\xba\x10\xb1\x68\x9a\x9f\xe5\x7b\x56\x21\x64\x61\x2e\xfd\xd5\xb6\xd7\x16\xf6\x64\x46\x2e\xba\xdf\x1f\x6a\x27\x5c\x00\xa8\x58\xfd\x45\x1b\xc5\xc5\xe6\xeb\x97\xaf\x5d\x38\x59\x3c\xce\xcd\xfc\xc5\x15\xc1\x57\xa1\x76\xc5\x33\xfb\x79\xae\xdb\x8f\xfd\x69\x27\xf2\x30\xd9\x55\xcd\xd7\xa1\x12\x4e\x4e\x76\xbd\x61\x6c\x3f\xdb\xcb\x9b\x1a\xac\xcf\xf1\x6c\x4a\x8e\x85\x05\x3f\xcc\xfb\x4c\x9b\x8a\x4a\xe1\x99\x6f\xb5\xae\x2c\x93\xa4\x69\x14\x13\x1b\x84\x27\x1c\x2e\xaf\x7a\x8c\xde\x39\xf1\xb6\x6d\x32\x6b\x1a\xe0\x6b\xcb\xcc\x42\x48\x03\x4f\x78\x98\x7d\x6d\x14\xb2\x6a\x19\x35\xbb\xa5\x42\x3b\x4d\x9e\x35\xcd\x13\xee\x11\x0f\x6c\xcd\x82\x5e\xb2\xd0\x62\x17\x41\x51\xd0\x94\xa6\x79\x4e\x3f\xdb\x36\x69\x93\x84\xe2\x80\xf7\x78\xe8\x88\x5e\x2c\x23\xd2\x9b\x64\xe6\xc5\xd2\xb5\x35\xc9\x6c\xcc\xe8\xe5\x0c\xec\xce\xb6\x78\x00\xad\xcb\xd4\x5b\x38\x62\xf6\x72\x6c\xe2\x0c\xbf\x91\x95\x21\xc5\x97\x67\xac\x8c\x89\xee\xcc\xc4\x5c\x4f\x45\xf3\x50\xbe\xc9\xa0\x05\x36\xae\x46\x7f\xba\x06\x2c\x8b\x8b\x28\x5d\x97\x10\xc9\x62\x91\x9b\x5b\xf0\x5b\x72\xf6\xd2\xfd\x9b\x52\x69\x7c\x56\xaf\xb2\x2e\x7b\xfb\x25\xdb\x76\x09\x8b\x71\x9f\x5b\xb7\x6d\x53\x40\xa5\xa4\x5a\x92\x3a\x66\xb3\x70\x30\xb1\xad\x14\x1d\x66\x13\x8a\x24\x17\x68\xc9\x25\x4d\xe1\x6b\x3b\xf6\x87\x2b\x10\xbc\x74\x56\x82\xcc\x04\x2f\xad\x21\x6a\x6b\x93\xbe\x3d\xac\x92\x9d\xf1\x6b\x99\xd2\xf4\xc4\x4e\x8c\xd5\xee\xb4\x4e\x84\xbc\xa3\x5d\x25\x66\xc5\xee\x5b\x4f\x0c\x5a\x56\x1c\x0d\x31\x51\x4f\x0c\x4e\x71\xf5\x8d\x64\x39\xb6\xc8\x97\x29\xa0\x34\xd8\x00\xe3\xa5\xe3\x72\xba\x84\x51\x6e\x0f\x11\xb4\x42\x9d\x24\x3c\x1c\x27\xbb\x6d\xa8\x21\x9e\xbb\x83\x65\xd4\xec\xb8\x8c\x59\x26\xf3\xbf\x13\x24\xde\xc8\x14\x0f\x41\x3b\x6e\xfc\xbe\x13\x84\xce\x8e\xb4\x68\x5d\xf1\xc3\xa6\xb4\x30\xa9\x06\xaf\x87\xae\x6f\x1f\xd8\xf6\x1d\x9e\x17\xcf\xfa\x2c\xd0\x1e\xd3\xff\x59\xb1\xfa\x1f\x65\xf9\xfa\x36\xc7\xda\xc0\x41\xb1\x5a\xbb\x43\x4b\x87\xe4\x9a\x63\x59\xd0\x89\xcd\xef\x76\x7d\x25\xb4\x4a\xb1\xbb\xfd\xc4\x31\x34\x7b\xc7\x8b\xa2\xc4\x03\x53\xee\x2e\xf1\xab\x0e\xb7\x0b\x3a\x57\xd7\x75\x79\x47\x9b\x36\x1d\x44\x0c\x19\xaf\xba\xd1\xf6\xa4\x85\x7b\x54\x77\x1d\xad\x94\x97\xb4\x27\x87\xb3\x27\xd9\x73\x85\x93\xce\x22\x69\x74\x14\xc8\x99\xa0\x73\x28\x9d\xde\xb0\xa0\x69\xab\x3b\x10\xc4\x86\x3b\x9f\xe2\x6d\x5e\xee\x0a\x2c\xdc\xc5\x62\x85\xe4\x02\xc5\x5c\x63\x91\x8d\xd0\x58\xf4\x3e\xa5\x30\xbf\x36\xcc\xec\xf4\x3c\x85\xf9\x47\x2e\x36\xf3\x65\x12\xea\xcb\xb3\xa8\xc0\x9c\x9a\x0f\x13\xa8\xa4\xbd\x37\x59\x96\xb9\x92\x6a\x95\xc5\x85\x6f\xbe\xbc\x8a\x77\x57\x07\x7f\xd3\x36\x96\xd4\xe7\x70\x5f\xf5\xfc\xe6\x94\x9c\xcd\x23\x8d\xce\x2f\xa1\x69\x53\x6f\x35\x08\x6a\xd6\x6f\x9b\xb3\x36\x49\x66\x44\xed\x6f\x14\x97\xcd\x0a\xeb\x5f\x17\x23\xb9\xcd\xd7\xd4\x2d\x6f\xa8\x3b\x44\xf9\x05\x6f\xbf\xbe\x80\x1f\xe4\x8d\x13\x7a\xcd\x04\xcf\x17\xeb\xca\x64\xd7\xb5\xe2\xc2\xac\x17\xf3\xd7\xc1\x44\x27\x87\xa7\x7f\xd3\x4f\xa1\x90\xa8\x81\x02\xc0\x5b\xae\xcd\x0b\xd0\x88\xb1\x8a\x3a\x21\xea\x6c\x23\xe7\xe4\xd4\x72\xe9\xea\xe0\xac\xc0\x12\x0d\x2e\x82\x07\xb6\xaf\x0f\x80\x8b\xbc\x77\xbf\xe3\xe2\xaf\x43\x9d\xaf\xad\x0b\x57\x57\x30\xc0\x3f\xd4\x81\xc9\xad\x04\xae\xa2\xd0\x17\x93\x43\x96\x7d\x59\x38\xc9\xa0\x2b\x09\xff\x62\x2b\x2c\xb1\xe8\x95\xea\x1e\x09\x36\x68\x42\x5e\xc5\x77\x37\x97\x5e\x87\x2d\x8a\xae\x57\x46\xa9\xe4\x8d\xb9\x8c\x48\x5d\x05\xf0\x49\xba\x73\x83\xc1\xbd\x3c\x30\xf7\x78\xc1\x73\xba\xc2\x28\x9e\xbb\xbb\x65\xe4\xc3\x96\xe7\x5b\x3b\x55\x93\xf3\x63\x17\xfc\xb9\xdd\xcf\x0e\xb7\x16\xa9\xfc\xa9\x7d\x1c\x95\xdd\x15\x5c\x72\xa5\xe3\x1d\x64\x62\x53\x49\x4e\xc5\xf5\x87\xeb\xe6\xc8\xa9\xd4\xc7\x69\x11\x57\x98\x23\xdf\xbb\xfb\x9d\x0d\xf1\xe8\xda\x9c\xc1\x35\xe2\xa4\x19\xdb\x13\xee\x9d\xc3\x12\x4f\x12\x2f\xd0\x30\x5e\xda\x4b\x62\xc8\x4f\xfb\x7a\xe1\x2f\xb7\xac\xe4\xe6\x2e\x3b\x57\xe0\x06\xd1\xc7\x75\xee\xd1\x98\x7e\xaf\x82\xdf\xab\xe0\x9f\x53\x05\x07\xf3\xd2\xe9\x23\xf8\xc3\x8b\xa2\x17\xfe\x67\x6e\xb6\x6f\x8c\xa9\xdd\xe9\xe3\x4c\xf6\xa3\x30\xea\x8e\x12\x76\xcd\x4b\x2c\xe0\xcd\xe8\xbe\x7f\xbe\x30\x4c\x5f\x13\x1f\x72\x90\x72\x0f\x3b\x20\xfd\x32\xff\xf3\xb3\xd4\x14\x62\x0b\x1d\x05\xf5\xc8\xb3\xd5\xbd\xf6\x4e\x60\xf7\xbd\xd0\xfc\xbf\x15\x9a\x3d\xeb\x65\x7c\xfa\x3d\x8c\xa2\x94\x21\x4a\xcc\x46\xcf\x30\x5f\xb8\xc8\xbf\xbe\x80\x10\x70\x30\x78\x45\xa9\x83\xa2\x58\xc8\x14\x62\xf5\x58\xa7\x01\x4b\x8d\xc7\xe3\xed\xeb\xc7\x29\x3f\x52\xf8\xfb\x32\x1a\xfe\xe5\xc7\xaf\x70\x35\xb0\xeb\xb1\x38\xe5\x20\x5c\x85\x50\x87\x25\x67\xa8\x76\x5f\x69\xa4\xc0\xbf\xa2\xd0\x8c\xd7\x3f\x91\xb7\xa7\xf3\xf5\x68\x7a\xa7\xa8\x70\x42\x78\x40\xde\xda\x1c\x7d\x98\x14\xee\x53\x42\x58\xbe\x97\xc3\x03\xd4\x10\x89\xa1\x1f\x7d\xca\x07\x2b\x99\x01\x48\x8e\xf7\x36\x99\x64\xbe\x73\x28\xa2\xdf\x71\xff\x33\x9a\x31\x93\xee\x96\xef\xbe\xd5\x4c\xaf\x0f\x4c\x6b\x99\x73\xfb\x05\xd0\x72\x4c\x95\x7e\xc3\xf7\x28\xfa\x93\x57\x32\xf1\x30\x36\xb5\xdc\x31\x5b\xcb\x93\x51\xdb\x2a\xba\x0e\x11\x3c\x8e\x01\xff\x72\x11\x89\x3f\x34\xdd\x93\x6f\x3f\x2e\x3d\x54\xd7\xce\xf7\xe1\xcb\x29\x01\xc1\x37\x84\x15\x9c\x79\x30\x05\xf7\x29\xe0\x2c\x34\x93\xe6\xc7\x4a\xf6\xdf\x11\xcf\xad\x66\x95\xec\x50\x39\x7a\xe6\x1d\x08\xc1\x5b\x1a\x0a\xe1\x28\xba\xa0\x04\xa2\xf7\x5c\x7c\xdf\x26\x87\xfb\x62\x5e\xb3\xb2\x5c\xb1\xfc\xe6\x7c\xd0\xe7\xfc\x73\xc2\xf1\x11\x0f\x85\x73\x12\xa2\x63\xe9\x04\xbc\x22\xe9\x04\xc7\x8e\x15\x32\x78\x15\x9f\x94\xc8\xf8\x35\xfc\x51\x1a\x19\x2c\x30\x06\xcc\x7f\x7c\x3e\xbb\xde\x40\x25\xc3\x67\xfc\x81\x4c\xbc\xad\x63\x99\x0c\x43\x9c\xd6\xc9\x44\x90\xdf\x2a\x94\x7b\x02\x9f\x50\xca\x54\xe4\x67\x5d\x74\x5a\xf1\x61\x1f\x6b\xe5\x14\x50\xc7\x62\x09\xa8\xdd\x2b\x96\xe8\xdb\x47\x24\x94\x7c\xa7\x8d\xac\xec\x87\x5e\x88\x47\x0c\x35\x02\x5c\x68\x83\xcc\x5e\xfe\xfd\xb7\xc9\x2d\x42\x81\x6b\xb6\x2b\x0d\xed\xde\xe7\x44\x14\x99\x1d\xe3\xb8\x75\x9d\xf0\xe0\x2f\x2f\xbd\x98\xe2\x8f\x39\x03\x21\x79\x9b\x43\x21\xc5\xc1\xc5\x22\x1a\x45\xee\xfe\xb7\x89\xc7\x88\xe5\x4c\x80\xcb\x07\x07\xe6\xd5\xb0\x0d\x1f\xdb\x63\x35\x4c\x47\x7a\xac\x84\x10\x76\xa4\x04\xc1\x4b\x8f\xc2\x1b\xa6\x8f\x51\xc8\xb7\x98\xdf\x68\x7b\xc2\x3f\x29\x03\xae\xff\x68\x22\x8d\x17\x1c\x63\xb3\x92\xd2\x7e\x0d\xf8\xed\x21\xe1\x76\x41\x49\x12\xf6\x7f\x03\x00\x00\xff\xff\x3a\x1d\x6e\x31\x20\x24\x00\x00")
+var _svcEndpointsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x8f\xdb\xb6\xb2\x7f\x96\xfe\x8a\xa9\x91\x7b\x63\x15\x8a\xb6\xf7\x75\x8b\x7d\xb8\x37\x49\x93\x00\xb7\x69\xd0\xa4\x27\x0f\x41\x10\xd0\xd2\xd8\x22\x56\x26\x55\x92\xde\x8f\x63\xe8\x7f\x3f\x18\x7e\x48\x94\x25\x3b\xde\x6c\x0f\x0e\x0a\xe4\xa1\xcd\x5a\xe4\x0c\xe7\xe3\x37\x3f\x8e\x28\x5e\x5c\xc0\x73\x59\x21\x6c\x50\xa0\x62\x06\x2b\x58\xdd\x43\xcd\x6e\xaf\x0b\x78\xf1\x1b\xbc\xfd\xed\x03\xbc\x7c\xf1\xe6\x43\x91\x5e\x5c\xc0\xef\xa8\x76\x42\x70\xb1\xb1\xe3\x70\xcb\x9b\x06\xe4\x0d\xaa\x5b\xc5\x0d\x82\xa9\xb9\x86\x35\x6f\xd0\xce\xfd\x07\x2a\xcd\xa5\xb8\x84\xfd\xbe\xf0\x7f\x77\x5d\x34\x00\x2f\x98\xc1\x78\x94\x7e\x77\x5d\x9a\xb6\xac\xbc\x66\x1b\xa4\x91\xf7\x37\xe5\x3b\xf7\x8b\x06\x2e\x2e\xe0\x43\x58\x02\x4a\x29\x0c\xe3\x42\xc3\x16\x4d\x2d\x2b\x0d\x46\xc2\x96\x5d\x23\x70\x51\xf1\x1b\x5e\xed\x58\x03\x28\xaa\x56\x72\x61\x34\xac\x95\xdc\x82\x46\x75\xc3\x4b\xd4\x39\x59\xa1\xf0\xcf\x1d\x6a\x03\x4c\x54\xa0\x50\xb7\x52\x68\x04\x73\xdf\xa2\xd5\x44\x53\xc9\x21\xa9\x71\xd0\x92\x03\xd3\x70\x8b\x4d\x43\xff\xa2\x28\x65\x85\x4a\x93\x02\xd2\x57\xa1\xff\xbd\x96\xca\x0b\x5a\x6d\xb9\x7d\xc0\x28\x50\x6b\x90\x3b\x05\x7a\xd7\xb6\x52\x51\x98\x8d\x62\x42\xd3\xdf\x64\x19\x67\x0d\xff\x27\x33\x5c\x0a\xd2\xb6\x96\x6a\xcb\x8c\x2e\xd2\x94\x6f\xed\x8c\x65\x9a\x2c\xd6\x5b\xb3\x48\x93\x05\x79\x8e\x77\xf6\x4f\x81\xe6\xa2\x36\xa6\x5d\xa4\xc9\xa0\x6c\xb1\xe1\xa6\xde\xad\x8a\x52\x6e\x2f\x36\xf2\xd9\x35\x37\x17\xf4\x5f\x3f\xc1\x4b\xa4\xc9\x91\x89\xc1\x5f\x5a\x60\x23\xe5\xa6\xc1\x62\x23\x1b\x26\x36\x85\x54\x9b\x8b\x8d\x6a\xcb\x45\x9a\x26\xed\x0a\x16\xfb\x7d\xf1\xee\xff\xde\x58\x03\xdf\x31\x53\xc3\xb3\xae\x5b\xa4\xc9\x7e\xff\x0c\x14\x13\x1b\x84\x27\xac\xe1\x4c\xe7\xf0\xa4\xa5\xd1\xcb\x2b\x28\xde\xbb\x14\x14\xaf\xa4\x13\xd3\x5d\x47\x02\x6e\x62\xd7\x91\x4a\x3b\xb9\x57\x84\xa2\xea\xba\x34\xb3\xc9\x7f\x19\x12\x01\xa5\x6c\x1a\x2c\x8d\x0e\x71\x35\x75\x94\x26\x30\x35\x33\x50\xca\x6d\x4b\xd9\x63\x02\x58\x55\x85\xdc\x17\xf0\xc6\x3c\xd5\xa4\x6c\x8b\x4c\x18\x4a\xf5\x0a\x61\xa7\xb1\xa2\x9c\x32\xa8\xb1\x69\x51\x81\x36\x6a\x57\x9a\x9c\x86\xfd\x52\xf3\x2b\x71\x61\x24\x30\x52\xa7\xb9\xd8\x34\x08\x2d\x53\x6c\x8b\x06\x15\x95\x00\x3d\x7f\x23\x80\xd9\xc5\x51\xe5\xc0\xcd\x53\x4d\x8b\xad\x77\x8d\x45\xc5\x7a\x27\x4a\xca\xb8\x37\x59\x20\x81\x42\x82\x6c\x6d\x1d\x82\x24\xd9\x16\xd5\xb3\xb0\x20\x29\x5c\x31\xcd\x75\x01\xbf\x48\x05\x78\xc7\xb6\x6d\x83\x39\xdc\xcb\x1d\x6c\xf9\xa6\x36\xd0\x32\x4d\x88\x8c\x42\x45\x06\xf6\x0b\xb9\x75\x5a\x25\xab\x5d\x89\x36\x0c\x4c\x00\xc1\xa1\x78\xcd\x44\xd5\x90\x8d\xb7\xdc\xd4\x80\xac\xac\x7d\x61\xc1\x32\xac\x9e\xc1\x2d\x57\x58\xc1\xae\x25\x23\x19\xe8\x16\x4b\xbe\xe6\x25\xb4\xcc\xd4\x05\x2c\xdf\x18\x52\xc8\x35\xb4\x4a\xae\xd8\xaa\xb9\x07\x06\x5b\xae\x8d\x2b\x4a\xa8\x50\xf3\x8d\x20\x51\x2e\x6e\xe4\x35\x55\x17\x82\xc7\x43\x5f\xc4\xd6\x44\x1c\x27\xdb\x25\x03\xf8\x10\xc9\x22\x8b\xa3\x5b\x36\x1c\x85\x19\x47\x37\x4a\xdc\xc0\x07\xcd\x3d\x94\x52\x38\x75\x58\x9d\x4a\x23\x55\xae\x8b\x15\xa7\x08\x6f\x91\xec\x88\xed\xe5\xc2\xa0\x5a\xb3\x12\x8f\x65\x82\x5c\xe8\x17\x9b\xe7\xa4\x1d\x61\x66\x20\x01\x5b\x96\xc5\x5b\xbc\x7d\xee\xfd\x29\xe5\x76\xc5\x85\x8d\xd3\xd6\x9b\x18\x25\x36\xf7\xcc\x65\x76\x4a\x00\xb7\x48\x26\x03\x4b\xd6\x34\xa8\x1c\x98\xbd\xb1\x45\x6a\xdd\x99\x04\x74\x4f\x45\x5c\xfc\x21\x7a\x17\xb1\xda\xef\x5f\xc9\xb7\x6c\x8b\x43\xa1\xd2\xaf\xae\xa3\x5f\xa8\xd2\x84\x4c\x74\x7f\xff\xd6\x12\x9e\x34\x00\xc0\x96\xb5\x9f\xb4\x51\x5c\x6c\x3e\x7f\xfa\xdc\xbb\x53\xc4\xf3\x9c\xe4\xef\x8e\x72\x5f\x04\xa6\x8c\x25\x07\x39\x37\xec\xe7\xfe\xb2\x13\x65\x10\x76\x1c\xfd\x32\xf0\xee\xac\xb0\x1b\x0d\x73\x07\x69\x0f\x6f\x7a\x60\x6d\x8e\xa5\xa9\x38\x96\x34\xa9\x08\x72\x1f\x69\x3b\x53\x39\xfc\xe8\x9f\x5a\x53\xb2\x34\xdd\xef\x3d\xaf\xf1\x11\x99\xfd\xea\xc0\xeb\xa8\x0c\xf8\x1a\x9e\xf0\xe2\xbd\x51\xc8\xb6\x94\x60\x7a\x4c\x14\xc7\x7d\x2c\x43\x1e\x12\x37\x25\xfc\xb4\xb2\xd8\x68\x3c\x2e\x10\xa0\x53\x8c\x65\x44\x45\x22\x03\x59\xba\x9d\x72\xac\x1d\xb8\x1e\x51\x17\x51\x19\x03\xdd\x1b\xe9\xea\xaf\x80\x0f\x35\xf6\x3b\xa3\x13\x21\x5d\x6b\xae\xb4\x81\x2d\x6a\x4d\xfb\xb2\x13\xb5\xd9\x7d\x76\xa8\xc1\x82\x52\xf0\x06\xa4\xa9\x51\xdd\x72\x8d\x39\x29\xf1\x2b\x79\x7e\xa0\x07\x9b\xdf\xdf\x3d\xf7\x4f\x03\xad\x3a\x0d\x39\x60\xb1\x29\xa0\x5d\x15\xc7\xc0\xf8\xc5\xc5\x9b\x1e\x3a\x9e\x85\x5f\x79\x55\x35\x78\xcb\x14\x12\x2c\xee\x41\x61\xdb\xb0\xd2\x56\x0e\xf8\xdd\xd2\x99\x51\x3b\x18\x80\xc2\x12\xf9\x0d\x6a\x2a\x1c\xa6\x5d\xe1\xb8\x79\x64\xa2\x5c\x03\x37\xda\x5b\xe7\xab\xe7\x20\x9c\x16\x33\xa5\xb9\x0b\x62\xc5\xf3\xb0\x4c\x1f\xbd\xc0\x10\xfb\x2e\x0f\x8e\xd2\xde\xe9\xeb\xc2\xe9\xcb\x00\x95\x92\x2a\x4a\xd8\xe0\x0a\xa5\x8c\x41\x59\x33\x2e\xd8\xaa\x41\x58\x61\xcd\x6e\xb8\x54\xb0\x95\x15\x5f\x73\x54\x76\x0b\x61\x07\x89\xb6\xbd\x4d\xc3\xaf\x87\x54\x17\x91\x4a\x92\xd8\x09\xa6\xee\xfb\x51\x3d\xf2\x2f\x9e\x4a\x1e\x8e\x75\x67\x07\x6b\xa5\x29\x85\x01\xde\xe2\x6d\x78\xa2\x97\x59\x44\xdc\xfb\x34\xf1\xfc\xd4\x3f\xdb\xa7\xc9\x94\x44\x2e\x13\x22\x91\x6b\x5c\x9e\xc1\x24\x59\xee\x35\x1c\x90\xc9\xe5\x54\xc5\x09\x4a\x89\xb4\x8c\x59\xe5\xf2\x84\x96\x29\xb7\xf4\x6a\x62\x7a\x99\xf3\xe6\x5c\x8a\xc9\xf2\x34\x09\x05\xdc\xc7\xec\x6c\xda\xa1\xda\x5b\x0a\x69\x88\x7f\xbc\xc6\x00\xb3\xe1\xb1\x5b\x3e\x3c\xb7\x5c\x43\xd6\xc1\x32\xda\x21\x32\x88\xe8\x67\x1e\xe6\x5c\xc0\x8f\x76\xd2\x2b\xe9\x97\xea\xba\x0c\x96\xc3\x33\xb7\x4e\xd7\xe5\x0e\xe2\x19\x50\xea\x93\xd0\x69\xdb\xa7\x44\xa2\x58\xcc\x30\x1d\x2d\x99\x03\x17\x19\x89\xf0\xb5\x9d\xfb\xc3\x95\xe5\x15\xab\x25\xc0\x4a\xf0\xc6\x2a\xa2\x67\x5d\x3a\x3c\x0f\xab\x14\x33\xf6\x64\x39\xe9\x49\xad\x40\x60\x5c\xbe\x9e\x84\xec\xac\xc8\xf8\xb2\x8e\xb9\xea\xc9\x21\x59\x0d\x23\x41\x8c\x26\xa0\xf2\xa5\xef\xc2\xc2\xd7\xf3\x81\x80\xab\x19\xaf\xf1\xc8\x9e\x7d\xb8\xb4\x27\xc8\xa9\xc1\xd9\x61\xbc\xe6\x93\xe0\xf9\xcf\xa7\x7c\xe9\x02\x17\xb8\x2c\x1b\x47\xf0\x9c\x68\xcd\x41\x26\x87\xbf\x5d\x0c\xb9\xc8\xe1\x91\x71\xe4\x62\x26\x8c\x61\x1b\x77\x9b\x38\x31\xc0\xaf\xec\x3a\x0a\x66\xba\xdf\xdb\xde\xfc\x89\x41\x2a\x9c\x82\x42\x3e\x66\x86\x27\x06\xe7\xc8\xe1\x91\xec\xe0\xd2\x4a\xb6\xcc\x3a\xe7\x32\x17\x2f\x7d\x90\xa1\xc3\xa6\x65\x9c\x88\x07\x6d\xa4\x19\x2c\x43\x69\x8f\xf7\x57\x22\x88\x98\x66\x28\x23\x7f\x52\x48\xbc\x92\x62\x39\x41\x1e\x45\x3e\x49\x92\x9b\x9e\x89\xf4\x28\xcb\x96\x81\x14\xfe\xe9\xa7\xcd\x91\xd0\x2c\x0d\x79\x40\xf4\x63\x37\x81\x6e\xfc\x80\xcf\xc7\x50\x34\x8f\x0c\xef\x78\x47\xf6\x96\x7d\x4b\x6c\xbf\xda\xa4\x04\xaf\x6f\x15\x6b\x5b\xac\x28\xba\xff\x6d\x1b\x9a\x57\x74\x6e\xc4\xcb\x58\xe4\xd3\xb4\xce\x27\x44\xfc\x39\x44\x31\x16\xbc\x0c\xb6\xba\x9f\xfb\xf1\x98\xb3\x30\x87\xd2\xdc\x5d\xd2\xff\xba\x7c\x14\x72\x6a\x81\x67\xa8\xbc\xeb\xc6\xe9\x1a\x27\xda\xbb\xe3\xf3\x4c\x2a\x28\x37\xa7\x65\x4e\xa0\x2a\x87\x19\x85\xb6\xa2\x27\x10\x70\x05\x9f\x84\x8a\x8f\x2b\x7f\x14\x84\xd0\xce\x8e\xfa\x54\x6a\x9a\xd9\xa8\x8b\x5e\xdd\xdb\x71\x29\xe8\x14\x42\x6b\xac\x48\x91\xa9\x95\xdc\x6d\xea\xa8\x07\x87\x6d\xdf\xe3\x85\xde\x6f\xbc\xda\xf0\x76\x38\x41\x42\x9a\xcc\xa0\x89\xda\x15\x8b\xe1\xa5\x1e\x6b\xca\xc0\xcf\x58\x66\x87\x32\x51\x6b\xa8\x8b\xd2\xdc\xf9\x96\xe7\xa3\x62\xed\xff\x36\xcd\xcb\xbb\x12\x5b\x63\x03\xa9\xdd\x51\x44\x8f\xef\x35\xc7\xa6\x22\xdf\xbd\x95\x61\x40\x83\xe5\x46\xfb\x0e\x3f\x73\x94\x15\xf5\xc0\xf6\x85\xe1\x0f\x1d\x4e\x2b\xa9\x85\x6e\xdb\xe6\x9e\x5e\x61\xe8\x78\xc1\x90\xf2\x28\x44\xf4\x5e\x8d\x37\x18\x75\xcc\x74\x10\x61\xc3\xe9\xeb\x91\xf4\xb9\xb7\x61\x3a\x61\xc8\xfb\x79\x1a\x4a\x26\x60\x15\xb2\x41\x62\xab\x7b\x10\xb4\xcb\xb8\x53\x27\xbc\x2b\x9b\x5d\x85\x95\x3b\x9c\x5c\x21\x99\xe0\xc1\x53\x4c\xa2\xb1\x1c\x6c\xca\x61\xf1\xde\x30\xb3\xd3\x8b\x1c\x16\xef\xb8\xd8\x2c\x32\x9f\x00\x84\x1f\xfb\x80\x64\x47\xe5\x61\x26\x2a\xf9\x60\x4d\x51\x14\xae\xf5\xb5\x2d\x1b\x17\xfe\xf1\xe5\x55\xfc\xce\xec\xc2\xbf\xef\xa8\x86\xa3\xf3\xbe\xa3\x0d\xea\xa3\x37\xa1\x64\x11\x15\xe0\xe2\x12\xf6\xae\xf6\xa3\x3a\x8a\x4b\xad\x4b\xd3\x84\xde\x75\xbe\x90\x5f\x64\x93\xb3\xaf\xf7\x91\xcc\xe6\x6b\xf8\x92\x83\xbc\xa6\xe1\xe0\xe5\x27\xbc\xfb\xfc\x33\xfc\x20\xaf\xc9\xf5\x24\x69\x99\xe0\xe5\x72\xbd\x35\xc5\xfb\x56\x71\x61\xd6\xcb\xc5\xcb\xa0\x22\x04\x11\x9e\xfe\x97\x7e\x0a\x95\x44\x0d\xe4\x00\xde\x71\x6d\x7e\x06\x8d\x18\xa3\xa8\x07\xa2\x2e\x36\x72\x41\x46\x65\x7e\xe7\x4f\x2a\x6c\xd0\xe0\x32\x58\x60\xc7\x06\x07\xb8\x28\x07\xf3\xc3\x1c\x6b\x9c\xbe\xe5\xa6\xac\xed\x84\x87\x24\xc1\x32\xa4\x8f\xf4\xc1\x19\x45\x92\x94\x4c\x23\x8c\x03\x6d\x9f\x27\x47\x1a\xab\xc8\xc5\xe5\xec\x94\x2c\x2c\x3a\x97\xa4\xa4\x1b\x5e\x76\xfe\x9f\xad\xb0\xc1\x6a\x00\xa4\xfb\xb6\xb0\x41\x13\xca\x67\x74\x7a\x61\xab\xe8\xb6\x46\xd1\x8f\xca\xa8\x62\xbc\x32\x07\x7c\x7a\xf1\xe7\x7d\x2d\xee\xdc\x64\x70\x1f\x2c\x98\xfb\xe6\xc1\x4b\x3a\x7f\x54\xbc\xb4\x27\xa2\x83\x4f\x70\x5b\xf3\xb2\xb6\xa2\x1a\xc5\x9c\x09\xfe\xd0\xcd\x4b\x87\x23\x47\xa9\x3c\xb1\x4e\xbd\xa2\x3a\xa5\x86\x90\x8b\x4d\x3e\x6d\x8d\x66\xba\xa5\xf4\x98\x5f\xdf\x4c\x8f\x13\xa3\x72\xef\xa7\x8d\xb8\x3f\x19\xb1\x6e\xd9\x28\x1f\x9c\x79\x17\xf0\x1e\x71\x56\x8d\x1d\x09\xc7\x40\x63\x26\x27\x24\x57\x68\x18\x6f\x34\x1d\x6a\x87\x32\x24\x35\xe1\x64\x9a\x35\xdc\xdc\x17\xa7\x78\xcc\x2f\x38\xa5\xb3\x07\xc7\xf4\x3b\xd9\x7d\x27\xbb\xc7\x91\xdd\x48\x2c\x87\xc7\x71\x9f\xc7\xb7\x63\x63\x3d\xad\xee\x83\x16\xff\x68\x8d\x93\x2e\xd7\x05\x79\x91\xbf\xb8\x36\x8f\x98\x1a\x95\x62\x0e\x8b\x8f\xcc\x94\xf5\xe9\x86\xe4\x98\xf4\xc4\xea\xa3\x75\x8a\xc5\x58\xd5\x98\x1a\xc8\xec\xe5\x17\x08\x8c\x20\xa8\x5b\x3e\x7d\x90\x69\x41\xe5\xfb\xd1\xc1\x9e\x25\x49\x66\x69\xd2\x0d\x76\x14\x45\x91\x8d\x77\xac\x43\x9b\x1f\xb3\x6f\xcd\x79\x93\xbb\xc3\x5c\xff\xec\xac\xa3\xdc\x63\x96\x8d\x78\xf2\x74\x40\x66\x70\xe9\xb5\x3e\x14\x9e\xe3\x4f\x79\x1e\x9e\x47\x2c\x3c\x67\x23\x22\x7d\xc1\xe1\x87\xa3\xfa\x01\xdb\xcd\x9c\xdb\xcb\xed\x37\x45\xf3\x28\x8e\xff\xe2\xfd\x66\x8e\xe5\xbe\xef\x24\x7f\xdd\x4e\xf2\x77\xd8\x45\x3e\x72\x53\xbf\x36\xa6\x75\x6f\xa4\xd3\x6a\xed\x2d\x41\x61\xd4\x3d\xd5\x29\xdd\xa6\xa9\xe0\xf5\xe4\xcb\xee\xcc\x0e\xe3\xeb\x97\x6a\x70\xfe\xeb\xcc\x39\x2f\xd7\xee\x13\x3e\x48\xbf\xcc\x7f\xfc\xfd\x7a\x2e\x62\x4b\x1d\x39\xf5\xc0\xf7\xed\xaf\xea\x3b\x12\xbb\xef\x5d\xe9\xdf\xad\x2b\xbd\x61\x03\x8c\x8f\xdf\x7c\x20\x2f\x65\xf0\x12\x8b\xc9\xd7\xcf\x4f\x5c\x94\x9f\x7f\x86\xe0\x70\x50\x78\x45\xe7\x52\x28\xaa\xa5\xcc\x41\xc7\x1f\x40\xa9\x79\x74\x9f\xad\x0e\xe6\xdb\x8f\x8e\xc7\xec\xc8\xe1\x7f\xb2\x68\xfa\xa7\x9f\x3e\xc3\xd5\x48\xaf\x8f\xc5\x31\x03\xe1\x2a\xb8\x3a\xa6\x9c\x31\xda\x3d\xd3\xd0\x19\x64\x28\x8c\x7f\x23\xd1\x4c\xd7\x3f\x52\xb7\xc7\xeb\xf5\x40\xbc\x47\x54\xd8\xd8\xcf\xa8\x5b\x5b\xa3\xe7\x41\xe1\x6b\x48\x08\xcb\x0f\x70\x38\x03\x0d\x11\x18\x86\xd9\xc7\x6c\x20\x9d\x49\xac\x20\x0f\xbb\x48\x72\xd2\xa0\x28\xfd\x2e\xf7\xaf\xd0\x4c\x33\xe9\x5a\x68\x6d\xef\xca\xcd\xaf\x0f\x4c\x6b\x59\x72\x7b\xcb\xd4\x6e\x26\x74\xce\xb0\xe1\x37\x28\xfa\x6a\x1e\xba\xb2\x28\x57\x73\xcb\xf5\x17\xd3\x7c\xab\x9f\x1d\xf5\x9a\x82\x43\xb1\xb7\x3f\xf4\xc3\x32\xe0\x5f\x0b\x82\xf7\x14\xa9\xf0\xa6\x70\xba\xde\x7e\x0a\x6f\x0a\xef\x9d\xed\xe3\x0b\x0b\x14\x08\xbe\x11\x74\xc5\xe3\xc4\x3d\x05\x3a\x7d\x66\x5f\x09\xcd\xac\xfa\xc3\xd8\xe4\xe1\x7e\xea\xa9\xd5\xfc\xbb\xd5\xcc\xed\x8a\x11\x10\xbc\xa6\x31\x10\xc6\x02\x3d\x12\x28\xbd\xa7\xfc\x7b\x1c\x1c\xbe\xe6\xf3\x9a\x35\xcd\x8a\x95\xd7\xa7\x9d\x3e\x65\x9f\x03\x8e\xf7\x78\x0c\x9c\xf1\xe2\x27\xa0\x13\xe2\x15\x41\x27\x18\x76\x88\x90\xd1\x65\x94\x59\x88\x4c\x2f\xa1\x3c\x08\x23\xa3\x05\xa6\x01\xf3\x97\x9a\x4f\xae\x37\x42\xc9\x48\x5f\x14\x03\xb8\x0a\xba\x0e\x61\x32\x92\x38\x82\x93\x19\x27\x1f\x0b\x94\xaf\x38\x3e\x83\x94\x39\xcf\x4f\x9a\xe8\xb0\x82\x62\x16\x2b\xc7\x02\x75\x08\x16\x14\x67\x82\x25\xba\x72\x14\x01\xa5\xdc\x69\x23\xb7\x40\xf8\x84\x78\xc6\x18\x23\xc0\x85\x36\xc8\xec\x29\x92\xbf\x85\x5a\x23\x54\xb8\x66\xbb\xc6\x80\x14\x78\x0a\x44\x91\xda\x69\x1c\xc3\x05\xbb\xb3\x2f\x3c\x0d\x60\x8a\xf4\x8e\x81\xe4\x75\x8e\x81\x14\xcd\x1e\x81\x68\xe2\x39\xb5\x70\x0f\x03\xcb\x09\x07\xb3\xb3\x1d\xf3\xcc\xe1\x6d\x1f\xa3\x61\xde\xd3\x43\x24\x04\xb7\x23\x24\xd0\x4d\x02\x17\x85\xd7\x4c\x1f\x46\xa1\xac\xb1\xbc\xd6\xb6\xc3\x3f\x0a\x03\xae\xbf\xb5\x90\xa6\x0b\x4e\x63\xb3\x92\xd2\xde\x8d\xf8\x72\x8e\xbb\xbd\x53\xf2\x3a\xed\xd2\x7f\x0d\x00\x39\x9f\xda\x4a\x84\x32\x00\x00")
 
 func svcEndpointsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -264,12 +285,12 @@ func svcEndpointsGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/endpoints.go.tpl", size: 9248, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "svc/endpoints.go.tpl", size: 12932, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _svcServerRunGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4f\x6f\xdb\x3a\x12\x3f\x4b\x9f\x62\x2a\xbc\x5d\x48\x0b\x97\x7a\xe8\xe2\xed\xc1\xfb\x7c\x68\x9d\x34\x0d\xd0\xb4\x81\x9d\xd7\x1e\x17\xb4\x34\x92\x88\x48\xa4\x96\xa4\xec\x04\x82\xbf\xfb\x62\x48\xc9\x96\x93\x38\x6d\x2f\x7b\xb1\x25\xce\x70\xfe\xfe\xe6\x8f\xd2\x14\x96\x2a\x47\x28\x51\xa2\xe6\x16\x73\xd8\x3c\x42\xc5\x77\xf7\x0c\x2e\xbe\xc2\x97\xaf\x77\x70\x79\x71\x7d\xc7\xc2\x34\x85\x15\xea\x4e\x4a\x21\x4b\x47\x87\x9d\xa8\x6b\x50\x5b\xd4\x3b\x2d\x2c\x82\xad\x84\x81\x42\xd4\xe8\x78\xbf\xa1\x36\x42\xc9\x39\xf4\x3d\x1b\x9e\xf7\xfb\x09\x01\x2e\xb8\xc5\x29\x95\xde\xf7\xfb\x30\x6c\x79\x76\xcf\x4b\x04\x83\x7a\x8b\x3a\x0c\x45\xd3\x2a\x6d\x21\x0e\x83\x28\x53\xd2\xe2\x83\x8d\xc2\x20\x2a\x6a\x5e\xba\xff\xc6\xbd\x96\xc2\x56\xdd\x86\x65\xaa\x49\x8d\xd0\x5d\x6b\x50\xa6\xb5\x2a\x75\x67\x9e\x52\x55\x85\xa2\xae\x1e\xd3\xac\xe9\x1e\x1c\x4d\xa9\xb2\x46\x56\xaa\x9a\xcb\x92\x29\x5d\xa6\xa5\x6e\xb3\x54\x63\x51\x63\x66\x85\x92\xc4\x24\xd1\x0e\x7f\x69\x65\x6d\x3b\x7d\x4e\xdb\x56\xab\x82\x4e\x94\x89\xc2\x30\x48\x53\xf8\x67\x0e\xb7\x5c\xdb\xc7\xb3\xd2\x07\xbe\x3b\x0a\xd8\x1a\xf5\x56\x64\x18\x06\xed\x06\xa2\xbe\x67\xb7\x1f\xae\x9d\xc3\xb7\xdc\x56\xf0\x76\xbf\x27\xc9\x7d\xcf\x4e\x0f\x21\xad\xb8\xcc\x6b\xd4\xe6\x0c\xd9\x6c\xb3\x28\x4c\xc2\x70\xcb\x35\x5c\x60\xc1\xbb\xda\x2e\x95\x2c\x44\x09\x66\x9b\x31\xff\x18\x86\x45\x27\x33\x10\x52\xd8\x38\x81\x3e\x0c\x28\xa6\x6c\x6d\xb5\x90\xe5\x37\xae\xe3\xbf\x9f\x5c\x64\x17\xb8\xe9\xca\xf7\x79\xae\x67\x10\xe5\xf4\xcc\x78\x9e\xeb\x68\x06\xd1\xfc\x8f\xdf\xff\xf5\x3b\x3d\x38\x16\xe0\x32\x87\x06\xad\x16\x99\x81\x5a\x18\x8b\x12\x88\x13\x8d\x89\x92\x1f\x29\x19\xa2\x31\xa8\x31\xfe\x6d\xaa\xe8\x0f\xa7\xe8\xd3\xdd\xdd\xad\xd3\x53\xae\x6e\x97\xcf\x95\xb8\xe8\xfe\x65\x10\x50\x6e\x85\x56\xb2\x41\x69\x61\xcb\xb5\xe0\x9b\x1a\xcd\x0c\x44\x01\x06\x2d\x83\x8f\x35\x2f\x0d\x54\x7c\x8b\xd0\x6a\xa1\xb4\xb0\x8f\x0e\xcf\x70\x29\xb7\xc4\x6f\x58\x18\x88\xc2\x09\x86\xf9\x02\x94\x61\x57\x68\x51\x6e\xe3\xe8\xe2\xf2\xc3\x5f\x57\xff\x79\x7f\x71\xb1\x8a\x92\x7f\x7b\x86\x37\x0b\x88\x22\x0a\x63\x70\x26\x6e\xb0\x70\x8c\x61\xb0\x77\x52\x1d\xaa\x4f\xa5\xde\x7e\x5d\xdd\x91\x3c\x47\x3a\x27\x6f\x12\x22\x58\x40\xd1\x58\xb6\x6e\xb5\x90\xb6\x88\xa3\xf9\xdf\x4c\x34\x73\xb7\x93\x51\xcb\x0b\xb6\xaf\x2f\x57\xdf\xae\x97\x97\x3f\x67\xfd\xa9\xb6\xd1\xfe\xfd\x80\x9d\x2f\xb8\xbb\x94\x79\xab\x84\xb4\x26\x1e\xb2\x05\xed\x86\xf5\xfd\x78\x91\x7d\xe1\x0d\xee\xf7\x6b\x57\xcc\x89\x43\xdf\xe1\x06\xa9\x4b\x53\xf8\xd0\x19\x21\xd1\x18\xc8\x55\xc3\x85\x64\x3e\x7d\xdf\x35\x6f\xc7\xe2\x80\x9d\xb0\x15\x34\x22\xcf\x6b\xdc\x71\x8d\x86\xc1\x1a\x11\xc6\x1a\x48\xa7\x94\x52\x85\xc1\x68\xc9\xe2\xc0\xc2\x48\xdc\x20\x6d\x34\x74\xc0\xc9\x68\xce\x41\x7d\x40\x45\x13\x87\x41\xdf\x6b\x2e\x4b\x84\xdf\x04\x05\xf0\xe0\xd0\x0d\xda\x4a\xe5\x86\xea\x2c\x0c\x82\xbe\x27\x34\x11\x14\x63\xa9\x2c\xfc\x26\xd8\x0a\xff\xdb\xa1\xb1\x6b\xab\x91\x37\xc9\xe4\xd8\xb4\x4a\x1a\x1c\xcf\xdd\xed\xa0\xef\xef\xd4\x67\xb5\x43\x4d\x2c\x3e\x54\x07\x7b\x16\x2e\x5a\x37\xfc\x1e\xfb\xfe\x19\xf5\xe8\x84\xb3\x01\x65\xee\x24\xf6\x3d\xca\x9c\x1e\xc8\x39\x3c\x04\x7a\xee\x65\x9d\xa4\x2b\xf9\xff\x78\x78\x30\x82\xbd\xe0\x05\x2c\xe0\x95\x08\xbc\xec\xda\x11\x1d\x06\xa9\x45\x63\x0e\x47\x44\xfd\x22\x50\x8e\x11\x7a\x02\x95\x67\xa8\x9e\xc1\x81\x97\x42\xab\xd1\x76\x5a\x1e\xcf\xa8\x22\x68\x40\x76\x12\x8c\xe5\xda\x1a\xe0\x20\x71\x07\x34\x21\x86\x49\x36\xf3\xcd\x6a\x7c\xa1\x80\x72\x70\x8d\x74\x38\xf3\xc6\xdb\x0a\x49\x52\xcb\x8d\xc1\x1c\x32\xdf\xb4\x89\xb9\x56\x65\x89\xda\xd7\xdd\xaa\x93\x71\x56\x4c\x9b\xb9\x6b\xe0\x23\xee\xe7\x13\x6f\xbe\xe0\x6e\xc4\x7d\xf2\x04\x12\x2f\x55\x2f\x39\x27\x0a\xc8\x8a\x92\x5d\xd1\x42\x20\x32\xea\xb5\x63\x6a\x2f\x65\xa6\x72\xd4\xb0\x58\x80\x14\xb5\x6b\x17\x3f\xe2\x1c\x4a\x9e\xde\x88\x3e\xb0\x8e\x6c\xd4\x4c\x7c\x42\x71\xb3\x56\xd9\x3d\xda\xc1\xe5\x30\xd8\x99\x65\x51\x8e\xc8\x3d\x90\xbd\xb7\xa4\xf8\xaa\xe3\x3a\x9f\x03\xc5\x23\xce\xec\x03\x0c\xcb\x01\xc5\x83\xfe\x67\xa0\xe1\x1f\x14\xfe\x11\xae\x09\xc4\xcf\x58\x50\x6b\xa5\x5d\xe8\x82\x31\xa5\x47\x18\x8c\x2a\x9d\x22\x52\x31\x83\x03\x18\x34\xd5\xdd\x7e\x16\x06\xc1\x57\x2d\x4a\x21\x97\x15\x66\xf7\xa8\x07\x73\x9e\x69\xde\x28\x55\xff\x40\xcb\x89\x9c\xf8\xb9\xa2\x21\x4e\x37\x98\x55\x5c\x8a\x8c\xd7\xc7\x7e\x85\x5a\x67\x14\xa8\x86\xdf\x63\x4c\xe4\xc1\x2f\x7f\xe3\x5a\x5a\xd4\xba\x6b\xed\xa8\x94\x85\x41\xa9\x8e\x16\x1c\xe8\x9f\xfc\x49\x4c\xe2\x86\xbb\x7e\xa0\xfb\xf9\x3a\x5e\x74\x1e\xfa\x98\x1d\x64\x7c\x76\xd8\x64\xdf\x85\xad\x3e\x0a\xac\x73\x13\xfb\xe5\x8b\xf9\x37\xe7\x79\x54\xf3\x47\xd4\xd1\x7c\xd8\x1e\xa2\x99\x3b\x74\xa3\x7d\x1e\x38\xc0\x1d\x37\x0c\xf2\x39\x61\xd7\xb2\x50\x71\xe4\xb5\x0b\x59\xba\xc1\x1e\x34\x0e\xdd\x14\xdd\x01\xd9\x78\xd3\x3d\x10\xb4\x83\x86\x79\x0f\xe2\x28\x75\x1a\xfc\x66\x96\x46\x33\xcf\x3e\xb8\xf7\x91\xcc\x77\x14\x76\x2d\x73\x7c\x48\x5e\xb9\x9a\x35\x79\x2d\x24\x9e\x97\xb0\xf4\x0c\xaf\xc9\xa0\x1f\x51\xbf\x22\xe3\xd6\x33\xbc\x26\xc3\x3c\x36\x1b\x55\x9f\x17\xb1\x76\xf4\xd7\x24\x58\xcd\xb3\x57\x6c\xb8\x23\x72\xe2\xe2\xeb\xc0\xf4\xe7\x5b\xcf\xf9\xd9\xc5\xfe\xbd\xcc\x5d\xa0\xe3\xd3\x24\x41\x43\x5b\x46\x3c\x40\x85\x8a\x7b\x68\x71\xd4\xad\x8e\xf5\x3c\x41\x4f\xed\x2a\x8e\x12\x28\xd1\x0e\xc2\xe3\xc8\x66\x6d\x34\x73\x00\x98\x2c\x1b\x89\x6b\x43\xc4\xfd\xe6\xd8\x6b\x5e\xc2\xdb\x25\x41\x9d\x30\x9b\x30\xff\x38\x6e\x8d\x07\xc5\xbe\x1a\x68\xf7\x1c\xaa\xcf\xed\x46\x39\x16\xa8\xa1\x66\xcb\x5a\x19\x74\x4e\xd8\xac\xbd\xe9\x1e\xc8\x3a\xfa\x36\x20\x78\xc5\x75\x12\x06\xb4\xb0\x7f\x1e\x45\xcd\x17\xe0\xd9\xd8\x0d\xb7\x59\x45\x06\x7c\xa7\x8f\x1f\x6d\x62\x77\x89\xa2\xf0\xce\x91\x3e\x21\xcf\x51\x3b\xfc\xaf\x91\x02\x68\xad\x90\xa5\x89\xfd\x47\x8c\xb4\x6f\xed\x63\x4b\x19\x89\x78\xdb\xd6\x22\xe3\xf4\xa9\xe1\x3f\x0e\x92\x27\x4a\xdf\x3d\xd5\x3a\x51\x35\xd1\xf2\x93\x92\x29\xaf\xe7\xdc\xf1\x82\xdf\xcb\xc7\x38\x19\xb2\xea\xf2\x69\x35\x97\x86\xb6\x4a\x16\x06\x6e\x70\x90\xb0\x43\xf9\x69\x2a\x3e\xa3\xb7\x63\xab\xa6\x85\xe5\x6a\x75\xbb\x1c\x88\x93\xb1\x19\xb4\x1b\xb6\xc2\x92\x94\xeb\x33\x1b\x62\x6c\x66\x60\xf4\x36\xa1\x09\x3b\x7e\x80\x1d\xee\xc4\x6e\xf4\x9e\xb6\xa0\x11\xae\x86\x79\x88\x4e\x03\x37\xc0\xf3\x17\x6e\xbc\x7b\x8a\xe8\x13\xdf\xab\xa9\x8b\x44\x1d\x3b\xe6\x13\x5c\x4e\x76\x85\xd9\x0f\x26\xe9\x0c\xdc\x98\x3b\x6b\xa4\xab\x42\x6f\xe7\x34\x71\x33\xa8\xa6\x96\xae\x69\xe5\xa0\xcd\x01\x28\x7f\xe7\x64\x0d\x99\xf6\xd2\x0e\xd7\x7f\xa5\x87\x1f\x5b\xf8\x50\x63\xae\x89\x0f\x3d\x1c\x9e\x96\x30\x0d\xad\x97\x9b\xb8\xdf\x94\xde\xbc\xac\xdc\x57\xf1\x9f\x6f\xdd\x18\x1a\xae\xe3\x83\xb0\x51\x12\xee\xc3\xff\x05\x00\x00\xff\xff\xf9\x39\x35\x0e\xad\x10\x00\x00")
+var _svcServerRunGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x18\x4d\x6f\xdb\x38\xf6\x2c\xfd\x8a\x37\x42\x77\x21\x2d\x1c\x6a\xb6\x8b\xd9\x83\x77\x72\x68\x93\x34\x0d\xd0\xb4\x81\x93\x69\x8f\x03\x5a\x7a\x96\xb9\x91\x49\x0d\x49\xdb\x09\x04\xfd\xf7\xc5\x23\x29\x59\x76\xec\x4c\x06\xd8\x93\x29\xbe\xef\xef\x47\xe7\x39\x5c\xa8\x12\xa1\x42\x89\x9a\x5b\x2c\x61\xfe\x0c\x4b\xbe\x7d\x64\x70\xf9\x0d\xbe\x7e\x7b\x80\xab\xcb\x9b\x07\x16\xe7\x39\xcc\x50\xaf\xa5\x14\xb2\x72\x70\xd8\x8a\xba\x06\xb5\x41\xbd\xd5\xc2\x22\xd8\xa5\x30\xb0\x10\x35\x3a\xdc\xef\xa8\x8d\x50\x72\x0a\x6d\xcb\xc2\xb9\xeb\x46\x00\xb8\xe4\x16\xc7\x50\xfa\xee\xba\x38\x6e\x78\xf1\xc8\x2b\x04\x83\x7a\x83\x3a\x8e\xc5\xaa\x51\xda\x42\x1a\x47\x49\xa1\xa4\xc5\x27\x9b\xc4\x51\x82\xb2\x50\xa5\x90\x55\xfe\x5f\xa3\x24\x5d\x2c\x6a\x5e\xb9\xdf\x95\x83\x57\xc2\x2e\xd7\x73\x56\xa8\x55\x6e\x84\x5e\x37\x06\x65\x5e\xab\x4a\xaf\xcd\x21\x54\x2d\x51\xd4\xcb\xe7\xbc\x58\xad\x9f\x1c\x4c\xa9\xaa\x46\x56\xa9\x9a\xcb\x8a\x29\x5d\xe5\x95\x6e\x8a\x5c\xe3\xa2\xc6\xc2\x0a\x2f\x4d\xa2\x0d\x3f\xf9\xd2\xda\x66\x7c\xce\x9b\x46\xab\x05\xdd\x28\x93\xc4\x71\x94\xe7\xf0\xaf\x12\xee\xb8\xb6\xcf\x27\xb9\x07\xbc\x07\xf2\xe0\x3d\xea\x8d\x28\x30\x8e\x9a\x39\x24\x6d\xcb\xee\x3e\xde\x38\x0f\xdc\x71\xbb\x84\xb3\xae\x23\xce\x6d\xcb\xf6\x2f\x21\x5f\x72\x59\xd6\xa8\xcd\x09\xb0\xd9\x14\x49\x1c\xb5\xed\x19\x68\x2e\x2b\x04\x16\xc4\x98\xae\x8b\x23\x77\x2f\x16\xc0\x3e\xd4\x82\xfb\x9b\xa8\x6d\xfb\xaf\x9e\xb3\xd3\xe6\x73\xf8\xd8\xf1\x77\x1a\x8d\xf1\xcd\xa6\x70\xa8\xf7\x9b\xe2\x10\x8b\xe4\xa0\x2c\x49\xc2\xc1\x51\x2c\xa0\xb2\x90\xd6\x28\x77\xaa\x65\xf0\x4f\xc2\xdc\x0b\x57\xa5\xb4\xa8\x6b\x9e\xfb\x60\xed\x98\x64\x71\xbc\xe1\x1a\x2e\x71\xc1\xd7\xb5\xbd\x50\x72\x21\x2a\x30\x9b\x82\xf9\x63\x1c\x2f\xd6\xb2\x00\x21\x85\x4d\x33\x68\xe3\x88\xd2\x85\xdd\x5b\x2d\x64\xf5\x9d\xeb\xf4\xef\x7b\x84\xec\x12\xe7\xeb\xea\x43\x59\xea\x09\x24\x25\x9d\x19\x2f\x4b\x9d\x4c\x20\x99\xfe\xf2\xf3\xbf\x7f\xa6\x83\x43\x01\x2e\x4b\x58\xa1\xd5\xa2\x30\x50\x0b\x63\x51\x02\x61\xa2\x31\x49\xf6\x67\x42\x82\x99\x41\x0c\xa5\xbb\x28\x70\x2c\xe8\x17\x27\xe8\xf3\xc3\xc3\x9d\x93\x53\xcd\xee\x2e\x5e\x0a\x71\x89\xf3\x9b\x41\x40\xb9\x11\x5a\xc9\x15\x4a\x0b\x1b\xae\x05\x9f\xd7\x68\x26\x20\x16\x60\xd0\x32\xf8\x54\xf3\xca\xc0\x92\x6f\x10\x1a\x2d\x94\x16\xf6\xd9\xd5\x2e\x5c\xc9\x0d\xe1\x1b\x16\x47\x62\xe1\xb4\x87\xe9\x39\x28\xc3\xae\xd1\xa2\xdc\xa4\xc9\xe5\xd5\xc7\xdf\xae\x7f\xff\x70\x79\x39\x4b\xb2\xff\x78\x84\x9f\xce\x21\x49\xc8\x8d\xd1\x09\xbf\xc1\xb9\x43\x8c\xa3\xce\x71\xa5\x2c\x38\xe0\x7a\xf7\x6d\xf6\x40\xfc\x1c\xe8\x14\xbf\x91\x8b\xe0\x1c\x16\x2b\xcb\xee\x1b\x2d\xa4\x5d\xa4\xc9\xf4\x6f\x26\x99\x38\xea\xac\x97\x72\x44\xf7\xfb\xab\xd9\xf7\x9b\x8b\xab\xb7\x69\xbf\x2f\xad\xd7\xbf\x8b\xe3\xb6\xf5\x45\xf3\xce\x10\xfb\x1e\x8f\x0a\x25\xcf\xe1\x2b\x6e\xdb\xf6\x5a\x7d\xe5\x2b\x1c\x0a\xe8\x4a\x96\x8d\x12\xd2\x1a\x28\x34\x72\x8b\x06\xec\x92\x22\xd4\xdf\xaa\x85\xbb\xa0\x22\x09\x61\x27\xf2\xae\x83\x3e\x0b\x7c\xba\xbe\xca\x3a\x0d\xb8\xd0\xcc\xd9\x0b\x46\xa4\x22\xea\x0c\xf6\xaa\x92\xed\xd4\x6a\x5d\xda\x7c\x5c\x1b\x21\xd1\x18\x28\xd5\x8a\x0b\xc9\x7c\x17\xfa\xa1\x79\xd3\x77\x21\xd8\x0a\xbb\x84\x95\x28\xcb\x1a\xb7\x5c\xa3\x61\x70\x8f\x08\x7d\x4b\xc8\xdb\xd6\x21\xf4\x42\xda\x96\x75\x5d\xde\xb6\xae\x26\xc7\x54\x95\x8a\xa3\x5e\xdf\x73\x78\xd9\x5b\x18\x09\x0d\x32\x7b\xc3\x42\x6e\xf7\x4a\x0f\x4a\x46\x1b\xae\x69\x1c\x0c\x51\x11\xe3\xa8\xb0\x5b\xb4\x4b\x55\x1a\x6a\x7b\xae\xb3\x3d\xa8\x2f\x6a\x8b\x1a\xde\x89\xe0\x9b\x81\x21\x29\xf2\xce\xf4\xaa\x50\xb3\xb8\xe5\x8f\xd8\xb6\x2f\x30\x77\x1a\x45\xc1\xb6\x38\x22\xed\x76\x01\x9d\x8e\x8d\x22\x4e\x5f\x71\xdb\x53\x9b\x34\x7b\xa3\xb2\x03\x3f\x76\x44\x09\x38\x87\x57\x8c\xd9\x69\xb6\x8b\xa1\x41\x9a\x58\x58\x42\x8f\x64\xfe\x9f\xe1\xdc\x19\x7f\x32\xa0\x83\xdc\xde\x81\x93\x5d\x0d\x90\xff\x34\xda\xb5\x96\xbb\xbb\xb8\x8b\x07\x2b\x68\xdd\x58\x4b\x30\x96\x6b\x6b\x80\x83\xc4\x2d\xd0\x78\x0d\x7b\xc1\xc4\xb7\xc3\xfe\x83\xfa\x23\x07\xd7\xaa\x03\x82\x37\xd5\x2e\x91\x76\x8e\x86\x1b\x83\x25\x14\xae\xce\x5d\x33\xad\x55\x55\xa1\xf6\x65\x36\x5b\xcb\xb4\x58\x8c\xc7\x85\x1b\x11\xa7\x86\x65\xb0\xe5\x45\xd1\xc3\xf4\xa8\x23\xbe\xe2\x36\x90\xa7\xd9\x38\xc6\x47\xe9\xdf\x54\xf1\x2f\x30\xb2\xbd\x71\xea\x4d\xf3\xda\xa4\x42\x96\xf8\xb4\x33\x00\x7e\xce\x5e\x28\xf8\xc5\xe1\xc7\x71\x34\x5f\x8b\xba\xbc\x91\x0b\x45\xb4\xe4\x8c\x19\xf2\xf2\x63\x7f\x49\xda\x7b\xd6\xec\x87\xb0\xcb\x4f\x02\xeb\xd2\xa4\x7e\xa7\x62\xfe\x8b\x5a\x6a\xb2\xf1\xfb\x5d\x32\x05\x18\x18\xf6\x3b\xde\x84\x10\x68\x77\x24\x28\x8c\x11\x3e\xf3\xed\xe3\x18\xa9\xd1\xca\xaa\x64\xba\x8f\x74\x47\x97\x33\xdc\x88\x01\x4d\x87\x8f\x64\x3a\x42\x1b\x61\x74\x19\xa3\xab\x34\x71\x79\x24\x64\xd5\x4f\xcc\x5b\x2c\x96\x5c\x8a\x82\xd7\xbb\xbe\x82\x5a\x17\x64\xfa\x8a\x3f\x62\x4a\x60\x40\xad\x95\x0e\x14\x37\xd2\xa2\xd6\xeb\xc6\xf6\xe5\xc2\xe2\xa8\x52\x43\xed\xb0\x01\x1e\x56\xa4\x94\xd8\x05\x5a\x37\x17\xc3\xec\xee\x09\x29\xf5\xfc\x2e\xf2\x16\xb7\x46\x49\xcd\x9f\x51\x27\xd3\xb0\x90\x24\x64\x7e\x94\xd0\x90\x4a\xa6\x11\x14\x8b\xd1\xf0\x25\xd0\x60\xb8\x17\xda\x5b\x1e\xad\xc8\x40\xaa\xa3\x3e\x2f\xf1\x76\xfd\x44\xa1\x8d\x56\xcc\x2b\x9e\x26\xb9\x93\xe0\xf7\xd8\x3c\x99\x78\xf4\x60\xd5\x27\xd2\xda\x41\xd8\x0d\xa5\x56\xf6\x0a\x69\xb1\x2a\x6b\x21\xf1\x34\x87\x0b\x8f\xf0\x1a\x0f\x62\x24\xea\x57\x78\xdc\x79\x84\xd7\x78\x98\xe7\xd5\x5c\xd5\xa7\x59\xdc\x3b\xf8\x6b\x1c\xac\xe6\xc5\x2b\x3a\x3c\x10\xf8\x38\xbd\xcb\x4a\x21\x17\xea\x18\xb5\x4b\x01\xdf\xd6\xd8\x0c\x4d\xa3\xa4\xc1\x1f\xf4\x96\xd2\x13\xd0\xf0\x8f\x70\xff\xc7\x1a\x8d\xf5\x89\x12\x6d\xd9\x67\xe4\x25\xea\x34\x63\xf7\x68\xd3\xe4\x42\x49\x8b\xd2\x9e\x3d\x3c\x37\xa4\x5f\xc2\x9b\xa6\x16\x05\xa7\x67\x8a\x7f\x19\x91\x52\xd1\xef\x70\x0e\xf4\x45\x31\xbf\xa2\x87\x13\xea\x74\x9b\x31\x7f\x4c\x87\xc2\x21\xdc\x2e\x73\x69\x42\xb9\x0b\xbf\x9e\x79\xd5\xbe\xb8\x14\xfa\x20\x4b\xea\x22\x98\xee\xe7\x1a\xac\x68\xff\x4a\x43\xa2\xd3\xb2\x1a\x5a\x33\x75\xd9\x1f\x38\xbf\x57\xc5\x23\xda\x71\xee\xd7\x13\xaa\x2b\xca\x43\x89\x36\x30\x4f\x13\x5b\x34\xc9\xc4\xe5\x71\xe8\x55\xc4\x3d\x73\xdb\x23\x61\xff\x74\x0e\x52\xd4\x87\xd5\x72\x45\xf5\x49\x85\x96\x31\x7f\x4c\x42\x8b\x1c\xe4\x91\x28\xa5\x69\x19\x0f\xd3\x86\x56\xba\xa8\xc4\x05\x6a\xa8\xd9\x45\xad\x0c\x3a\xdd\x6d\xd1\xdc\xae\x9f\x48\x29\x7a\x07\x92\xa3\xd2\x3a\x8b\x23\x7a\xfa\x7d\xe9\x59\x4d\xcf\xc1\xa3\xb1\x5b\x6e\x8b\x25\x29\xe0\xa3\x65\x52\x47\x44\xc6\xbf\x77\x20\x1f\x24\x57\xbd\xf7\x48\x7e\xb3\x56\xc8\xca\xa4\xfe\x05\x2b\xed\x99\x3d\x12\x2f\x92\x95\x64\x07\x42\xdf\x1f\x4a\x1d\x89\x1a\x49\x79\x23\x67\x0a\xe7\x29\x73\x3c\xe3\x0f\xf2\x39\xcd\x42\x30\x5d\x18\xad\xe6\xd2\xd0\x9a\xcd\xe2\xc8\xed\x39\xc4\x6c\x68\x1e\x3a\xcd\x4e\x0f\xca\x66\xce\x66\x58\x91\x38\x7d\x62\x41\x4d\xcd\x64\x34\x32\xfb\x0d\xec\x7a\x76\x77\x11\xe0\xa7\xa7\x65\xb6\x3f\xf4\x76\xcf\xf3\x41\x66\x6a\xb2\xf8\xb0\xd3\xf6\x79\x6d\x9c\x3a\x98\x8e\x5d\x1d\xf2\xf8\x2f\x50\xbc\x3f\x4c\xfd\x3d\x6f\xfd\xd9\xb3\x76\x49\xde\x0c\xc9\x36\x53\x6b\xbb\x73\x66\xb0\xe9\x94\x5f\x03\x63\xa5\x21\xc5\x3f\xfc\x9b\xf9\xdd\x98\x7b\x36\xe0\xb3\xcf\xd6\x36\x57\x4f\x8d\x32\x58\xf6\xcf\xfa\xb1\x4e\x7b\x64\xd0\x75\x4b\x46\x6f\xf5\x3b\x8d\x0b\xf1\x94\x26\xa3\xa0\x11\x9f\x1d\xa8\xeb\x92\xac\x6f\x64\x69\xdb\x62\x6d\xb0\xeb\x9c\x39\x61\x7d\x7b\x19\x53\xf2\xce\x8e\xa2\x87\x0e\xc3\xd3\xef\x20\x13\x38\x1d\x6f\xdf\x1b\xae\x29\x50\xa2\x20\x6e\x7d\xc3\x0c\x0d\xed\x30\x91\x86\xd6\x73\xe1\x96\x3e\x0a\x7e\x14\x5d\xaf\xb9\x2e\xa7\x3e\x21\x0a\xfb\x04\xe1\x0f\x25\xfa\x93\x80\x7e\x8f\x74\xdd\xf4\x05\x8a\x6b\x28\xa1\x1d\xf7\x4d\x05\x8e\x98\x34\x28\xe0\x84\xa6\x85\x7d\x9a\xf4\xcf\xbb\x23\xc6\x69\xea\x50\x51\xd4\xd1\xec\x8e\xa2\x6f\x5a\x54\x42\x5e\x2c\xb1\x78\x44\x1d\xf4\x7d\xa1\xda\x5c\xa9\xfa\x2f\xa8\xb1\xc7\x33\x7d\xa3\x26\x5d\xd6\xb6\xa7\xf2\xa5\x23\x60\x48\xd5\xc3\xb4\x0d\xc7\xe3\xa5\x44\x5d\x28\x54\xd3\xb8\x21\x4d\x60\x39\xae\xa7\x7b\xda\xd8\xdc\x33\x99\x6a\xe4\x14\xaf\xd0\xc1\x3c\xb7\x81\xfc\x0d\x0b\xd5\x6e\x9f\x0a\xae\x48\x26\xf1\xb0\x50\xc1\xe1\x20\x9a\xc4\xa7\x36\x2a\xff\x4e\xf9\x69\x4f\xa6\x9f\x45\xbf\x9e\x91\x92\x3d\x15\x3e\x09\x9b\x64\x71\x17\xff\x6f\x00\x34\xc4\x83\x15\x70\x15\x00\x00")
 
 func svcServerRunGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -284,12 +305,12 @@ func svcServerRunGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/server/run.go.tpl", size: 4269, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "svc/server/run.go.tpl", size: 5488, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _svcTransport_grpcGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x6f\xe3\x38\x0c\x3d\x5b\xbf\x82\x6b\x0c\x16\xf1\x20\x55\xf6\x5c\xa0\x97\x69\xbb\xd3\x62\xb7\x1f\xe8\x76\x67\x0f\x83\xc1\x40\xb1\x19\x5b\x88\x2d\xb9\x92\x92\x34\x2b\xf8\xbf\x0f\x28\x7f\xc4\x69\xd3\xb4\x87\x00\xb1\x44\x3e\x92\xef\x91\xb4\x67\x33\x38\xd7\x19\x42\x8e\x0a\x8d\x70\x98\xc1\x7c\x0b\x85\xd8\x2c\x39\x5c\xdc\xc1\xed\xdd\x23\x5c\x5e\x5c\x3f\x72\x36\x9b\xc1\x03\x9a\x95\x52\x52\xe5\xe1\x1e\x36\xb2\x2c\x41\xaf\xd1\x6c\x8c\x74\x08\xae\x90\x16\x16\xb2\xc4\x60\xfb\x0d\x8d\x95\x5a\x9d\x82\xf7\xbc\xfb\xdf\x34\xa3\x0b\xb8\x10\x0e\xc7\xb7\xf4\xdc\x34\x8c\xd5\x22\x5d\x8a\x1c\xc1\xae\x53\x46\xf6\x8f\x3d\x2c\xd4\x46\xaf\x65\x86\x16\x2c\x9a\x35\x9a\x13\x2b\x33\x84\xb9\x54\x99\x54\xb9\x85\x85\x36\xe0\x0a\x84\xfc\xe1\xfe\x1c\x9c\x11\xca\xd6\xda\xb8\x90\xcb\xb5\x83\x95\x93\xa5\xfc\x1f\x6d\x30\x19\x6e\x67\xb9\xa9\x53\xfe\x4f\x80\xe3\x8c\xc9\x8a\x0e\x61\xc2\xa2\x58\xa1\x9b\x15\xce\xd5\x31\x8b\xe2\x54\x2b\x87\xcf\x2e\x66\x2c\x8a\x73\xad\xf3\x12\x79\xae\x4b\xa1\x72\xae\x4d\x1e\x20\x66\x15\x3a\x91\x09\x27\xc8\x86\x0e\x86\x08\x10\xe7\xd2\x15\xab\x39\x4f\x75\x35\xcb\xf5\xc9\x52\xba\x19\xfd\xf6\x53\x20\xb7\xbe\x54\xca\x46\xa6\xc8\xa2\x7a\x0e\xb1\xf7\xfc\xfe\xcb\x75\x48\xeb\x5e\xb8\x02\x4e\x9a\x26\x66\x49\xe0\xe5\x46\x2c\xf1\xeb\xc3\xfd\x79\x9b\x3d\x54\x62\x89\x16\x04\x58\x74\xa0\x17\x80\x2a\xab\xb5\x54\xce\x82\x58\x0b\x59\x8a\x79\x89\x20\xe8\x3e\xd0\xe3\x3d\xef\xc2\xf0\x5b\x51\x61\xd3\xf4\x14\x2c\x56\x2a\x7d\x81\x3c\xd9\x41\x5d\xf6\xff\xa6\xa0\x6b\x27\xb5\xb2\xc0\x39\xdf\xab\xb7\x23\xf3\x2e\x5c\x27\x50\xcf\xf9\x1b\xb1\xc0\xb3\xc8\x8e\x6c\x2d\x9c\x9e\xc1\xf7\x1f\x6f\x83\x79\x16\x45\x87\x6e\xbf\xe0\x42\x1b\x9c\xf4\x0a\x3c\xea\xf3\x56\xae\x64\xca\xa2\xe6\x65\x8c\x33\x10\x75\x8d\x2a\x9b\xec\x1d\x0f\xe5\x70\xce\x13\x16\x19\x74\x2b\xa3\xe0\x77\x8a\xd6\xc6\xf0\x41\x1e\xef\xe1\x51\xff\xad\x37\x68\x60\xaf\x24\x68\x1a\x16\x79\x6f\x84\xca\x11\x3e\x49\x2a\x64\xb8\xbf\x41\x57\xe8\xcc\x92\x45\xe4\x7d\xef\xfe\x49\x76\x5c\x9c\xc2\x7e\x49\xb7\xb8\xe9\x58\x67\x51\x14\x0d\xcc\x73\xef\x07\x97\x5e\x84\x29\x59\x5c\x60\xaa\xb3\x20\xd6\xc8\xe2\x01\x9f\x56\x68\x5b\x83\x4b\x75\xd0\xc0\xd6\x5a\x59\x0c\x16\x7b\x4c\x70\xce\xe9\x90\xb8\xf3\xfe\x84\xba\x88\x32\x6f\x58\x13\x5a\x6e\x47\x08\xc8\xaa\x2e\xb1\x42\xea\x0a\x9a\x28\xef\xbf\xea\x40\xc5\x61\xad\xa5\x72\x68\x16\x22\x45\xe6\xb6\x35\x8e\x71\xac\x33\xab\xd4\x81\x67\x00\x40\xdd\xf2\xaf\x1a\x90\x31\x3b\x8e\xca\xd8\xfb\x9c\x1f\xa0\x1c\xe0\x05\xe7\x57\x42\x65\x25\x1a\xb6\x2b\xb8\xad\xb6\x83\x09\x8b\x65\x94\xb1\xd3\xbb\xe2\x3f\x5e\xf7\xbb\xa9\x86\xc9\x9b\x58\xf8\xbc\x0b\x95\xec\xe0\x87\xec\x27\xa9\x7b\x86\x6e\x21\xf1\xae\xd3\xa7\x60\xf0\x09\x3e\x87\x59\xdb\xd9\x77\x5d\xd0\x34\x09\x4c\x5e\xdf\xb5\x0d\xd0\x34\x53\x40\x63\x34\x85\x62\xd1\x4f\x02\xaa\xc3\x09\x25\x49\x5d\xf7\x8a\xbd\x76\xe8\xa8\x9f\x28\x93\x10\x39\x61\x91\x5c\x04\xa7\xdf\xce\x40\xc9\x92\xa0\xfa\x19\x52\xb2\x0c\x78\x61\x14\xbb\x33\x83\x35\x3f\x92\x51\x32\x25\x2f\xd6\x30\xef\x5b\x35\x48\x8b\x8e\xcf\xb6\xdd\xdf\x27\x73\x36\x83\x63\x93\x01\x92\x36\xe1\x8b\xb7\x40\xeb\xd0\x59\xfc\x49\x6a\xb8\x42\x38\xe2\x7a\x8d\x86\xf6\x68\x98\x80\x76\x7b\xbe\x6e\x2a\xd3\x21\x3b\x0d\x02\x56\x16\xcd\x49\xa6\x2b\x21\xd5\x31\x63\x0e\xf7\x46\x56\xc2\xc8\x72\x4b\x2e\x8b\x55\x09\x52\x85\x15\x3e\x5a\xc6\xc7\xea\x98\xfc\x7c\xdd\x0a\x54\xcb\x03\x3e\xed\x5a\xcf\x53\x03\x8c\x9e\xc6\x8a\x53\xdf\x9c\x9e\xf5\x3e\x87\x54\xe9\x7b\x68\xa4\xde\xd3\x11\x81\xda\x75\xf3\x21\x81\x8e\x6e\xa6\x83\x0a\xb5\x1e\xbd\xc9\x5b\x12\xbd\x4f\x7e\x17\x22\x48\x75\x44\xd0\xba\xdc\x7e\x48\xa1\xa3\x85\x1c\x92\x68\xc8\xe0\x83\x1a\xd9\x9a\x58\xec\xbd\x8e\xce\xce\x48\x26\x5b\x1f\xd2\xe9\x0a\xcb\x1a\x8d\x65\x6d\xea\xaf\x5e\x9a\x87\xd7\x4b\x95\x0d\x96\xfc\xe6\x22\x79\x69\x40\x59\xd2\x92\x5c\x4e\x61\x1d\x32\x0d\xda\x57\x59\x58\x04\x72\x01\xeb\xf1\x62\x68\xbf\x73\x10\x96\xb8\x0d\x22\x67\x19\x7d\x71\x6a\x57\x10\xb3\x7d\x14\xda\xb9\x95\x70\x30\x59\x26\xb0\x29\x64\x5a\x04\xd3\xb2\x84\x92\x54\xea\x50\x84\xca\xc2\xbb\x87\x3e\xd3\xf8\xb9\x50\x5a\xc9\x54\x94\x57\x28\x32\x34\x7f\xe1\x96\xbe\x82\x5c\x17\xc8\xea\xb6\x53\xa4\x83\x54\x28\x98\x63\x0f\x91\xa6\x68\x2d\x66\x14\x1b\xa5\x2b\xd0\x74\x91\xe9\x9e\xa8\x38\x1b\x6a\xfd\x4f\xba\xe2\x9b\x28\x57\xd8\xee\x3d\xaa\xf5\xfb\x1f\x3f\x92\x77\x0d\xdf\xc8\x6e\xb2\x4c\x76\x08\xe1\x15\x7b\x1c\x26\x1e\x66\x21\x9e\x42\x4c\xcd\x16\x27\x6c\x50\x3b\x75\xcf\xac\x61\xbf\x02\x00\x00\xff\xff\x7d\xcc\xbd\x93\xca\x0b\x00\x00")
+var _svcTransport_grpcGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdb\x38\x13\x3e\x8b\xbf\x62\x5e\x23\x78\x61\x15\x0a\xbd\xe7\x00\xb9\x34\x49\xd3\x60\xb7\x6d\x90\x66\xbb\x87\xa2\x08\x18\x69\x2c\x11\x96\x48\x85\xa4\x9d\x78\x05\xfd\xf7\xc5\x50\x9f\xb6\x15\xa7\xc5\x1e\xf6\x60\xc0\xe4\x7c\x3f\xcf\x70\x48\x2d\x16\x70\xa1\x13\x84\x14\x15\x1a\xe1\x30\x81\xc7\x2d\x64\xe2\x79\xc5\xe1\xf2\x0b\x7c\xfe\x72\x0f\x57\x97\x37\xf7\x9c\x2d\x16\x70\x87\x66\xad\x94\x54\xa9\x97\xc3\xb3\xcc\x73\xd0\x1b\x34\xcf\x46\x3a\x04\x97\x49\x0b\x4b\x99\xa3\xd7\xfd\x86\xc6\x4a\xad\xce\xa0\xaa\x78\xfb\xbf\xae\x47\x02\xb8\x14\x0e\xc7\x52\x5a\xd7\x35\x63\xa5\x88\x57\x22\x45\x92\x7c\xdd\xc4\xb7\xcd\x8a\x04\x8b\x05\xdc\x77\x21\xa0\x34\x7a\x23\x13\xb4\x60\xd1\x6c\xd0\x9c\x5a\x99\x20\x3c\x4a\x95\x48\x95\x5a\x58\x6a\x03\x2e\x43\x48\xef\x6e\x2f\xc0\x19\xa1\x6c\xa9\x8d\xf3\x79\xdd\x38\x58\x3b\x99\xcb\xbf\xd1\x7a\x95\x5e\xba\x48\x4d\x19\xf3\xaf\xde\x1d\x67\x4c\x16\x64\x02\x73\x16\xcc\x14\xba\x45\xe6\x5c\x39\x63\xc1\x2c\xd6\xca\xe1\x8b\x9b\x31\x16\xcc\x52\xad\xd3\x1c\x79\xaa\x73\xa1\x52\xae\x4d\xea\x5d\xcc\x5e\x95\x2c\x0a\x74\x22\x11\x4e\x90\x35\x6d\xf4\xb1\x61\x96\x4a\x97\xad\x1f\x79\xac\x8b\x45\xaa\x4f\x57\xd2\x2d\xe8\xb7\x9b\x1c\x99\x75\x20\x50\x9e\x32\x46\x16\x94\x8f\x30\xab\x2a\x7e\xfb\xfe\xc6\x27\x7c\x2b\x5c\x06\xa7\x75\x3d\x63\x41\x55\x9d\x82\x11\x2a\x45\x38\x11\xb9\x14\x36\x82\x93\x92\xa4\x67\xe7\xc0\x5b\x73\x7e\xad\x1b\x33\x5b\xd7\x64\xd0\x28\xd6\x35\xb9\xf4\xca\xbd\x23\x54\x49\x5d\xb3\xd0\xb3\xf0\x49\xac\xf0\xfa\xee\xf6\x82\x9c\xa0\x81\x42\xac\xd0\x82\x00\x8b\x0e\xf4\x12\x50\x25\xa5\x96\xca\x59\x10\x1b\x21\x73\xf1\x98\x23\x08\x92\x7b\x32\xaa\xaa\x8f\xfd\x59\x14\x58\xd7\x1d\xe0\xcb\xb5\x8a\xf7\x3c\xcf\x07\x57\x57\xdd\xbf\x08\x74\xe9\xa4\x56\x16\x38\xe7\x3b\x18\xb6\xd4\x7d\xf1\xe2\x10\xca\x47\xfe\x4a\x2c\xa8\x58\x60\x47\xba\x16\xce\xce\xe1\xfb\x8f\xd7\x9d\x55\x2c\x08\xa6\xa4\xef\x71\xa9\x0d\xce\x3b\x56\xef\xf5\x45\xd3\x1c\x61\xc4\x82\x7a\x3f\xc6\x39\x88\xb2\x44\x95\xcc\x77\xb6\xfb\x72\x38\xe7\x21\x0b\x0c\xba\xb5\x51\xf0\x7f\x8a\xd6\x64\x50\x79\xca\xab\x0a\xee\xf5\x1f\xfa\x19\xcd\x40\x1d\xc1\x07\x0d\x6d\x2d\xcb\x72\x87\xda\x4f\xe8\x32\x9d\x78\x62\x3d\x83\x72\x09\x27\x92\x7f\x75\x06\x45\x21\x55\xea\xf7\x83\xaa\xea\xfc\x9e\xc8\x16\xa4\xb3\x81\x41\x5e\x55\xfd\x76\xc7\x40\xd4\xba\xc3\xdc\xe2\x11\x1f\xbb\x78\x7d\xc6\xe7\x96\x52\x32\x08\xde\x0c\x10\x04\x97\x18\xeb\xc4\x77\xd9\x48\xe5\x0e\x9f\xd6\x68\x5b\x8d\x2b\x35\xa9\x61\x4b\xad\x2c\x36\x2a\x3b\x50\x73\xce\xfd\x6e\xd8\x57\xe0\x5b\x7a\xfc\xb7\x66\xcd\x98\x19\xe0\x07\x59\x94\x39\x16\xa8\x5c\x33\x2d\xaa\xea\x5a\x13\x4c\x30\xdd\x59\x52\x39\x34\x4b\x11\x23\x73\xdb\x12\xc7\x7e\xac\x33\xeb\xd8\x41\xc5\x00\x80\x7a\xf3\x4f\xd5\x7b\xc6\xe4\xb8\x57\xc6\xde\x66\xf8\x35\x82\x27\xb8\x01\x80\x46\xa7\x03\x9c\xed\xf2\x39\x6d\xb2\xcb\xe7\x47\xa1\x92\x1c\xcd\x78\x34\x0c\xff\x1a\x0c\xdb\xe4\xfc\x28\x1e\xe1\xe0\xf4\x00\xe9\xcf\xa3\xf9\x26\x00\x43\xfd\x6d\x8f\x34\x25\xd6\x75\x33\x57\xe6\x16\xde\x0d\x49\x84\x43\xe0\xbe\xc4\xb9\xf5\x06\x44\xcd\x20\xdc\x4b\xeb\xe1\xd0\xac\x73\x88\xc6\x68\x03\x55\x7f\x82\x2d\x9f\x80\xb1\x8d\xd1\x4e\x89\x76\x15\x46\xa0\x64\x1e\x41\xbb\x62\x84\x24\x71\xd1\x97\xd3\x34\xf4\x2f\xd7\x63\xf0\x09\xde\xf9\x93\x71\xad\x5b\x4c\xea\x3a\x82\xff\xb0\x4c\x83\x4f\x87\x65\xfe\x42\x45\xb1\x7b\x81\xf6\xfa\xe5\xad\xf3\x08\x26\xcb\x0c\x61\x3e\xec\x35\xf8\x51\xed\x3e\xfb\x90\x58\x7a\x20\xc3\xd2\xef\x50\x43\x4d\x96\xe1\x51\xf1\x13\x68\x1e\xbb\x17\x32\x78\x0a\x59\x20\x97\xde\xe8\x7f\xe7\xc4\x1a\xb9\xea\xa0\xf0\x24\xa2\x31\x34\x45\xba\x3d\x83\x25\x9f\xc8\xa4\xa1\xbc\x61\x7a\xef\xec\xd0\xc9\x69\xbb\xbf\x99\x80\x6f\xb6\x7e\x55\xc9\x25\x28\xed\xf6\xcf\xfe\x62\x01\xc7\x66\x28\x48\xba\x92\xfb\x13\xed\x5f\x2e\xbc\x31\x68\x35\x3e\x10\x2d\x2e\x13\x8e\x40\xdf\xa0\xa1\x0b\x9d\xd2\x6b\xaf\xf1\x03\xbc\x08\x20\xef\xd9\x69\x10\xb0\xb6\x68\x4e\x13\x5d\x08\xa9\x8e\x29\x73\xb8\x35\xb2\x10\x46\xe6\x5b\x32\x59\xae\x73\x90\xca\xbf\x25\x46\xaf\x82\x63\x75\xcc\x1f\x0e\x7b\x82\x6a\xb9\xc3\xa7\x61\x7e\x54\x75\x08\xf3\xd1\x6a\xdc\x0a\xd4\x40\x67\xe7\x9d\x0d\x9f\x1f\x36\xd3\x88\xce\xa7\x3d\xe6\xaa\xea\x80\xb6\x2b\xf5\x6f\x69\x3b\x7a\xb1\x4d\xf2\xd6\x58\x74\x2a\xaf\x11\xf7\x36\x25\x6d\x08\x4f\xe0\x11\x9a\xcb\x7c\xfb\x53\xbc\x1d\x2d\x64\x8a\xb8\x3e\x83\x9f\x64\xce\x96\x74\x7a\x3b\xab\xc9\xa3\x36\x22\xcf\x96\x47\xd8\xfb\x88\x79\x89\xc6\xb2\x66\x1a\x1d\x3c\xe9\x68\x04\x1c\xe6\x5b\x24\xbd\x26\xff\x74\x19\xee\x2b\x50\x7b\xd1\xf5\xb7\x8a\x60\xe3\x13\xf5\x1d\x51\x24\xb4\x4f\x83\x64\x33\x1e\x23\xf4\xcc\xbb\xcf\x10\x56\xb8\xf5\x1c\x27\x09\x7d\x89\x69\x97\x11\xb0\x5d\x14\xba\x4d\x0b\xe1\x60\xbe\x0a\xe1\x39\x93\x71\xe6\x55\xf3\x1c\x72\x22\xa9\xf5\x22\x54\xe2\xbf\x6c\xe8\x93\x85\x5f\x08\xa5\x95\x8c\x45\xfe\x11\x45\x82\xe6\x77\xdc\xd2\x1b\xdd\xb5\x81\xac\x6e\x1a\x45\x3a\x88\x85\x82\x47\xec\x5c\xc4\x31\x5a\x8b\x09\xc5\x46\xe9\x32\x34\x6d\x64\x92\x13\x14\xe7\x7d\xad\x7f\x49\x97\x7d\x13\xf9\x1a\x09\xa2\xc8\xd7\xfa\xfd\xb7\x1f\xe1\x9b\x8a\xaf\x64\x37\x5f\x85\x83\x87\xda\x0f\xd3\xa3\x6e\x66\xfd\x51\x98\x45\x30\xa3\x5e\x9b\x85\xac\x27\x3d\x76\x2f\xed\x73\x64\xe7\x4e\x82\x46\xdc\x3c\xe9\x5a\xc7\x04\x8b\xe8\xae\xc7\x67\x49\xb8\x3b\x3b\x00\xef\xf9\x88\x20\x97\x2b\xa4\xb3\xb4\x67\xb8\x56\xc2\x6c\xbb\x99\x66\xdb\xf6\x9f\xba\x06\x61\xf4\x91\xd9\x4c\xeb\xc9\xae\xa1\x92\xe9\x5e\xf2\x1a\x9d\x60\x1e\xb2\xa0\x48\x22\x78\xa0\x5e\xea\x12\xe3\x1f\x8c\x2e\x6e\x54\xac\x69\x7e\x74\x8a\xb1\x7b\x19\xa6\xd6\x64\x2f\x47\x50\x24\x21\xab\xd9\x3f\x03\x00\xe7\x30\x1e\xfb\xfd\x0f\x00\x00")
 
 func svcTransport_grpcGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -304,12 +325,12 @@ func svcTransport_grpcGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/transport_grpc.go.tpl", size: 3018, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "svc/transport_grpc.go.tpl", size: 4093, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _svcTransport_httpGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x31\x0a\xc2\x50\x0c\x06\xe0\xbd\xa7\xc8\xdc\xe1\xe5\x1a\x1d\x85\xbe\x0b\x84\xfa\xdb\x82\xd1\x3c\xd2\xa0\x43\xc8\xdd\x05\xe9\xfe\x7d\x99\x3c\xd3\x0a\xd0\x21\xdf\x27\xef\x78\xc3\x25\xcc\xf9\x88\x18\x1c\x78\x0d\x95\xc0\xc9\x27\xfc\x03\x6f\xbb\xd1\xc3\x9c\x36\xbb\x83\x66\xae\x9a\x32\x37\x51\xa5\xb6\xf4\x7e\x5b\xa0\x03\xde\xd6\x3f\xed\x57\xa5\x56\x35\xfd\x02\x00\x00\xff\xff\xed\x08\x4c\xd3\x65\x00\x00\x00")
+var _svcTransport_httpGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x65\x00\x9a\xff\x7b\x7b\x2f\x2a\x20\x53\x65\x65\x20\x68\x61\x77\x6b\x2f\x67\x65\x6e\x65\x72\x61\x74\x6f\x72\x2f\x68\x74\x74\x70\x2f\x74\x65\x6d\x70\x6c\x61\x74\x65\x73\x2f\x73\x65\x72\x76\x65\x72\x2e\x67\x6f\x20\x66\x6f\x72\x20\x63\x6f\x64\x65\x20\x2a\x2f\x7d\x7d\x0a\x7b\x7b\x63\x61\x6c\x6c\x20\x2e\x48\x54\x54\x50\x48\x65\x6c\x70\x65\x72\x2e\x53\x65\x72\x76\x65\x72\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x2e\x7d\x7d\x0a\x03\x00\xed\x08\x4c\xd3\x65\x00\x00\x00")
 
 func svcTransport_httpGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -329,7 +350,7 @@ func svcTransport_httpGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _svcTransport_wsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5d\x73\xdb\xb8\xce\xbe\x96\x7e\x05\xd6\xd3\xe9\x48\x19\x47\xee\xee\xbc\x37\xaf\x5b\x5f\x34\x4e\x4e\x9b\xb3\x9b\x8f\x89\xd3\xcd\x45\xa7\xd3\x65\x24\x58\xe1\x89\x4c\xea\x50\x54\x9c\x1c\x8f\xfe\xfb\x19\x80\xd4\x87\x1d\xa7\xed\xee\x9e\xcc\xb4\x89\x44\x00\x04\x1e\x3c\x00\x41\x4d\x26\x30\xd7\x19\x42\x8e\x0a\x8d\xb0\x98\xc1\xed\x13\xdc\x89\xf5\x7d\x02\xc7\x17\x70\x7e\x71\x0d\x27\xc7\xa7\xd7\x49\x38\x99\xc0\x15\x9a\x5a\x29\xa9\x72\x5e\x87\xb5\x2c\x0a\xd0\x0f\x68\xd6\x46\x5a\x04\x7b\x27\x2b\x58\xca\x02\x59\xf6\x77\x34\x95\xd4\x6a\x0a\x9b\x4d\xe2\xff\x6e\x9a\xc1\x02\x1c\x0b\x8b\xc3\x55\x7a\x6e\x9a\x30\x2c\x45\x7a\x2f\x72\x84\xea\x21\x0d\x43\xb9\x2a\xb5\xb1\x10\x85\xc1\x28\xd5\xca\xe2\xa3\x1d\x85\xc1\x08\x55\xaa\x33\xa9\xf2\xc9\xbf\x2a\xad\xe8\x45\x2e\xed\x5d\x7d\x9b\xa4\x7a\x35\xc9\xf5\xe1\xbd\xb4\x13\xfa\x87\x2a\x2b\xb5\x54\xa4\x62\x8d\x50\x15\x9b\x7a\x41\xb6\x13\x98\xdc\x59\x5b\x3e\xb3\xa9\xf3\x02\x27\x75\x2d\xb3\x67\x2b\x46\x16\x85\x98\xac\xf1\xb6\xd2\xe9\x3d\xda\x9d\xf5\x4a\x9a\xba\xac\x50\x4d\x0a\x9d\x9b\xba\xa2\x55\x85\xfd\x1e\xd5\x93\x4a\xe9\xb7\x95\x2b\x1c\x85\xc1\x64\x02\xd7\x04\x62\x85\xe6\x41\xa6\x18\x06\xe5\x2d\x8c\x36\x9b\xe4\xf2\xe8\x94\x71\xb8\x14\xf6\x0e\x0e\x9b\x66\x14\xc6\x61\x98\x6a\x55\x31\x32\xa5\x56\xf9\x8d\x90\x36\x00\x80\x19\xfc\xf2\x06\x0e\x80\xec\x25\x0b\x4c\xb5\xca\xc2\xa0\x94\x2a\xbf\x44\x23\x75\x16\xc0\x0c\xa2\x56\x1c\x0e\xe0\xff\x63\x98\xc0\xcf\x6f\xc2\x60\xb3\x39\x04\xb9\x84\xdc\x42\xf2\xf1\xfa\xfa\xf2\x23\x16\x25\x9a\x64\xe1\xdc\x48\x6e\x16\x67\xe2\x71\x21\xff\x83\xf0\x06\x9a\x26\x0c\x82\x95\x78\x3c\xc3\xaa\x12\x39\xf2\xdb\x19\x65\xf1\x5b\x7a\xa4\x44\x5b\xa0\xca\xc8\x40\x1c\x86\xf6\xa9\x44\xb8\xc1\xdb\x05\x83\x36\xd7\x6a\x29\x73\xa8\xac\xa9\x53\x0b\x9b\x30\xf8\x50\x0b\x93\x41\xfb\xb3\xac\x55\x1a\xa5\xf6\x11\x3c\x05\x92\xb9\xfb\x3d\x06\x03\x07\x04\x65\x72\x85\xff\xae\xb1\xb2\x31\x44\xcf\x44\xd0\x18\x6d\xe2\x30\xb8\x30\x32\x97\x6a\x7e\x87\xe9\x3d\x1a\x67\xf2\x99\xf6\xad\xd6\x45\xd8\x78\xef\x7c\x84\x03\xaf\xce\xd0\xde\x69\x76\xab\xb2\x86\xaa\xa0\xfb\xf9\x83\x88\x38\x1d\xad\x58\x60\xf4\x47\x18\x1c\x0b\x2b\x02\x00\x7a\x9d\x5c\x89\x75\x6b\xcb\xcb\x65\xc2\x8a\xb1\x5e\x49\x8b\xab\xd2\x3e\x91\xfc\x5c\xaf\x56\x42\x65\x2f\x9b\x4e\x9d\xc0\xb6\x96\x77\xfc\xf4\xf8\x25\x2d\xe3\x04\xbe\xca\x1d\xc5\x85\x15\xb6\xae\x48\x50\x2a\x0b\xc3\x1f\xaf\x58\xb1\xc0\x96\x52\x8b\xcb\xa5\xd6\xc5\x00\x94\x42\xe7\x44\xbc\x03\x47\xf0\xe4\x44\x59\xf3\x14\x06\x75\x99\x1b\x91\x21\x00\x74\x95\x91\x7c\x72\xef\x4c\x18\xe4\x5d\x7a\xff\x27\xa9\x6d\xeb\xbc\x82\x95\x28\x3f\x3b\x28\xbe\xb4\x2f\x93\x13\xff\x47\x18\x64\x98\xea\x0c\x4d\x05\x43\x39\xf6\x60\x27\x4f\x31\x44\x52\x59\x34\x4b\x91\xe2\xa6\xe9\x37\x4a\x0b\x89\xb4\x8d\x33\x70\x30\xe7\xc7\x2f\xcc\x9a\x80\x4a\x39\xb9\xba\x39\xab\x2d\x3e\x76\x58\x39\x89\x01\x5a\xb2\x67\xb5\xdb\x9f\x01\x6c\x5f\xed\x80\x98\x6a\xa5\x30\xb5\xd4\x2c\x0f\x7a\x18\xe7\x5a\x29\x2a\x7a\x5d\xb4\x5a\x97\xec\x00\xa1\xe8\x7f\x76\x90\x0a\xc3\x40\xd7\x16\xd2\x3b\xa1\x5a\x56\x93\x87\x14\x39\x9c\xe3\x9a\xd4\x23\xf2\x62\x6b\xfb\x31\xf4\xb0\xb6\x10\x56\x63\x58\x57\xf3\x65\xbe\x5b\xb9\xb1\xf3\x81\x02\x2c\x61\x3a\x83\xd7\xf4\xb4\x09\x03\x8a\x6d\x1a\x40\xa1\xf3\x71\x18\xb4\xe8\x4d\x61\x25\xee\x31\xda\x45\x30\x26\x11\x4f\x9b\xe9\x1e\xd6\x90\xb9\x80\xcb\xd7\x55\xf2\x94\x23\x65\x77\x92\xad\xda\x26\x3b\xc1\x15\x8a\xec\xa8\x5e\x2e\xd1\x50\xff\x99\x02\xfc\xfc\xe6\x97\xff\xe3\x95\x1b\x3a\xab\x86\x4b\xed\x4a\x43\xff\x31\x33\x87\xa6\xb9\x13\xd1\x4a\x87\xc6\xc0\xff\x97\xa8\xc6\xb1\xb4\x6c\x9b\xc2\x33\x85\x1f\xe7\x1c\x59\x6a\x42\xea\x9e\x46\xa8\x1c\xe1\x95\x24\x7c\xbb\x0e\xeb\x3a\x52\xc5\x4d\x79\xb3\xa1\x16\x4e\x4d\x24\x52\xda\xc2\x2b\xd9\x96\xce\xc2\x1a\x14\xab\x78\xf0\xba\x2a\xb5\xaa\xb0\x7d\xcf\xda\x41\x99\x74\x11\x7e\x1e\x6d\x36\xaf\x64\x72\x2e\x56\xd8\x34\xa3\x2f\x30\xeb\xa9\x90\x0c\x56\xfa\xc2\x22\xed\x36\xdc\xe7\xca\xdd\xda\x60\xc1\xf9\xeb\xcf\x83\xf6\x6c\xa0\xd3\x3f\x30\x68\x6b\xa3\xa0\xec\x18\x1a\x95\x8e\x5d\x31\xbc\xcf\x32\x47\x98\xfd\x1d\xe3\xe5\x72\x89\xc1\x33\xcd\x97\xe0\x74\x06\x74\x96\x27\xe7\xb8\x5e\x70\x46\x22\x2a\x6e\x66\xae\x93\x23\xb2\xc9\x6c\x1a\x04\x20\x39\xf9\xbd\xe9\xe9\x60\x1b\x5a\xa1\x3a\x9c\x52\x03\x2c\xc7\x9e\xef\x50\x26\x85\xce\x93\x1b\x69\xef\xfe\x21\xb1\xc8\xaa\xc8\x97\x95\x7b\x62\x1e\x8f\xba\x71\x63\x34\x85\xd1\xcd\xc9\xd1\xe2\x62\xfe\xeb\xc9\xf5\x88\x19\x3a\x72\x85\x32\x9a\x06\x6e\xf3\x86\xe9\x94\xda\xc7\x69\x17\x33\x19\xff\x5d\x14\x35\x12\x12\x63\x18\x98\x1b\x0f\xcd\xb1\xa2\xae\xad\xa7\xec\xb0\x01\x38\x62\x05\x29\xfb\x7a\xaa\x96\x3a\x1a\x7d\xbe\x59\x7c\x01\xb7\x77\x1b\x24\x66\xa3\x38\x0c\xca\xc4\x97\xee\xe7\x94\xf2\x69\x4d\x8d\x7d\xa2\xd2\x3d\x89\x32\xb8\xd2\x0f\xd8\xe6\xca\x59\xf4\x19\x88\xb9\x49\x24\xbf\xe9\xf4\x9e\x40\xcf\x70\x89\x06\xca\xe4\x93\x2a\xfc\x1b\xb9\x84\xaf\x63\xd0\xf7\x94\x8d\xc1\xc6\xae\x51\xbc\xa5\x85\x0d\xf7\x12\x5d\xa1\x37\x9d\xe8\xda\xc6\x61\x10\x7c\x85\x99\x77\x3f\xe9\x73\x94\xcc\x59\x32\xe6\x82\x2c\xd0\x62\xd4\x19\x1d\x7b\xe9\x98\x90\xe8\x82\x48\x7b\x4f\x0d\x8a\xcc\xc3\x55\x45\xec\xb8\x73\x97\xcb\x37\x76\x7e\x24\x44\x80\x64\x3b\x60\x32\x18\xc5\x5c\xb5\x7f\x66\xaa\x4a\x87\x6e\x2f\xd0\x52\x0f\xfb\x4d\xae\xa4\x8d\xb6\xe7\xad\x38\x1c\xd6\x8e\x5c\x52\xab\x20\xb4\xf6\xe9\x1f\xa3\xc8\x0a\xa9\x30\xe2\x89\xf0\x5c\xaf\xa3\x38\x79\x9f\x65\xdd\x10\x18\xc7\x6f\x59\xfd\xa7\x19\x28\xc9\x0d\xdc\xe7\xd5\xb5\x9d\x5d\x93\x97\x5a\xe5\x1f\x85\xca\x0a\x34\x11\xa3\xe0\x1a\x5a\xec\xba\xd5\x40\xfd\x2f\x3a\x13\x06\x0d\xe1\xb6\xf4\xb6\xbe\x8e\xa1\x14\x4f\x85\x16\xd9\x78\x6f\x90\x57\x7d\x82\x18\xef\x16\x8c\x41\x34\xf4\xaa\x6f\x06\xa7\xd5\x27\x85\x8f\x25\x33\x9b\x89\x71\x42\x7e\x47\x68\xcc\x78\x20\xc5\x2b\x1f\xb4\x54\xf9\xfb\xb5\x78\x7a\xb6\xf2\xfe\x56\x69\xb3\x12\x05\x3d\xd4\x06\x1d\x11\x02\x5f\x4a\x54\x99\x9d\xd1\x78\x58\x59\x75\xb7\x33\x30\x7b\xa9\xb2\x82\xa0\x01\x2c\x2a\xfc\x41\x13\x43\x3d\xfa\xef\xd6\xa0\xb8\xa7\x0e\x41\xb1\x3f\x08\x03\xab\x2a\xef\x4e\xf8\x0e\x8d\x99\x1b\x43\x3f\xa9\x95\x30\xd5\x9d\x28\xa2\x0e\xd3\xd7\xab\x2a\x7f\x4e\x80\xef\xba\x21\xd5\x83\x28\x64\x06\x2b\x3f\xd5\x1a\x4c\x51\x3e\xb8\x5e\xc1\xbd\xd2\x4a\x55\x63\xeb\x17\x4d\x16\x9c\xb8\xad\xae\x18\xb5\xc3\xf2\x98\x9c\xf6\x07\x59\xec\x9d\x6e\xeb\xdf\x57\x57\x7f\x30\xf5\xa2\x7d\x2b\x08\x78\x9a\xee\xf9\xc1\x2a\xdd\x69\x34\xd0\x88\xe8\x6f\x1a\xcc\xd9\x4d\x82\x6a\xd6\x82\xe5\xe0\x77\x72\x7c\xfa\xf7\x6a\xdc\x92\xfb\x49\x7b\xca\x4b\xdd\xa3\x5b\x75\xe3\x34\x2b\xf2\xcc\xea\x9e\x2f\x7e\x1d\x77\x99\xda\x43\xcc\xe0\xdb\x20\xbb\x8a\xe2\x38\x68\xb2\xf7\x58\x3b\x88\xc9\xf9\x84\x2f\xc1\x3d\x81\xfb\x05\x3f\xdc\xcf\x86\xbe\x1c\x89\xcc\xfb\xec\x89\x46\x03\xe1\xbb\x43\x0a\xc6\xbd\xe8\x93\xe6\x1c\x36\x7e\x48\xe8\x80\xc5\x28\x4d\xf8\xb0\xc9\x5a\x04\xff\x6a\x4c\xf8\x88\x69\x6d\x29\xa8\xee\x82\xfe\xed\xa8\xb6\x6a\xa4\x4d\xe2\x78\xc8\xed\x33\xcf\xec\xd6\x6b\x67\x6f\x9f\x83\xfb\x3c\x74\x7f\x0e\x5d\xf4\x95\x52\x90\x93\xad\x4d\xef\xe4\xcb\xd8\x37\x1d\x78\x3b\xf0\x36\x2f\x1d\x30\xfc\xdd\x64\xfb\x84\xb1\x92\xef\xa7\xd3\x99\xbb\xc3\x9f\xe3\xfa\x9a\xdf\x44\xfd\x2d\x3e\xde\x73\x0e\x39\xb5\x64\x61\x75\xc9\x67\xdd\xb7\xcf\xa5\xb6\xbf\x56\x58\xa0\xbb\x97\x04\xa9\xa8\xb0\x25\x59\x5b\x7e\xef\x0e\x39\x90\xa9\x4f\xf6\x4f\x6d\xc1\xbd\x74\xe6\xdc\x0c\xc2\x89\x76\x9a\xe6\x59\x6b\x5a\xc9\x62\x4f\xcb\xf9\x7e\xd3\x71\x89\xa1\x0e\x48\x49\xe9\x77\xf5\x69\xd9\xe2\xc8\x9e\xb1\xa6\x9f\x0d\xb9\x89\x66\xa3\x41\xce\xba\x23\xcf\x3f\x6f\x35\x94\x2d\x7e\x79\x80\x5e\xa4\xff\xde\x20\xbe\x4d\xaf\xed\xc2\xde\xae\xc3\xc1\x36\x3f\x06\xf5\x35\x3e\xda\x0e\x69\x2e\xd4\x3d\x50\xff\x18\xd2\xc4\xcd\x67\xfe\x35\x2d\x53\xde\x1d\x7a\xc6\xcd\xa7\xe1\x9f\x26\xc4\xa5\x54\x79\xe7\xe5\xe7\x2f\xb7\x4f\x16\x37\xcd\xdf\xf7\x94\x2a\xc4\xc3\xb8\x9d\xd0\xed\xfa\xeb\xa6\x54\x1a\xc4\x90\x06\xb3\x68\x0d\xfe\x53\x83\xab\x74\x76\xdb\xec\xf9\x04\xb1\x09\xf9\x76\x6d\x1f\x39\x52\x3f\x8b\x1f\x89\xf4\x3e\x37\xba\x56\x99\x1f\x5f\xcb\xc4\x7d\xdf\x18\x84\x42\x67\x33\x45\xc7\x0e\xbb\x61\xbe\xed\x5f\x5e\xda\x0d\xf2\x26\xde\x3f\xc4\xb0\xde\x09\x37\x1d\x13\x29\x59\xb0\xf2\x18\xd6\x71\x38\x0c\xb6\xf1\x43\x9b\x56\xaa\xa3\x6f\x99\xf8\x3b\x75\x7b\x91\x8e\xd6\x63\x30\xae\x0c\xc3\x3d\x5b\x6d\x4d\x7f\x6e\x72\x67\x2b\x5b\x77\x2f\x77\xd7\x8a\xc3\x20\xd7\xed\xc4\xbd\x3d\x2b\x0f\x57\x76\x9a\x5c\x9f\x88\x15\x1c\x74\x77\xdf\x9d\x86\xea\x6f\xbf\x3c\x72\x73\x31\x7e\x7d\x56\x8a\x2c\x71\x63\x44\x59\xa2\xd9\xb0\xe2\x94\xb4\x7c\xb5\xc5\x8d\x8b\xce\x57\x1a\x65\xd3\xf5\x34\x12\x89\x86\x86\xd0\xc4\xdd\x28\x21\x97\xbc\xc7\x91\xce\x9e\xc6\xad\xea\x89\xc3\xb1\x33\xd4\xea\xfd\x73\x71\x71\x1e\xc5\x6f\x87\x62\xb3\x41\xc6\xc8\x6d\x7f\x3a\x91\xb9\x36\x3b\x01\x85\x49\xf6\x06\x67\xf3\x29\x5d\xfb\x95\x28\x98\x8f\xe6\xc4\x51\x44\x2e\xa1\x4a\xb7\x9c\xee\xee\x78\x5e\x6d\x4e\x64\xe8\x7d\x67\xc3\x33\xa8\xd2\xc1\x72\xc4\x57\x9b\x60\xc5\x07\x26\xcc\xb8\x23\xd0\x63\x37\x21\x90\x12\x25\xe4\xfb\x9f\x17\xfe\xde\xd7\x85\x9d\xca\x7b\xfe\x3d\x20\x62\xbc\x7e\xe8\x93\x88\xc3\x97\x93\xf2\xba\xbc\x4d\x36\x9b\x0f\x9a\x6c\x0c\x5c\x6a\x9a\x4d\xd3\x17\x06\xb1\x7d\x67\x00\x76\x9c\x7a\xcd\xd5\xd6\x0c\x6f\x53\x9b\x8d\xfb\x0c\xf1\xdf\x00\x00\x00\xff\xff\x22\x86\x06\xe6\x14\x19\x00\x00")
+var _svcTransport_wsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\x5b\x6f\xdc\x36\xf6\x7f\x26\x3f\xc5\xe9\x20\x08\x24\x63\xac\x49\x8b\xff\xcb\x5f\xc9\x3c\xc4\x97\x4d\xbc\x6d\x6c\xc3\xe3\xd4\x0f\x81\x91\xd2\xd2\x19\x99\x6b\x0d\xa9\xa5\x28\x8f\xbd\x03\x7d\xf7\xc5\x21\x29\x8d\xe6\xe2\x26\x69\xd7\x40\x6b\x4b\xe7\xc2\xdf\xb9\x1f\x2a\x93\x09\x1c\xeb\x1c\xa1\x40\x85\x46\x58\xcc\xe1\xee\x19\xee\xc5\xf2\x21\x81\x93\x0b\x38\xbf\xb8\x86\xd3\x93\xb3\xeb\x84\x4f\x26\x70\x85\xa6\x51\x4a\xaa\xc2\xd1\x61\x29\xcb\x12\xf4\x23\x9a\xa5\x91\x16\xc1\xde\xcb\x1a\xe6\xb2\x44\xc7\xfb\x3b\x9a\x5a\x6a\x95\xc2\x6a\x95\x84\xbf\xdb\x76\x40\x80\x13\x61\x71\x48\xa5\xe7\xb6\xe5\xbc\x12\xd9\x83\x28\x90\x28\xb3\xc7\xec\xd2\x3f\x11\x41\x2e\x2a\x6d\x2c\x44\x9c\x8d\x32\xad\x2c\x3e\xd9\x11\x67\x23\x54\x99\xce\xa5\x2a\x26\xff\xaa\xb5\xa2\x17\x85\xb4\xf7\xcd\x5d\x92\xe9\xc5\xa4\xd0\x87\x0f\xd2\x4e\xe8\x3f\x54\x79\xa5\xa5\x22\x11\x6b\x84\xaa\x9d\xaa\x17\x78\x7b\x86\xc9\xbd\xb5\xd5\x8e\x4e\x5d\x94\x38\x69\x1a\x99\xef\x50\x8c\x2c\x4b\x31\x59\xe2\x5d\xad\xb3\x07\xb4\x5b\xf4\x5a\x9a\xa6\xaa\x51\x4d\x4a\x5d\x98\xa6\x26\xaa\xc2\xf5\x19\xf5\xb3\xca\xe8\x9d\x95\x0b\x1c\x71\x36\x99\xc0\x35\x39\xb4\x46\xf3\x28\x33\xe4\xac\xba\x83\xd1\x6a\x95\x5c\x1e\x9d\x39\x3f\x5c\x0a\x7b\x0f\x87\x6d\x3b\xe2\x6c\xb5\x3a\x04\x23\x54\x81\xf0\x4a\x94\x52\xd4\x63\x78\x55\x11\x35\x9d\x42\x32\xf3\xe2\xc9\x07\xed\xc5\xea\xb6\x25\x01\xcf\xd8\xb6\xa4\xd2\x31\xf7\x8a\x50\xe5\x6d\xcb\x63\xce\x33\xad\x6a\xe7\xed\x4a\xab\xe2\x46\x48\xcb\x00\x60\x0a\xbf\xbc\x81\x03\x20\x8c\xc9\x0c\x33\xad\x72\xce\x2a\xa9\x8a\x4b\x34\x52\xe7\x0c\xa6\x10\x75\xec\x70\x00\xff\x1f\xc3\x04\x7e\x7e\x43\x07\x1e\x82\x9c\x43\x61\x21\xf9\x78\x7d\x7d\xf9\x11\xcb\x0a\x4d\x8f\xed\x66\xf6\x49\x3c\xcd\xe4\x7f\x10\xde\x00\xc1\x63\x0b\xf1\xf4\x09\xeb\x5a\x14\xe8\xde\x4e\x29\x17\xfe\x4c\xae\x6d\x7b\xec\xa4\x20\xe6\xdc\x3e\x57\x08\x37\x78\x37\x73\x81\x38\xd6\x6a\x2e\x0b\xa8\xad\x69\x32\x0b\x2b\xce\x3e\x34\xc2\xe4\xd0\xfd\xcc\x1b\x95\x45\x99\x7d\x82\x90\x56\xc9\xb1\xff\x3d\x06\x03\x07\x14\x9e\xe4\x0a\xff\xdd\x60\x6d\x63\x88\x76\x58\xd0\x18\x6d\x62\xce\x2e\x8c\x2c\xa4\x3a\xbe\xc7\xec\x01\x8d\x57\xb9\x23\x7d\xa7\x75\xc9\xdb\x80\x2e\x58\x38\x40\xf5\x09\xed\xbd\x76\xb0\x6a\x6b\xa8\xca\xfa\x9f\x3f\x28\xb9\xd3\xd1\xc2\x31\x8c\xfe\xe0\xec\x44\x58\xc1\x00\xe8\x75\x72\x25\x96\x9d\xae\xc0\x97\x0b\x2b\xc6\x7a\x21\x2d\x2e\x2a\xfb\x4c\xfc\xc7\x7a\xb1\x10\x2a\x7f\x59\x75\xe6\x19\x36\xa5\x02\xf0\xb3\x93\x97\xa4\x8c\x67\xf8\x2a\xb7\x04\x67\x56\xd8\xa6\x26\x4e\xa9\x6c\x27\x33\x14\xac\x1d\xc3\x86\x50\xe7\x97\x4b\xad\xcb\x81\x53\x4a\x5d\x50\xe2\x1d\xf8\xa2\x49\x4e\x95\x35\xcf\x9c\x35\x55\x61\x44\x8e\x00\xd0\x57\x5b\xf2\xd9\xbf\x33\x9c\x15\x7d\x78\xff\x27\xa1\xed\x7a\x47\x0d\x0b\x51\x7d\xf1\xae\xb8\xed\x5e\x26\xa7\xe1\x0f\xce\x72\xcc\x74\x8e\xa6\x86\x21\x9f\x43\xb0\x15\xa7\x18\x22\xa9\x2c\x9a\xb9\xc8\x70\xd5\xae\x0f\xca\x4a\x89\x74\x8c\x57\x70\x70\xec\x1e\x6f\x5d\xd6\x30\x6a\x0f\xc9\xd5\xcd\xa7\xc6\xe2\x53\x9f\x43\x9e\x63\xe0\x2d\xb9\xce\x6a\x8f\x93\xb3\x52\xf7\x71\xdb\x72\x62\xa6\x95\xc2\xcc\x52\x33\x3e\x58\xbb\xf1\x58\x2b\xc5\x59\x45\x51\x08\x52\x14\x11\xce\xa8\x40\xc2\xcf\x96\xa7\x38\x67\xba\xb1\x90\xdd\x0b\x05\xc1\x42\x42\x48\x96\xc3\x39\x2e\x49\x3c\x22\x14\x1b\xc7\x8f\x61\xed\xd6\xce\x85\xf5\x18\x96\xf5\xf1\xbc\xd8\xae\xdc\x18\x0e\x48\x09\x55\x6e\x05\xe9\x14\x5e\xd3\xd3\x8a\x33\xb2\x2d\x65\x50\xea\x62\xcc\x59\xe7\xbd\x14\x16\xe2\x01\xa3\x6d\x0f\xc6\xc4\x12\xd2\x26\xdd\x93\x35\xa4\x8e\xb9\xf2\xf5\x95\x9c\x3a\x4b\x1d\x9c\x64\xa3\xb6\x49\x0f\xbb\x42\x91\x1f\x35\xf3\x39\x1a\xea\x3f\x29\xc0\xcf\x6f\x7e\xf9\x3f\x47\xb9\xa1\x59\x38\x24\x75\x94\x96\xfe\xe7\x32\x73\xa8\xda\x75\x22\xa2\xf4\xde\x18\xe0\x7f\x29\xd5\x9c\x2d\x5d\xb6\xa5\xb0\x23\xf0\xfd\x39\x47\x9a\x5a\x4e\xdd\x33\x0c\x10\xb9\x31\x35\x7c\x47\x72\x33\x83\xad\x56\xd4\xc2\xa9\x89\x44\x4a\x5b\x78\x25\xbb\xae\x38\xb3\x06\xc5\x22\x1e\xbc\xae\x2b\xad\x6a\xec\xde\x3b\x69\x56\x25\xbd\x85\x5f\x68\xe8\xc8\xe4\x5c\x2c\xb0\x6d\x47\xb7\x30\x5d\xa7\x42\x32\xa0\x74\xd6\x7a\xe9\xce\xdc\x5d\xe1\x9e\x36\x20\x78\xbc\x61\x1e\x0c\xe6\x1a\x67\x06\x6d\x63\x14\x54\x7d\x86\x46\x95\xcf\xae\x18\xde\xe7\xb9\x2f\xa8\xfd\x1d\xe3\xe5\x72\x89\x21\x64\x5a\x28\xc1\x74\x0a\xb4\x1f\x24\xe7\xb8\x9c\xb9\x88\x44\x31\x67\x19\x79\xf6\xb5\xe7\xa3\x64\x93\x79\xca\x18\xc8\x9c\x62\xb9\x56\x9d\x0e\x8e\x21\x0a\xd5\x61\x4a\x0d\xb0\x1a\x87\x7c\x87\x2a\x29\x75\x91\xdc\x48\x7b\xff\x0f\x89\x65\x5e\x47\xa1\xac\xfc\x13\xa9\x66\xa3\x7e\x85\x19\xa5\x30\xba\x39\x3d\x9a\x5d\x1c\xff\x7a\x7a\x3d\x22\x1d\x6c\xe4\x0b\x65\x94\x32\x7f\x78\x4b\x49\x40\xe5\x9d\xf6\x36\x93\xf2\xdf\x45\xd9\x20\x79\x62\x0c\x03\x75\xe3\xa1\x3a\x27\xa8\x1b\x1b\x52\x76\xd8\x00\x88\xd4\x72\x96\x39\xac\x67\x6a\xae\xa3\xd1\x97\x9b\xd9\x2d\xf8\xb3\x3b\x23\x31\x1f\xc5\x9c\x55\x49\x28\xdd\x2f\x19\xc5\xd3\x9a\x06\xd7\x81\xca\xf6\x04\xca\xe0\x42\x3f\x62\x17\x2b\xf7\xab\x8b\x40\x4c\x21\xa8\x92\xdf\x74\xf6\x40\x4e\xcf\x71\x8e\x06\xaa\xe4\xb3\x2a\xc3\x1b\x39\x87\xaf\x63\xd0\x0f\x14\x8d\xc1\xc1\x4e\xc9\xed\x5b\x22\xac\xc8\x1b\xa5\xae\x31\xa8\x4e\x74\x63\x63\xce\xd8\x57\x98\x06\xf8\xc9\x3a\x46\xc9\xb1\xe3\x24\x7a\x8e\x25\x5a\x8c\x7a\xa5\xe3\xc0\x1d\x93\x27\x7a\x23\xb2\x35\x52\x83\x22\x0f\xee\xaa\x23\x07\xdc\xc3\x25\x6b\xfd\x33\xcb\x12\x4a\x80\x64\xd3\x60\x52\x18\xc5\xae\x6a\x7f\x64\xab\xca\x86\xb0\x67\x68\xa9\x87\xfd\x26\x17\xd2\x46\x9b\xfb\x56\xcc\x87\xb5\x23\xe7\x34\x9e\xc8\x5b\xfb\xe4\x4f\x50\xe4\xa5\x54\x18\xb9\x8d\xf0\x5c\x2f\xa3\x38\x79\x9f\xe7\xfd\x12\x18\xc7\x6f\x9d\xf8\x4f\x53\x50\xb2\x74\x26\xf9\xb8\x92\x4f\xf8\x0e\xa4\x4b\xad\x8a\x8f\x42\xe5\x25\x9a\xc8\x79\xc1\x77\xc0\x98\x74\x68\x33\x10\xff\x8b\x60\x38\x6b\xc9\x6f\xf3\xa0\xeb\xeb\x18\x2a\xf1\x5c\x6a\x91\x8f\xf7\x1a\x79\xb5\x0e\x90\xf3\x37\x93\xf3\x6d\x6b\xe8\xd5\xba\x19\x9c\xd5\x9f\x15\x3e\x55\x2e\xb3\x5d\x62\x9c\x12\xee\x08\x8d\x19\x0f\xb8\x1c\xe5\x83\x96\xaa\x78\xbf\x14\xcf\x3b\x94\xf7\x77\x4a\x9b\x85\x28\xe9\xa1\x31\xe8\x13\x81\x85\x52\xa2\xca\xec\x95\xc6\xc3\xca\x6a\xfa\x93\x21\xa3\x03\xa8\xb2\x18\x6b\x01\xcb\x1a\xbf\x53\xc5\x50\x8e\x04\xee\x0c\x8a\x07\xce\x5c\xa8\xd8\xa3\x30\xb0\xa8\x8b\x7e\xc2\xf7\xde\x98\xfa\x35\xf4\xb3\x5a\x08\x53\xdf\x8b\x32\xea\x7d\xfa\x7a\x51\x17\xbb\x09\xf0\x4d\x18\x52\x3d\x8a\x52\xe6\xb0\x08\x5b\xad\xc1\x0c\xe5\xa3\xef\x15\xae\x57\x5a\xa9\x1a\xec\x70\xd1\x66\xe1\x02\xd7\x29\x75\x7d\x30\xea\x96\xe5\x31\x81\x0e\x83\x2c\x0e\x21\xec\xea\x3f\x54\xd7\x7a\x30\xad\x59\xd7\xad\x80\xb9\x6d\x7a\x9d\x1f\x4e\xa4\x9f\x46\x03\x89\x88\xfe\xa6\xc5\x9c\x8e\x61\xe4\xaa\x69\xe7\x2c\xa7\x87\x79\x3e\x37\xfd\xd7\x62\xd4\x42\xd9\x7a\xd3\x4e\x1d\xdc\xfe\xd1\x53\xfd\x3a\xed\x04\xdd\x75\xc4\x3f\x5f\xfc\x3a\xee\x23\xb5\x27\x31\xd9\x9f\x3b\xd9\x57\x94\xb3\x83\x36\xfb\xe0\x6b\xef\x62\x02\x9f\xb8\x8b\x35\xae\x85\x7b\x42\x58\xee\xa7\x43\x2c\x47\x22\x0f\x98\x43\xa2\xd1\x42\xf8\xee\x90\x8c\xe1\x6c\x33\x68\x1e\xb0\x09\x4b\x42\x5f\x78\x18\x65\x89\x1b\x36\x79\xe7\xc1\xbf\x6a\x13\x3e\x61\xd6\x58\x32\xaa\x0b\xec\x37\xac\xda\xa8\x91\x2e\x88\xe3\x61\x6e\x7f\x0a\x99\xdd\xa1\x76\x62\x7b\x9d\xbe\x0f\xa1\x07\x3b\x84\x18\x2a\xa5\x24\x90\x9d\xce\x00\xf2\x65\xdf\xb7\xbd\xf3\xb6\xdc\xdb\xbe\x34\x60\xdc\x77\x99\x90\x82\xb5\x9f\x28\x56\xba\xfb\x69\x3a\xf5\x77\xf8\x73\x5c\x5e\xbb\x37\xd1\xfa\x16\x1f\xef\x99\x43\x5e\x2c\x99\x59\x5d\x45\xf1\x37\xe7\x52\xd7\x5f\x6b\x2c\xd1\x5f\xb8\x59\x26\x6a\xec\x92\xac\x2b\xbf\x77\x87\xce\x90\x34\x04\xfb\xa7\xae\xe0\x5e\x9a\x39\x37\x03\x73\xa2\xad\xa6\x19\x5e\x8f\x29\x14\x7b\x5a\xce\xb7\x9b\x8e\x0f\x0c\x75\x40\x0a\xca\xfa\xd4\x10\x96\x8d\x1c\x09\xca\x06\xd2\x6b\x7e\xdf\x7c\x43\xaf\xf2\xe1\x0a\x33\xab\x0f\xdf\x46\x43\xd9\xc8\xaf\xe0\xa0\x17\xd3\x3f\x4b\x7e\x38\xbd\x82\xca\x51\xbc\xa7\x0e\x07\xc7\x7c\x9f\xab\xaf\xf1\xc9\xf6\x9e\x26\x33\xe2\xb7\xdf\x09\x72\xc7\xd3\x94\x9b\x3b\xf8\xda\x2e\x53\xde\x1d\x86\x8c\x3b\x4e\xf9\x0f\x27\xc4\xa5\x54\x45\x8f\xf2\xcb\xed\xdd\xb3\xc5\x55\xfb\xf7\x91\x52\x85\x8c\xe2\x3d\x01\xdd\xac\xbf\x7e\x4b\xa5\xcf\x5b\x48\x8b\x59\xb4\x84\xf0\xa9\xc1\x57\xba\x83\x6d\xf6\x7c\x82\x58\x71\x6a\xf1\x74\xf9\x48\xa7\xfd\x2e\x7e\x24\xb2\x87\xc2\xe8\x46\xe5\x61\x7d\xad\x12\x77\x8b\x1c\x9a\x42\xb3\x99\x1c\xe4\x00\x93\x0f\xa9\x91\xd2\x0b\xda\x71\x1d\xb7\x5f\xe4\x4d\x37\x01\xb7\x3c\xe1\xe4\x4e\x5d\xd3\x31\x91\x92\xa5\x13\x1e\xc3\x32\xe6\x43\x63\xdb\xb0\xb4\x69\xa5\xfa\xf4\xad\x92\x70\xa7\xee\x2e\xd2\xd1\x72\x0c\xc6\x97\x21\xdf\x73\x54\xa7\xac\xe5\xdd\xe7\x0e\xea\x04\x55\xb2\x71\xf7\xf2\x77\xad\x98\xb3\x42\x77\x1b\xf7\xe6\xae\x3c\xa4\x6c\x35\xb9\x75\x20\x16\x70\x10\x5e\xc7\xb0\xd5\x50\xc3\xed\x97\xaa\x8a\xb2\x78\x0c\x5f\x77\x4a\xd1\x71\xdc\x18\x51\x55\x68\x56\x4e\x30\x25\xa9\x50\x6d\x71\xeb\x63\x11\x2a\x0d\x4d\xd7\xd3\x88\x25\x1a\x2a\x42\x13\xf7\xab\x84\x9c\xbb\x55\xe9\x48\xe7\xcf\xe3\x4e\xf4\xd4\xef\x15\xbd\xa2\x4e\xee\x9f\xb3\x8b\xf3\x28\x7e\x3b\x64\x9b\x0e\x22\x46\xb0\xc3\x74\x22\x75\x5d\x74\x18\x99\x09\xe9\xc6\x6c\x3e\xa3\x6b\xbf\x12\xa5\xcb\x47\xe3\xf0\x3b\xec\x75\xb6\x01\xba\xbf\xe3\x05\x31\xfa\x47\x81\x01\x76\xa7\x78\x0a\x75\x36\x20\x53\x20\x5a\xce\x16\x6e\x60\xc2\x14\x08\x14\x3d\xf6\x1b\x02\x09\x51\x40\xbe\xfd\x79\xe1\xef\x7d\x5d\xd8\xaa\xbc\xdd\xef\x01\x11\x41\x83\xef\xfa\x24\xe2\xac\x65\x2e\x28\xaf\xdd\x17\x85\x0f\x3a\x40\x69\xdb\x55\xd8\x5d\x28\x87\x29\xcb\xb7\x16\x5f\x3a\x64\x0c\xaf\x5d\x95\xb5\xc3\x5b\xd4\x6a\x85\x2a\x6f\x5b\xfe\xdf\x01\x00\x8c\x32\x53\x48\x6c\x19\x00\x00")
 
 func svcTransport_wsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -344,7 +365,7 @@ func svcTransport_wsGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/transport_ws.go.tpl", size: 6420, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
+	info := bindataFileInfo{name: "svc/transport_ws.go.tpl", size: 6508, mode: os.FileMode(420), modTime: time.Unix(1464111000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -406,6 +427,7 @@ var _bindata = map[string]func() (*asset, error){
 	"handlers/handlers.methods.go.tpl": handlersHandlersMethodsGoTpl,
 	"handlers/hooks.go.tpl":            handlersHooksGoTpl,
 	"handlers/middlewares.go.tpl":      handlersMiddlewaresGoTpl,
+	"svc/build.go.tpl":                 svcBuildGoTpl,
 	"svc/client/grpc/client.go.tpl":    svcClientGrpcClientGoTpl,
 	"svc/client/http/client.go.tpl":    svcClientHttpClientGoTpl,
 	"svc/config.go.tpl":                svcConfigGoTpl,
@@ -471,6 +493,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"middlewares.go.tpl":      &bintree{handlersMiddlewaresGoTpl, map[string]*bintree{}},
 	}},
 	"svc": &bintree{nil, map[string]*bintree{
+		"build.go.tpl": &bintree{svcBuildGoTpl, map[string]*bintree{}},
 		"client": &bintree{nil, map[string]*bintree{
 			"grpc": &bintree{nil, map[string]*bintree{
 				"client.go.tpl": &bintree{svcClientGrpcClientGoTpl, map[string]*bintree{}},
@@ -0,0 +1,98 @@
+package kit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+)
+
+// version and versionDate identify the build of hawk, they are stamped into
+// the generated files. They can be set at build time, e.g.
+// `-ldflags "-X github.com/niiigoo/hawk/kit.version=v1.2.3"`, otherwise the
+// module version and the VCS time embedded by `go build` are used.
+var (
+	version     string
+	versionDate string
+)
+
+// Version returns the version of hawk and its date, the date is empty if
+// unknown, e.g. for `go install` of a released version.
+func Version() (string, string) {
+	v, date := version, versionDate
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return v, date
+	}
+	if v == "" {
+		v = info.Main.Version
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.time" && date == "" {
+			date = setting.Value
+		}
+	}
+	return v, date
+}
+
+// ProtoHash identifies the proto files by their names and contents. Unlike a
+// VCS revision, it does not change by committing the generated code, which
+// would make the committed files outdated.
+func ProtoHash(files ...string) (string, error) {
+	h := sha256.New()
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte(filepath.Base(f)))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12], nil
+}
+
+// ProtoRevision returns the git revision of the proto files, described by
+// `git describe --tags --always`. Only the last commit changing one of the
+// files is considered, committing the generated code does not change the
+// revision. It is empty if the files are not located in a repository or have
+// uncommitted changes, ProtoHash identifies them then.
+func ProtoRevision(files ...string) string {
+	if len(files) == 0 {
+		return ""
+	}
+	paths := make([]string, len(files))
+	for i, f := range files {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return ""
+		}
+		paths[i] = abs
+	}
+	dir := filepath.Dir(paths[0])
+
+	// untracked and modified files are listed
+	status, err := git(dir, append([]string{"status", "--porcelain", "--"}, paths...)...)
+	if err != nil || status != "" {
+		return ""
+	}
+	commit, err := git(dir, append([]string{"log", "-1", "--format=%H", "--"}, paths...)...)
+	if err != nil || commit == "" {
+		return ""
+	}
+	describe, err := git(dir, "describe", "--tags", "--always", commit)
+	if err != nil {
+		return ""
+	}
+	return describe
+}
+
+// git runs the git command in dir and returns its trimmed output.
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
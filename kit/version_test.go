@@ -0,0 +1,57 @@
+package kit
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestProtoRevision(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+	}
+	proto := filepath.Join(dir, "greeter.proto")
+	writeTestFile(t, proto, greeterProto)
+
+	if revision := ProtoRevision(proto); revision != "" {
+		t.Errorf("Revision outside of a repository: %q", revision)
+	}
+
+	run("init", "-q")
+	if revision := ProtoRevision(proto); revision != "" {
+		t.Errorf("Revision of an untracked file: %q", revision)
+	}
+
+	run("add", "greeter.proto")
+	run("commit", "-q", "-m", "proto")
+	run("tag", "v1.0.0")
+	if revision := ProtoRevision(proto); revision != "v1.0.0" {
+		t.Errorf("Revision of the tagged file is %q, want v1.0.0", revision)
+	}
+
+	// committing the generated code does not change the revision
+	if err := os.MkdirAll(filepath.Join(dir, "svc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(dir, "svc", "build.go"), "package svc\n")
+	run("add", "svc")
+	run("commit", "-q", "-m", "generated")
+	if revision := ProtoRevision(proto); revision != "v1.0.0" {
+		t.Errorf("Revision after committing the generated code is %q, want v1.0.0", revision)
+	}
+
+	writeTestFile(t, proto, greeterProto+"// changed\n")
+	if revision := ProtoRevision(proto); revision != "" {
+		t.Errorf("Revision of a modified file: %q", revision)
+	}
+}
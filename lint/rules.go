@@ -0,0 +1,187 @@
+package lint
+
+import (
+	"github.com/niiigoo/hawk/proto"
+	"github.com/niiigoo/hawk/proto/io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Rule is a check of `hawk lint`, it can be disabled by its ID in `protoc.yaml`.
+type Rule struct {
+	ID          string
+	Description string
+	// check reports the problems of the elements declared in file, rules
+	// without check are reported while building the definition
+	check func(file string, def *proto.Definition, diagnostics *proto.Diagnostics)
+}
+
+var (
+	pascalCase     = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+	lowerSnakeCase = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+	upperSnakeCase = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+)
+
+// Rules contains all rules ordered by their ID.
+var Rules = []*Rule{
+	{
+		ID:          "COMMENT_FIELD",
+		Description: "Fields are documented, the comments are used by `hawk docu`",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, msg := range messages(file, def) {
+				for _, field := range msg.Fields {
+					if len(field.Comments) == 0 {
+						diagnostics.Reportf(field.Pos, proto.SeverityWarning, "COMMENT_FIELD", "field `%s.%s` has no comment", msg.Name, field.Name)
+					}
+				}
+			}
+		},
+	},
+	{
+		ID:          "COMMENT_METHOD",
+		Description: "Methods are documented, the comments are used by `hawk docu`",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, service := range def.Services {
+				for _, method := range service.Methods {
+					if len(method.Comments) == 0 {
+						diagnostics.Reportf(method.Pos, proto.SeverityWarning, "COMMENT_METHOD", "method `%s.%s` has no comment", service.Name, method.Name)
+					}
+				}
+			}
+		},
+	},
+	{
+		ID:          "COMMENT_SERVICE",
+		Description: "Services are documented, the comments are used by `hawk docu`",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, service := range def.Services {
+				if len(service.Comments) == 0 {
+					diagnostics.Reportf(service.Pos, proto.SeverityWarning, "COMMENT_SERVICE", "service `%s` has no comment", service.Name)
+				}
+			}
+		},
+	},
+	{
+		ID:          proto.RuleHttpNonBaseType,
+		Description: "Parameters located outside of the body are scalars, enums, repeated scalars or timestamps",
+	},
+	{
+		ID:          proto.RuleHttpRepeatedPath,
+		Description: "Path parameters are not repeated",
+	},
+	{
+		ID:          proto.RuleHttpStreaming,
+		Description: "Streaming methods have no `google.api.http` option",
+	},
+	{
+		ID:          "NAMING_ENUM",
+		Description: "Enums are named in PascalCase",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, enum := range enums(file, def) {
+				if !pascalCase.MatchString(enum.Name) {
+					diagnostics.Reportf(enum.Pos, proto.SeverityWarning, "NAMING_ENUM", "enum `%s` is not named in PascalCase", enum.Name)
+				}
+			}
+		},
+	},
+	{
+		ID:          "NAMING_ENUM_VALUE",
+		Description: "Enum values are named in UPPER_SNAKE_CASE",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, enum := range enums(file, def) {
+				for _, entry := range enum.Values {
+					if entry.Value != nil && !upperSnakeCase.MatchString(entry.Value.Key) {
+						diagnostics.Reportf(entry.Value.Pos, proto.SeverityWarning, "NAMING_ENUM_VALUE", "value `%s` of enum `%s` is not named in UPPER_SNAKE_CASE", entry.Value.Key, enum.Name)
+					}
+				}
+			}
+		},
+	},
+	{
+		ID:          "NAMING_FIELD",
+		Description: "Fields are named in lower_snake_case",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, msg := range messages(file, def) {
+				for _, field := range msg.Fields {
+					if !lowerSnakeCase.MatchString(field.Name) {
+						diagnostics.Reportf(field.Pos, proto.SeverityWarning, "NAMING_FIELD", "field `%s.%s` is not named in lower_snake_case", msg.Name, field.Name)
+					}
+				}
+			}
+		},
+	},
+	{
+		ID:          "NAMING_MESSAGE",
+		Description: "Messages are named in PascalCase",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, msg := range messages(file, def) {
+				if !pascalCase.MatchString(msg.Name) {
+					diagnostics.Reportf(msg.Pos, proto.SeverityWarning, "NAMING_MESSAGE", "message `%s` is not named in PascalCase", msg.Name)
+				}
+			}
+		},
+	},
+	{
+		ID:          "NAMING_METHOD",
+		Description: "Methods are named in PascalCase",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, service := range def.Services {
+				for _, method := range service.Methods {
+					if !pascalCase.MatchString(method.Name) {
+						diagnostics.Reportf(method.Pos, proto.SeverityWarning, "NAMING_METHOD", "method `%s.%s` is not named in PascalCase", service.Name, method.Name)
+					}
+				}
+			}
+		},
+	},
+	{
+		ID:          "NAMING_SERVICE",
+		Description: "Services are named in PascalCase",
+		check: func(file string, def *proto.Definition, diagnostics *proto.Diagnostics) {
+			for _, service := range def.Services {
+				if !pascalCase.MatchString(service.Name) {
+					diagnostics.Reportf(service.Pos, proto.SeverityWarning, "NAMING_SERVICE", "service `%s` is not named in PascalCase", service.Name)
+				}
+			}
+		},
+	},
+	{
+		ID:          proto.RuleServiceConfigKey,
+		Description: "The legacy `(config)` option of services contains known keys only",
+	},
+}
+
+// messages returns the messages declared in file ordered by their position.
+func messages(file string, def *proto.Definition) []*proto.Message {
+	res := make([]*proto.Message, 0)
+	for name, msg := range def.Messages {
+		if name == msg.FullName && msg.Pos.Filename == file {
+			res = append(res, msg)
+		}
+	}
+	slices.SortFunc(res, func(a, b *proto.Message) int {
+		return a.Pos.Offset - b.Pos.Offset
+	})
+	return res
+}
+
+// enums returns the enums declared in file.
+func enums(file string, def *proto.Definition) []*io.Enum {
+	res := make([]*io.Enum, 0)
+	for _, enum := range def.Enums() {
+		if enum.Pos.Filename == file {
+			res = append(res, enum)
+		}
+	}
+	return res
+}
+
+func findRule(id string) *Rule {
+	for _, rule := range Rules {
+		if strings.EqualFold(rule.ID, id) {
+			return rule
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/niiigoo/hawk/proto"
+	"github.com/pkg/errors"
+	"io"
+	"slices"
+)
+
+var ErrFormat = errors.New("invalid format given")
+
+type Service interface {
+	Lint(args ...string) (proto.Diagnostics, error)
+}
+
+type service struct {
+	protoService proto.Parser
+}
+
+// NewService creates the lint service, the proto file is parsed by parser if
+// provided.
+func NewService(parser ...proto.Parser) Service {
+	protoService := proto.NewService()
+	if len(parser) > 0 {
+		protoService = parser[0]
+	}
+	return &service{
+		protoService: protoService,
+	}
+}
+
+// Lint checks the proto file against all rules enabled in `protoc.yaml`. The
+// problems found by the parser are reported as well.
+func (s *service) Lint(args ...string) (proto.Diagnostics, error) {
+	file, err := s.protoService.DetectFile(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var diagnostics proto.Diagnostics
+	if err = s.protoService.Parse(file, true); err != nil && !errors.As(err, &diagnostics) {
+		return nil, err
+	}
+
+	return Lint(file, s.protoService.Definition(), s.protoService.Config().Lint)
+}
+
+// Lint checks the elements declared in file against all enabled rules. The
+// diagnostics of the definition are included, the ones without rule cannot be
+// disabled. The result is ordered by position.
+func Lint(file string, def *proto.Definition, config proto.LintConfig) (proto.Diagnostics, error) {
+	enabled := make(map[string]bool)
+	for _, rule := range Rules {
+		enabled[rule.ID] = true
+	}
+	for id, enable := range config.Rules {
+		rule := findRule(id)
+		if rule == nil {
+			return nil, errors.Errorf("unknown lint rule '%s'", id)
+		}
+		enabled[rule.ID] = enable
+	}
+
+	diagnostics := make(proto.Diagnostics, 0)
+	diagnostics = append(diagnostics, def.Diagnostics...)
+	for _, rule := range Rules {
+		if rule.check != nil && enabled[rule.ID] {
+			rule.check(file, def, &diagnostics)
+		}
+	}
+
+	res := make(proto.Diagnostics, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		if d.Rule == "" || enabled[d.Rule] {
+			res = append(res, d)
+		}
+	}
+	slices.SortStableFunc(res, func(a, b *proto.Diagnostic) int {
+		if a.Pos.Filename != b.Pos.Filename {
+			if a.Pos.Filename < b.Pos.Filename {
+				return -1
+			}
+			return 1
+		}
+		if a.Pos.Line != b.Pos.Line {
+			return a.Pos.Line - b.Pos.Line
+		}
+		return a.Pos.Column - b.Pos.Column
+	})
+
+	return res, nil
+}
+
+// problem is the JSON representation of a diagnostic.
+type problem struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Rule     string `json:"rule,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Write prints the diagnostics either as text (one per line) or as JSON array.
+func Write(w io.Writer, diagnostics proto.Diagnostics, format string) error {
+	switch format {
+	case "text":
+		for _, d := range diagnostics {
+			line := d.Error()
+			if d.Rule != "" {
+				line += " (" + d.Rule + ")"
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "json":
+		problems := make([]problem, len(diagnostics))
+		for i, d := range diagnostics {
+			problems[i] = problem{
+				File:     d.Pos.Filename,
+				Line:     d.Pos.Line,
+				Column:   d.Pos.Column,
+				Severity: d.Severity.String(),
+				Rule:     d.Rule,
+				Message:  d.Message,
+			}
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(problems)
+	}
+	return ErrFormat
+}
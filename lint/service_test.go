@@ -0,0 +1,173 @@
+package lint
+
+import (
+	"bytes"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/niiigoo/hawk/proto"
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type LintTestSuite struct {
+	suite.Suite
+}
+
+func TestLintTestSuite(t *testing.T) {
+	suite.Run(t, new(LintTestSuite))
+}
+
+const lintProto = `syntax = "proto3";
+package test;
+// A request
+message Request {
+  // the id
+  string id = 1;
+  // the tags
+  repeated string Tags = 2;
+}
+enum kind {
+  a = 0;
+}
+// The service
+service Test {
+  option (config) = {
+    HttpPrefix: "/api"
+    Unknown: true
+  };
+  rpc get(Request) returns (Request) {
+    option (google.api.http) = {
+      get: "/entity/{Tags}"
+    };
+  }
+}
+`
+
+func (s *LintTestSuite) lint(config proto.LintConfig) proto.Diagnostics {
+	return s.lintString(lintProto, config)
+}
+
+func (s *LintTestSuite) lintString(src string, config proto.LintConfig) proto.Diagnostics {
+	p, err := io.ParseString("test.proto", src, true)
+	s.Require().NoError(err)
+	def, err := proto.DefinitionFromProto(p)
+	s.Require().NoError(err)
+
+	diagnostics, err := Lint("test.proto", def, config)
+	s.Require().NoError(err)
+	return diagnostics
+}
+
+func (s *LintTestSuite) rules(diagnostics proto.Diagnostics) []string {
+	rules := make([]string, len(diagnostics))
+	for i, d := range diagnostics {
+		rules[i] = d.Rule
+	}
+	return rules
+}
+
+func (s *LintTestSuite) TestLint() {
+	diagnostics := s.lint(proto.LintConfig{})
+
+	s.Equal([]string{
+		"HTTP_REPEATED_PATH",
+		"NAMING_FIELD",
+		"NAMING_ENUM",
+		"NAMING_ENUM_VALUE",
+		"SERVICE_CONFIG_KEY",
+		"COMMENT_METHOD",
+		"NAMING_METHOD",
+	}, s.rules(diagnostics))
+	s.Equal("test.proto:7:3: warning: field `Request.Tags` is not named in lower_snake_case", diagnostics[1].Error())
+}
+
+func (s *LintTestSuite) TestLint_Disabled() {
+	diagnostics := s.lint(proto.LintConfig{Rules: map[string]bool{
+		"NAMING_FIELD":       false,
+		"naming_enum":        false,
+		"NAMING_ENUM_VALUE":  false,
+		"HTTP_REPEATED_PATH": false,
+		"COMMENT_METHOD":     true,
+	}})
+
+	s.Equal([]string{"SERVICE_CONFIG_KEY", "COMMENT_METHOD", "NAMING_METHOD"}, s.rules(diagnostics))
+}
+
+func (s *LintTestSuite) TestLint_NonBaseType() {
+	diagnostics := s.lintString(`syntax = "proto3";
+package test;
+import "google/protobuf/timestamp.proto";
+// A filter
+message Filter {
+  // the name
+  string name = 1;
+}
+// A request
+message Request {
+  // the filter
+  Filter filter = 1;
+  // the filters
+  repeated Filter filters = 2;
+  // the labels
+  map<string, string> labels = 3;
+  // the creation time
+  google.protobuf.Timestamp since = 4;
+  // the ids
+  repeated string ids = 5;
+  // the body
+  Filter body = 6;
+}
+// The service
+service Test {
+  // Lists the entities
+  rpc List(Request) returns (Request) {
+    option (google.api.http) = {
+      post: "/entity"
+      body: "body"
+    };
+  }
+}
+`, proto.LintConfig{})
+
+	messages := make([]string, 0)
+	for _, d := range diagnostics {
+		s.Equal("HTTP_NON_BASE_TYPE", d.Rule)
+		messages = append(messages, d.Error())
+	}
+	s.ElementsMatch([]string{
+		"test.proto:11:3: warning: List.filter is a non-base type specified to be located outside of the body. " +
+			"Non-base types outside the body may result in generated code which fails to compile.",
+		"test.proto:13:3: warning: List.filters is a non-base type specified to be located outside of the body. " +
+			"Non-base types outside the body may result in generated code which fails to compile.",
+		"test.proto:15:3: warning: List.labels is a non-base type specified to be located outside of the body. " +
+			"Non-base types outside the body may result in generated code which fails to compile.",
+	}, messages)
+}
+
+func (s *LintTestSuite) TestLint_UnknownRule() {
+	_, err := Lint("test.proto", &proto.Definition{}, proto.LintConfig{Rules: map[string]bool{"UNKNOWN": false}})
+	s.EqualError(err, "unknown lint rule 'UNKNOWN'")
+}
+
+func (s *LintTestSuite) TestWrite() {
+	diagnostics := make(proto.Diagnostics, 0)
+	pos := lexer.Position{Filename: "test.proto", Line: 3, Column: 2}
+	diagnostics.Reportf(pos, proto.SeverityWarning, "NAMING_FIELD", "field `A.B` is not named in lower_snake_case")
+
+	out := bytes.NewBuffer(nil)
+	s.Require().NoError(Write(out, diagnostics, "text"))
+	s.Equal("test.proto:3:2: warning: field `A.B` is not named in lower_snake_case (NAMING_FIELD)\n", out.String())
+
+	out.Reset()
+	s.Require().NoError(Write(out, diagnostics, "json"))
+	s.JSONEq(`[{
+		"file": "test.proto",
+		"line": 3,
+		"column": 2,
+		"severity": "warning",
+		"rule": "NAMING_FIELD",
+		"message": "field `+"`A.B`"+` is not named in lower_snake_case"
+	}]`, out.String())
+
+	s.ErrorIs(Write(out, diagnostics, "xml"), ErrFormat)
+}
@@ -0,0 +1,363 @@
+// Package plugin runs external generators declared in `protoc.yaml`. Like the
+// plugins of protoc, a plugin is an executable reading a Request as JSON from
+// stdin and writing a Response as JSON to stdout. The types of this package
+// are the protocol, plugins written in Go can import them.
+package plugin
+
+import (
+	"github.com/niiigoo/hawk/kit/generic"
+	kithttp "github.com/niiigoo/hawk/kit/http"
+	"github.com/niiigoo/hawk/proto"
+	"github.com/niiigoo/hawk/proto/io"
+	"sort"
+	"strings"
+)
+
+// Request is passed to a plugin, it contains the parsed proto files.
+type Request struct {
+	// Parameter is the parameter of the plugin configured in `protoc.yaml`
+	Parameter string `json:"parameter,omitempty"`
+	Config    Config `json:"config"`
+	// Files contains a definition per proto file in the order they are
+	// passed to `hawk generate`
+	Files []*Definition `json:"files"`
+}
+
+// Config contains the settings of the generated code, see generic.Config.
+type Config struct {
+	// GoPackage is the import path of the module
+	GoPackage string `json:"goPackage"`
+	// PBPackage is the import path of the compiled proto files
+	PBPackage   string `json:"pbPackage"`
+	Version     string `json:"version,omitempty"`
+	VersionDate string `json:"versionDate,omitempty"`
+	ProtoHash   string `json:"protoHash,omitempty"`
+}
+
+// Definition is a proto file, see proto.Definition.
+type Definition struct {
+	Name    string `json:"name"`
+	Package string `json:"package"`
+	// GoPackage is the import path of the option `go_package`
+	GoPackage string     `json:"goPackage,omitempty"`
+	Services  []*Service `json:"services"`
+	// Messages contains the declared and the imported messages, sorted by
+	// their fully-qualified name
+	Messages []*Message `json:"messages"`
+	// Enums contains the declared and the imported enums, sorted by their
+	// fully-qualified name
+	Enums []*Enum `json:"enums"`
+}
+
+// Service is a service of a proto file, see proto.Service.
+type Service struct {
+	Name        string    `json:"name"`
+	Package     string    `json:"package"`
+	Description string    `json:"description,omitempty"`
+	HttpPrefix  string    `json:"httpPrefix,omitempty"`
+	WSPath      string    `json:"wsPath,omitempty"`
+	Methods     []*Method `json:"methods"`
+	// Routes contains the HTTP bindings in the order they are registered at
+	// the router, see kit/http.Helper.
+	Routes []*Route `json:"routes"`
+}
+
+// Method is an RPC of a service, see proto.Method.
+type Method struct {
+	Name           string  `json:"name"`
+	Description    string  `json:"description,omitempty"`
+	Request        string  `json:"request"`
+	RequestStream  bool    `json:"requestStream,omitempty"`
+	Response       string  `json:"response"`
+	ResponseStream bool    `json:"responseStream,omitempty"`
+	Compressed     bool    `json:"compressed,omitempty"`
+	WebSocket      bool    `json:"webSocket,omitempty"`
+	Deprecated     bool    `json:"deprecated,omitempty"`
+	Http           []*Http `json:"http,omitempty"`
+}
+
+// Http is a binding of `google.api.http`, see proto.OptionHttp.
+type Http struct {
+	Method       string   `json:"method"`
+	Path         string   `json:"path"`
+	Body         string   `json:"body,omitempty"`
+	ResponseBody string   `json:"responseBody,omitempty"`
+	Params       []*Param `json:"params"`
+}
+
+// Param is a field of the request bound to the path, the query or the body.
+type Param struct {
+	Name     string         `json:"name"`
+	Location proto.Location `json:"location"`
+}
+
+// Route is an HTTP binding of a method as served by the generated router,
+// see kit/http.Binding.
+type Route struct {
+	// Method is the name of the RPC
+	Method string `json:"method"`
+	// Label is unique per service, e.g. `GetZero` for the first binding of `Get`
+	Label        string        `json:"label"`
+	Verb         string        `json:"verb"`
+	PathTemplate string        `json:"pathTemplate"`
+	BasePath     string        `json:"basePath"`
+	Fields       []*RouteField `json:"fields"`
+}
+
+// RouteField is a field of the request decoded from the HTTP request, see
+// kit/http.Field.
+type RouteField struct {
+	Name           string `json:"name"`
+	QueryParamName string `json:"queryParamName,omitempty"`
+	JSONName       string `json:"jsonName"`
+	Location       string `json:"location"`
+	GoType         string `json:"goType"`
+	IsBaseType     bool   `json:"isBaseType,omitempty"`
+	IsEnum         bool   `json:"isEnum,omitempty"`
+	Repeated       bool   `json:"repeated,omitempty"`
+	Optional       bool   `json:"optional,omitempty"`
+}
+
+// Message is a message type, see proto.Message.
+type Message struct {
+	// FullName is the fully-qualified name without the leading dot
+	FullName    string   `json:"fullName"`
+	Description string   `json:"description,omitempty"`
+	Deprecated  bool     `json:"deprecated,omitempty"`
+	Fields      []*Field `json:"fields"`
+}
+
+// Field is a field of a message, see proto.Field.
+type Field struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Number      int    `json:"number"`
+	// Type is the scalar (`string`), the map (`map<string, int32>`) or the
+	// referenced type as written in the proto file
+	Type       string            `json:"type"`
+	JSONName   string            `json:"jsonName"`
+	Optional   bool              `json:"optional,omitempty"`
+	Repeated   bool              `json:"repeated,omitempty"`
+	Deprecated bool              `json:"deprecated,omitempty"`
+	OneOf      string            `json:"oneOf,omitempty"`
+	Validation *proto.Validation `json:"validation,omitempty"`
+}
+
+// Enum is an enum type.
+type Enum struct {
+	// FullName is the fully-qualified name without the leading dot
+	FullName    string       `json:"fullName"`
+	Description string       `json:"description,omitempty"`
+	Values      []*EnumValue `json:"values"`
+}
+
+type EnumValue struct {
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+}
+
+// Response is returned by a plugin.
+type Response struct {
+	// Error aborts the generation, nothing is written if it is set
+	Error string `json:"error,omitempty"`
+	// Files are written relative to the output directory of the plugin
+	Files []*File `json:"files"`
+}
+
+type File struct {
+	// Name is the slash-separated path of the file
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// NewRequest converts the definitions of the proto files to a request, names
+// contains the names of the files in the same order.
+func NewRequest(names []string, defs []*proto.Definition, config generic.Config) *Request {
+	req := &Request{
+		Config: Config{
+			GoPackage:   config.GoPackage,
+			PBPackage:   config.PBPackage,
+			Version:     config.Version,
+			VersionDate: config.VersionDate,
+			ProtoHash:   config.ProtoHash,
+		},
+		Files: make([]*Definition, len(defs)),
+	}
+	for i, def := range defs {
+		req.Files[i] = newDefinition(names[i], def)
+	}
+	return req
+}
+
+func newDefinition(name string, def *proto.Definition) *Definition {
+	d := &Definition{
+		Name:     name,
+		Package:  def.Package(),
+		Services: make([]*Service, len(def.Services)),
+		Messages: make([]*Message, 0),
+		Enums:    make([]*Enum, 0),
+	}
+	d.GoPackage, _ = def.GoPackage()
+
+	for i, svc := range def.Services {
+		d.Services[i] = newService(svc)
+	}
+
+	// the messages are accessible by their relative name as well
+	added := map[*proto.Message]bool{}
+	for _, msg := range def.Messages {
+		if !added[msg] {
+			added[msg] = true
+			d.Messages = append(d.Messages, newMessage(msg))
+		}
+	}
+	sort.Slice(d.Messages, func(i, j int) bool {
+		return d.Messages[i].FullName < d.Messages[j].FullName
+	})
+
+	// the fully-qualified name is the longest one of an enum
+	enums := map[*io.Enum]string{}
+	for name, enum := range def.EnumsMap() {
+		if len(name) > len(enums[enum]) {
+			enums[enum] = name
+		}
+	}
+	for enum, name := range enums {
+		d.Enums = append(d.Enums, newEnum(name, enum))
+	}
+	sort.Slice(d.Enums, func(i, j int) bool {
+		return d.Enums[i].FullName < d.Enums[j].FullName
+	})
+	return d
+}
+
+func newService(svc *proto.Service) *Service {
+	s := &Service{
+		Name:        svc.Name,
+		Package:     svc.Package,
+		Description: svc.Description,
+		HttpPrefix:  svc.HttpPrefix,
+		WSPath:      svc.WSPath,
+		Methods:     make([]*Method, len(svc.Methods)),
+		Routes:      make([]*Route, 0),
+	}
+	for i, m := range svc.Methods {
+		s.Methods[i] = newMethod(m)
+	}
+	for _, binding := range kithttp.NewHelper(svc).Routes() {
+		s.Routes = append(s.Routes, newRoute(binding))
+	}
+	return s
+}
+
+func newMethod(m *proto.Method) *Method {
+	method := &Method{
+		Name:           m.Name,
+		Request:        m.Request,
+		RequestStream:  m.RequestStream,
+		Response:       m.Response,
+		ResponseStream: m.ResponseStream,
+		Compressed:     m.Compressed,
+		WebSocket:      m.WebSocket,
+		Deprecated:     m.Deprecated,
+	}
+	if m.Method != nil {
+		method.Description = description(m.Method.Comments)
+	}
+	for _, binding := range m.HttpBindings {
+		h := &Http{
+			Method:       binding.Method,
+			Path:         binding.PathRaw,
+			Body:         binding.Body,
+			ResponseBody: binding.ResponseBody,
+			Params:       make([]*Param, len(binding.Params)),
+		}
+		for i, p := range binding.Params {
+			h.Params[i] = &Param{Name: p.Name, Location: p.Location}
+		}
+		method.Http = append(method.Http, h)
+	}
+	return method
+}
+
+func newRoute(binding *kithttp.Binding) *Route {
+	r := &Route{
+		Method:       binding.Parent.Name,
+		Label:        binding.Label,
+		Verb:         binding.Method,
+		PathTemplate: binding.PathTemplate,
+		BasePath:     binding.BasePath,
+		Fields:       make([]*RouteField, len(binding.Fields)),
+	}
+	for i, f := range binding.Fields {
+		r.Fields[i] = &RouteField{
+			Name:           f.Name,
+			QueryParamName: f.QueryParamName,
+			JSONName:       f.LowCamelName,
+			Location:       f.Location,
+			GoType:         f.GoType,
+			IsBaseType:     f.IsBaseType,
+			IsEnum:         f.IsEnum,
+			Repeated:       f.Repeated,
+			Optional:       f.IsOptional,
+		}
+	}
+	return r
+}
+
+func newMessage(msg *proto.Message) *Message {
+	m := &Message{
+		FullName:    msg.FullName,
+		Description: description(msg.Comments),
+		Deprecated:  msg.Deprecated,
+		Fields:      make([]*Field, len(msg.Fields)),
+	}
+	for i, f := range msg.Fields {
+		m.Fields[i] = &Field{
+			Name:        f.Name,
+			Description: description(f.Comments),
+			Number:      f.Tag,
+			Type:        typeName(&f.Type),
+			JSONName:    f.JSONName,
+			Optional:    f.Optional,
+			Repeated:    f.Repeated,
+			Deprecated:  f.Deprecated,
+			OneOf:       f.OneOf,
+			Validation:  f.Validation,
+		}
+	}
+	return m
+}
+
+func newEnum(name string, enum *io.Enum) *Enum {
+	e := &Enum{
+		FullName:    name,
+		Description: description(enum.Comments),
+		Values:      make([]*EnumValue, 0, len(enum.Values)),
+	}
+	for _, entry := range enum.Values {
+		if entry.Value != nil {
+			e.Values = append(e.Values, &EnumValue{Name: entry.Value.Key, Number: entry.Value.Value})
+		}
+	}
+	return e
+}
+
+// typeName returns the type as written in the proto file.
+func typeName(t *io.Type) string {
+	if t.Scalar != io.None {
+		return t.Scalar.GoString()
+	} else if t.Map != nil {
+		return "map<" + typeName(t.Map.Key) + ", " + typeName(t.Map.Value) + ">"
+	}
+	return strings.TrimPrefix(t.Reference, ".")
+}
+
+// description joins the comment lines without the comment markers.
+func description(comments []string) string {
+	lines := make([]string, len(comments))
+	for i, c := range comments {
+		lines[i] = strings.Trim(c, "/* ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,178 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/niiigoo/hawk/kit/generic"
+	"github.com/niiigoo/hawk/proto"
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"testing"
+)
+
+// TestMain makes the test binary act as plugin if HAWK_TEST_PLUGIN is set, it
+// returns a file listing the routes of the request.
+func TestMain(m *testing.M) {
+	switch os.Getenv("HAWK_TEST_PLUGIN") {
+	case "":
+		os.Exit(m.Run())
+	case "error":
+		_ = json.NewEncoder(os.Stdout).Encode(Response{Error: "unsupported"})
+	case "escape":
+		_ = json.NewEncoder(os.Stdout).Encode(Response{Files: []*File{{Name: "../outside.txt"}}})
+	default:
+		var req Request
+		if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+			os.Exit(1)
+		}
+		content := req.Parameter + "\n"
+		for _, route := range req.Files[0].Services[0].Routes {
+			content += fmt.Sprintf("%s %s %s\n", route.Method, route.Verb, route.PathTemplate)
+		}
+		_ = json.NewEncoder(os.Stdout).Encode(Response{Files: []*File{{Name: "routes.txt", Content: content}}})
+	}
+	os.Exit(0)
+}
+
+type PluginTestSuite struct {
+	suite.Suite
+}
+
+func TestPluginTestSuite(t *testing.T) {
+	suite.Run(t, new(PluginTestSuite))
+}
+
+const pluginProto = `syntax = "proto3";
+package test;
+option go_package = "example.com/test/pb";
+// A request
+message Request {
+  // the id
+  string id = 1;
+  map<string, int32> counts = 2;
+  Kind kind = 3;
+  enum Kind {
+    A = 0;
+    B = 1;
+  }
+}
+// The service
+service Test {
+  // Get returns the entity
+  rpc Get(Request) returns (Request) {
+    option (google.api.http) = {
+      get: "/entity/{id}"
+    };
+  }
+  rpc Watch(Request) returns (stream Request) {}
+}
+`
+
+func (s *PluginTestSuite) request() *Request {
+	p, err := io.ParseString("test.proto", pluginProto, true)
+	s.Require().NoError(err)
+	def, err := proto.DefinitionFromProto(p)
+	s.Require().NoError(err)
+
+	return NewRequest([]string{"test.proto"}, []*proto.Definition{def}, generic.Config{
+		GoPackage: "example.com/test",
+		PBPackage: "example.com/test/pb",
+	})
+}
+
+func (s *PluginTestSuite) TestNewRequest() {
+	req := s.request()
+
+	s.Equal(Config{GoPackage: "example.com/test", PBPackage: "example.com/test/pb"}, req.Config)
+	s.Require().Len(req.Files, 1)
+	def := req.Files[0]
+	s.Equal("test.proto", def.Name)
+	s.Equal("test", def.Package)
+	s.Equal("example.com/test/pb", def.GoPackage)
+
+	s.Require().Len(def.Messages, 1)
+	msg := def.Messages[0]
+	s.Equal("test.Request", msg.FullName)
+	s.Equal("A request", msg.Description)
+	s.Require().Len(msg.Fields, 3)
+	s.Equal(&Field{Name: "id", Description: "the id", Number: 1, Type: "string", JSONName: "id"}, msg.Fields[0])
+	s.Equal("map<string, int32>", msg.Fields[1].Type)
+	s.Equal("Kind", msg.Fields[2].Type)
+
+	s.Equal([]*Enum{{FullName: "test.Request.Kind", Values: []*EnumValue{{Name: "A"}, {Name: "B", Number: 1}}}}, def.Enums)
+
+	s.Require().Len(def.Services, 1)
+	svc := def.Services[0]
+	s.Equal("Test", svc.Name)
+	s.Equal("The service", svc.Description)
+	s.Require().Len(svc.Methods, 2)
+	s.Equal("Get returns the entity", svc.Methods[0].Description)
+	s.Require().Len(svc.Methods[0].Http, 1)
+	s.Equal("get", svc.Methods[0].Http[0].Method)
+	s.Equal("/entity/{id}", svc.Methods[0].Http[0].Path)
+	s.Equal([]*Param{
+		{Name: "id", Location: proto.LocationPath},
+		{Name: "counts", Location: proto.LocationQuery},
+		{Name: "kind", Location: proto.LocationQuery},
+	}, svc.Methods[0].Http[0].Params)
+	s.True(svc.Methods[1].ResponseStream)
+
+	s.Require().Len(svc.Routes, 1)
+	route := svc.Routes[0]
+	s.Equal("Get", route.Method)
+	s.Equal("GetZero", route.Label)
+	s.Equal("get", route.Verb)
+	s.Equal("/entity/{id}", route.PathTemplate)
+	s.Equal("/entity/", route.BasePath)
+
+	// the request is serializable, the model of hawk is cyclic
+	_, err := json.Marshal(req)
+	s.NoError(err)
+}
+
+func (s *PluginTestSuite) config(mode string) proto.PluginConfig {
+	s.T().Setenv("HAWK_TEST_PLUGIN", mode)
+	return proto.PluginConfig{Command: os.Args[0], Parameter: "param", Out: "docs"}
+}
+
+func (s *PluginTestSuite) TestRun() {
+	files, err := Run(s.T().TempDir(), s.config("routes"), s.request())
+	s.Require().NoError(err)
+	s.Equal(map[string][]byte{"docs/routes.txt": []byte("param\nGet get /entity/{id}\n")}, files)
+}
+
+func (s *PluginTestSuite) TestRun_Error() {
+	config := s.config("error")
+	config.Name = "sdk"
+	_, err := Run(s.T().TempDir(), config, s.request())
+	s.EqualError(err, "plugin 'sdk' failed: unsupported")
+}
+
+func (s *PluginTestSuite) TestRun_Escape() {
+	config := s.config("escape")
+	config.Out = ""
+	_, err := Run(s.T().TempDir(), config, s.request())
+	s.ErrorContains(err, "invalid file name '../outside.txt'")
+}
+
+func (s *PluginTestSuite) TestFilePath() {
+	for _, tc := range []struct {
+		out, name, file string
+	}{
+		{".", "a/b.go", "a/b.go"},
+		{"docs", "api.md", "docs/api.md"},
+		{"docs", "../api.md", "api.md"},
+		{"docs", "../../api.md", ""},
+		{".", "/etc/passwd", ""},
+		{".", "", ""},
+	} {
+		file, err := filePath(tc.out, tc.name)
+		if tc.file == "" {
+			s.Error(err, tc.name)
+		} else {
+			s.NoError(err, tc.name)
+			s.Equal(tc.file, file)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/niiigoo/hawk/proto"
+	"github.com/pkg/errors"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Name returns the name of the plugin, the base name of its command if no
+// name is configured.
+func Name(config proto.PluginConfig) string {
+	if config.Name != "" {
+		return config.Name
+	}
+	return filepath.Base(config.Command)
+}
+
+// Run runs the plugin in dir, the project root, and returns its files by
+// their slash-separated path relative to dir. The output of the plugin on
+// stderr is passed through.
+func Run(dir string, config proto.PluginConfig, request *Request) (map[string][]byte, error) {
+	if config.Command == "" {
+		return nil, errors.Errorf("plugin '%s': no command configured", config.Name)
+	}
+	name := Name(config)
+
+	req := *request
+	req.Parameter = config.Parameter
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	cmd := exec.Command(os.ExpandEnv(config.Command), config.Args...)
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "plugin '%s' failed", name)
+	}
+
+	var res Response
+	if err = json.Unmarshal(out.Bytes(), &res); err != nil {
+		return nil, errors.Wrapf(err, "plugin '%s' returned an invalid response", name)
+	}
+	if res.Error != "" {
+		return nil, errors.Errorf("plugin '%s' failed: %s", name, res.Error)
+	}
+
+	outDir := path.Clean(filepath.ToSlash(config.Out))
+	files := make(map[string][]byte, len(res.Files))
+	for _, f := range res.Files {
+		file, err := filePath(outDir, f.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "plugin '%s'", name)
+		}
+		if _, ok := files[file]; ok {
+			return nil, errors.Errorf("plugin '%s' returned the file '%s' twice", name, file)
+		}
+		files[file] = []byte(f.Content)
+	}
+	return files, nil
+}
+
+// filePath returns the path of the file name in the directory out, both must
+// be relative and must not leave the project root.
+func filePath(out, name string) (string, error) {
+	file := path.Join(out, name)
+	if name == "" || path.IsAbs(name) || path.IsAbs(out) || file == "." || file == ".." || strings.HasPrefix(file, "../") {
+		return "", errors.Errorf("invalid file name '%s', it must be a relative path within the project", name)
+	}
+	return file, nil
+}
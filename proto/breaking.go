@@ -0,0 +1,222 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"slices"
+	"strconv"
+)
+
+// BreakingChanges compares two revisions of a definition and reports the
+// changes breaking existing clients, either on the wire (gRPC) or on the
+// generated HTTP routes. Changes of the new revision are reported at their
+// position in the new file, removed elements at their position in the old one.
+func BreakingChanges(old, new *Definition) Diagnostics {
+	diagnostics := make(Diagnostics, 0)
+
+	for _, name := range messageNames(old) {
+		oldMsg := old.Messages[name]
+		newMsg, ok := new.Messages[name]
+		if !ok {
+			continue
+		}
+		compareMessages(&diagnostics, old, new, oldMsg, newMsg)
+	}
+
+	for _, oldSrv := range old.Services {
+		i := slices.IndexFunc(new.Services, func(s *Service) bool {
+			return s.Name == oldSrv.Name
+		})
+		if i < 0 {
+			diagnostics.Errorf(oldSrv.Pos, "service `%s` removed", oldSrv.Name)
+			continue
+		}
+		compareServices(&diagnostics, old, new, oldSrv, new.Services[i])
+	}
+
+	return diagnostics
+}
+
+// messageNames returns the fully-qualified names of all messages, sorted.
+func messageNames(d *Definition) []string {
+	names := make([]string, 0, len(d.Messages))
+	for name, msg := range d.Messages {
+		if name == msg.FullName {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func compareMessages(diagnostics *Diagnostics, old, new *Definition, oldMsg, newMsg *Message) {
+	byTag := make(map[int]*Field)
+	byName := make(map[string]*Field)
+	for _, f := range newMsg.Fields {
+		byTag[f.Tag] = f
+		byName[f.Name] = f
+	}
+	oldTags := make(map[int]bool)
+
+	for _, oldField := range oldMsg.Fields {
+		oldTags[oldField.Tag] = true
+		newField, ok := byTag[oldField.Tag]
+		if !ok {
+			if f, renamed := byName[oldField.Name]; renamed {
+				diagnostics.Errorf(f.Pos, "tag of field `%s.%s` changed from %d to %d", newMsg.FullName, f.Name, oldField.Tag, f.Tag)
+			} else if !reservedTag(newMsg.Message, oldField.Tag) {
+				diagnostics.Errorf(oldField.Pos, "field `%s.%s` (%d) removed without reserving its tag", oldMsg.FullName, oldField.Name, oldField.Tag)
+			}
+			continue
+		}
+
+		if newField.Name != oldField.Name {
+			diagnostics.Errorf(newField.Pos, "tag %d of `%s` is used by `%s` instead of `%s`", newField.Tag, newMsg.FullName, newField.Name, oldField.Name)
+		}
+		oldType := old.fieldType(oldMsg.FullName, oldField.Field)
+		newType := new.fieldType(newMsg.FullName, newField.Field)
+		if oldType != newType {
+			diagnostics.Errorf(newField.Pos, "type of field `%s.%s` changed from `%s` to `%s`", newMsg.FullName, newField.Name, oldType, newType)
+		}
+		compareOneOf(diagnostics, newMsg.FullName, oldField, newField)
+	}
+
+	for _, newField := range newMsg.Fields {
+		if !oldTags[newField.Tag] && reservedTag(oldMsg.Message, newField.Tag) {
+			diagnostics.Errorf(newField.Pos, "field `%s.%s` reuses the reserved tag %d", newMsg.FullName, newField.Name, newField.Tag)
+		}
+	}
+}
+
+// compareOneOf reports a field moved into, out of or between oneofs. Setting
+// such a field clears the other fields of the oneof.
+func compareOneOf(diagnostics *Diagnostics, msg string, oldField, newField *Field) {
+	switch {
+	case oldField.OneOf == newField.OneOf:
+	case oldField.OneOf == "":
+		diagnostics.Errorf(newField.Pos, "field `%s.%s` moved into oneof `%s`", msg, newField.Name, newField.OneOf)
+	case newField.OneOf == "":
+		diagnostics.Errorf(newField.Pos, "field `%s.%s` moved out of oneof `%s`", msg, newField.Name, oldField.OneOf)
+	default:
+		diagnostics.Errorf(newField.Pos, "field `%s.%s` moved from oneof `%s` to `%s`", msg, newField.Name, oldField.OneOf, newField.OneOf)
+	}
+}
+
+// fieldType returns the type of the field as it is declared, referenced types
+// are fully-qualified.
+func (d *Definition) fieldType(scope string, field *io.Field) string {
+	t := d.typeName(scope, &field.Type)
+	if field.Repeated {
+		t = "repeated " + t
+	}
+	return t
+}
+
+func (d *Definition) typeName(scope string, t *io.Type) string {
+	if t.Scalar != io.None {
+		return t.Scalar.GoString()
+	} else if t.Map != nil {
+		return "map<" + d.typeName(scope, t.Map.Key) + ", " + d.typeName(scope, t.Map.Value) + ">"
+	} else if sym := d.Resolve(scope, t.Reference); sym != nil {
+		return sym.FullName
+	}
+	return t.Reference
+}
+
+// reservedTag checks whether the tag is part of the reserved ranges of the message.
+func reservedTag(msg *io.Message, tag int) bool {
+	for _, entry := range msg.Entries {
+		if entry.Reserved == nil {
+			continue
+		}
+		for _, r := range entry.Reserved.Reserved {
+			if r.Ident != "" {
+				continue
+			}
+			if r.Start == tag || r.Max && tag >= r.Start || r.End != nil && tag >= r.Start && tag <= *r.End {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func compareServices(diagnostics *Diagnostics, old, new *Definition, oldSrv, newSrv *Service) {
+	if oldSrv.HttpPrefix != newSrv.HttpPrefix {
+		diagnostics.Errorf(newSrv.Pos, "HttpPrefix of service `%s` changed from `%s` to `%s`", newSrv.Name, oldSrv.HttpPrefix, newSrv.HttpPrefix)
+	}
+	if oldSrv.WSPath != newSrv.WSPath {
+		diagnostics.Errorf(newSrv.Pos, "WebSocketPath of service `%s` changed from `%s` to `%s`", newSrv.Name, oldSrv.WSPath, newSrv.WSPath)
+	}
+
+	for _, oldMethod := range oldSrv.Methods {
+		i := slices.IndexFunc(newSrv.Methods, func(m *Method) bool {
+			return m.Name == oldMethod.Name
+		})
+		if i < 0 {
+			diagnostics.Errorf(oldMethod.Pos, "rpc `%s.%s` removed or renamed", oldSrv.Name, oldMethod.Name)
+			continue
+		}
+		newMethod := newSrv.Methods[i]
+		name := newSrv.Name + "." + newMethod.Name
+
+		oldRequest, newRequest := old.qualify(oldMethod.Request), new.qualify(newMethod.Request)
+		if oldRequest != newRequest || oldMethod.RequestStream != newMethod.RequestStream {
+			diagnostics.Errorf(newMethod.Pos, "request of rpc `%s` changed from `%s` to `%s`", name,
+				streamType(oldRequest, oldMethod.RequestStream), streamType(newRequest, newMethod.RequestStream))
+		}
+		oldResponse, newResponse := old.qualify(oldMethod.Response), new.qualify(newMethod.Response)
+		if oldResponse != newResponse || oldMethod.ResponseStream != newMethod.ResponseStream {
+			diagnostics.Errorf(newMethod.Pos, "response of rpc `%s` changed from `%s` to `%s`", name,
+				streamType(oldResponse, oldMethod.ResponseStream), streamType(newResponse, newMethod.ResponseStream))
+		}
+
+		compareBindings(diagnostics, name, oldMethod, newMethod)
+	}
+}
+
+// compareBindings checks that all http bindings of the old method are still
+// available with the same body. Bindings are identified by the http method
+// and the path template, a moved path parameter changes the template.
+func compareBindings(diagnostics *Diagnostics, name string, oldMethod, newMethod *Method) {
+	for _, oldBinding := range oldMethod.HttpBindings {
+		i := slices.IndexFunc(newMethod.HttpBindings, func(b *OptionHttp) bool {
+			return b.Method == oldBinding.Method && b.PathRaw == oldBinding.PathRaw
+		})
+		if i < 0 {
+			pos := oldBinding.Pos
+			if len(newMethod.HttpBindings) > 0 {
+				pos = newMethod.HttpBindings[0].Pos
+			}
+			diagnostics.Errorf(pos, "http binding `%s` of rpc `%s` removed or changed", bindingName(oldBinding), name)
+			continue
+		}
+		newBinding := newMethod.HttpBindings[i]
+		if oldBinding.Body != newBinding.Body {
+			diagnostics.Errorf(newBinding.Pos, "body of http binding `%s` of rpc `%s` changed from %s to %s", bindingName(newBinding), name,
+				strconv.Quote(oldBinding.Body), strconv.Quote(newBinding.Body))
+		}
+		if oldBinding.ResponseBody != newBinding.ResponseBody {
+			diagnostics.Errorf(newBinding.Pos, "response_body of http binding `%s` of rpc `%s` changed from %s to %s", bindingName(newBinding), name,
+				strconv.Quote(oldBinding.ResponseBody), strconv.Quote(newBinding.ResponseBody))
+		}
+	}
+}
+
+func bindingName(b *OptionHttp) string {
+	return b.Method + " " + b.PathRaw
+}
+
+// qualify returns the fully-qualified name of a message referenced by a method.
+func (d *Definition) qualify(ref string) string {
+	if sym := d.Resolve(d.pack, ref); sym != nil {
+		return sym.FullName
+	}
+	return ref
+}
+
+func streamType(name string, stream bool) string {
+	if stream {
+		return "stream " + name
+	}
+	return name
+}
@@ -0,0 +1,284 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type BreakingTestSuite struct {
+	suite.Suite
+}
+
+func TestBreakingTestSuite(t *testing.T) {
+	suite.Run(t, new(BreakingTestSuite))
+}
+
+const breakingBase = `
+syntax = "proto3";
+package test;
+message Request {
+	string id = 1;
+	int32 count = 2;
+	Nested nested = 3;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/entity/{id}"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/entity/{id}"
+			body: "*"
+		};
+	}
+}
+`
+
+func (s *BreakingTestSuite) definition(filename, data string) *Definition {
+	p, err := io.ParseString(filename, data, false)
+	s.Require().NoError(err)
+	def, err := DefinitionFromProto(p)
+	s.Require().NoError(err)
+	return def
+}
+
+func (s *BreakingTestSuite) changes(data string) []string {
+	diagnostics := BreakingChanges(s.definition("old.proto", breakingBase), s.definition("new.proto", data))
+	messages := make([]string, len(diagnostics))
+	for i, d := range diagnostics {
+		messages[i] = d.Error()
+	}
+	return messages
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_None() {
+	s.Empty(s.changes(breakingBase))
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_Fields() {
+	s.Equal([]string{
+		"new.proto:5:2: type of field `test.Request.id` changed from `string` to `int64`",
+		"old.proto:6:2: field `test.Request.count` (2) removed without reserving its tag",
+		"new.proto:6:2: tag of field `test.Request.nested` changed from 3 to 4",
+	}, s.changes(`
+syntax = "proto3";
+package test;
+message Request {
+	int64 id = 1;
+	Nested nested = 4;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/entity/{id}"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/entity/{id}"
+			body: "*"
+		};
+	}
+}
+`))
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_Reserved() {
+	s.Empty(s.changes(`
+syntax = "proto3";
+package test;
+message Request {
+	reserved 2;
+	string id = 1;
+	test.Request.Nested nested = 3;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/entity/{id}"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/entity/{id}"
+			body: "*"
+		};
+	}
+}
+`))
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_OneOf() {
+	s.Equal([]string{
+		"new.proto:7:3: field `test.Request.count` moved into oneof `limit`",
+	}, s.changes(`
+syntax = "proto3";
+package test;
+message Request {
+	string id = 1;
+	oneof limit {
+		int32 count = 2;
+	}
+	Nested nested = 3;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/entity/{id}"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/entity/{id}"
+			body: "*"
+		};
+	}
+}
+`))
+
+	old := s.definition("old.proto", `
+syntax = "proto3";
+package test;
+message Request {
+	oneof key {
+		string id = 1;
+		int32 count = 2;
+	}
+}
+`)
+	diagnostics := BreakingChanges(old, s.definition("new.proto", `
+syntax = "proto3";
+package test;
+message Request {
+	string id = 1;
+	oneof filter {
+		int32 count = 2;
+	}
+}
+`))
+	s.Require().Len(diagnostics, 2)
+	s.Equal("new.proto:5:2: field `test.Request.id` moved out of oneof `key`", diagnostics[0].Error())
+	s.Equal("new.proto:7:3: field `test.Request.count` moved from oneof `key` to `filter`", diagnostics[1].Error())
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_Renamed() {
+	s.Equal([]string{
+		"new.proto:5:2: tag 1 of `test.Request` is used by `key` instead of `id`",
+	}, s.changes(`
+syntax = "proto3";
+package test;
+message Request {
+	string key = 1;
+	int32 count = 2;
+	Nested nested = 3;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/entity/{key}"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/entity/{key}"
+			body: "*"
+		};
+	}
+}
+`)[:1])
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_Service() {
+	s.Equal([]string{
+		"new.proto:11:1: HttpPrefix of service `Test` changed from `/api` to `/api/v2`",
+		"new.proto:11:1: WebSocketPath of service `Test` changed from `/api/ws` to `/api/v2/ws`",
+		"new.proto:16:2: response of rpc `Test.Get` changed from `test.Response` to `stream test.Response`",
+		"old.proto:17:10: http binding `get /entity/{id}` of rpc `Test.Get` removed or changed",
+		"old.proto:21:2: rpc `Test.Update` removed or renamed",
+	}, s.changes(`
+syntax = "proto3";
+package test;
+message Request {
+	string id = 1;
+	int32 count = 2;
+	Nested nested = 3;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api/v2"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (stream Response);
+	rpc Put(Request) returns (Response);
+}
+`))
+}
+
+func (s *BreakingTestSuite) TestBreakingChanges_Bindings() {
+	s.Equal([]string{
+		"new.proto:17:10: http binding `get /entity/{id}` of rpc `Test.Get` removed or changed",
+		"new.proto:22:10: body of http binding `put /entity/{id}` of rpc `Test.Update` changed from \"*\" to \"nested\"",
+	}, s.changes(`
+syntax = "proto3";
+package test;
+message Request {
+	string id = 1;
+	int32 count = 2;
+	Nested nested = 3;
+	message Nested {}
+}
+message Response {}
+service Test {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketPath: "/ws"
+	};
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/entity"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/entity/{id}"
+			body: "nested"
+		};
+	}
+}
+`))
+}
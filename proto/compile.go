@@ -0,0 +1,191 @@
+package proto
+
+import (
+	"context"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompilerProtoc is the value of `compiler` in `protoc.yaml` to compile the
+// proto file with the external protoc binary instead of the built-in compiler.
+const CompilerProtoc = "protoc"
+
+// Compile compiles the file and writes the generated `.pb.go` and
+// `_grpc.pb.go` files to out, like `protoc --go_out=out --go-grpc_out=out`
+// does. The file is located relative to the include paths, the directory
+// of the file is used if it is not located in one of them. Errors in the
+// proto files are returned as Diagnostics.
+func Compile(file, out string, includes ...string) error {
+	return compile(file, out, "", includes)
+}
+
+// CompileSourceRelative is like Compile, but the generated files are written
+// to out relative to the proto file instead of the Go import path, like
+// `protoc --go_opt=paths=source_relative` does.
+func CompileSourceRelative(file, out string, includes ...string) error {
+	return compile(file, out, "paths=source_relative", includes)
+}
+
+// CompilePackage is like Compile, but the generated files are written to out,
+// the directory of the Go package importPath, like
+// `protoc --go_opt=module=IMPORT_PATH` does. importPath replaces the import
+// path of the option `go_package`, e.g. to resolve a relative one. Unlike
+// CompileSourceRelative, the directory of the file is not repeated in out.
+func CompilePackage(file, out, importPath string, includes ...string) error {
+	_, name := compileIncludes(file, includes)
+	return compile(file, out, strings.Join(packageParameters(name, importPath), ","), includes)
+}
+
+// packageParameters returns the parameters of the generators to write the
+// code of the file name to the directory of the Go package importPath.
+func packageParameters(name, importPath string) []string {
+	return []string{"M" + name + "=" + importPath, "module=" + importPath}
+}
+
+// compile compiles file and runs the generators with the given parameter.
+func compile(file, out, parameter string, includes []string) error {
+	includes, name := compileIncludes(file, includes)
+
+	diagnostics := Diagnostics{}
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: includes,
+		}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+		Reporter: reporter.NewReporter(func(err reporter.ErrorWithPos) error {
+			diagnostics.Errorf(compilePosition(err.GetPosition(), includes), "%s", err.Unwrap())
+			return nil
+		}, func(err reporter.ErrorWithPos) {
+			diagnostics.Warnf(compilePosition(err.GetPosition(), includes), "%s", err.Unwrap())
+		}),
+	}
+
+	files, err := compiler.Compile(context.Background(), name)
+	var pos reporter.ErrorWithPos
+	if err != nil && errors.As(err, &pos) && !diagnostics.HasErrors() {
+		// imports which cannot be resolved are not passed to the reporter
+		diagnostics.Errorf(compilePosition(pos.GetPosition(), includes), "%s", pos.Unwrap())
+	}
+	if diagnostics.HasErrors() {
+		return diagnostics.Err()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to compile '%s'", file)
+	}
+
+	return generate(files[0], out, parameter)
+}
+
+// compileIncludes expands the include paths and returns them with the name of
+// file relative to the first include path containing it.
+func compileIncludes(file string, includes []string) ([]string, string) {
+	abs, _ := filepath.Abs(file)
+
+	res := make([]string, 0, len(includes)+1)
+	name := ""
+	for _, include := range includes {
+		include = os.ExpandEnv(include)
+		res = append(res, include)
+		if name != "" {
+			continue
+		}
+		dir, _ := filepath.Abs(include)
+		if rel, err := filepath.Rel(dir, abs); err == nil && !strings.HasPrefix(rel, "..") {
+			name = filepath.ToSlash(rel)
+		}
+	}
+	if name == "" {
+		res = append([]string{filepath.Dir(file)}, res...)
+		name = filepath.Base(file)
+	}
+
+	return res, name
+}
+
+// compilePosition converts the position reported by the compiler, the
+// filename is resolved to the file found in the include paths.
+func compilePosition(pos ast.SourcePos, includes []string) lexer.Position {
+	filename := pos.Filename
+	for _, include := range includes {
+		path := filepath.Join(include, pos.Filename)
+		if _, err := os.Stat(path); err == nil {
+			filename = path
+			break
+		}
+	}
+	return lexer.Position{Filename: filename, Line: pos.Line, Column: pos.Col}
+}
+
+// generate runs the Go and gRPC code generators for file, the same way protoc
+// runs protoc-gen-go and protoc-gen-go-grpc with the given parameter. The
+// output is compared against proto/testdata/golden, see TestCompile_Golden.
+func generate(file linker.File, out, parameter string) error {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      &parameter,
+		ProtoFile:      fileDescriptorProtos(file, map[string]bool{}),
+	}
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate code for '%s'", file.Path())
+	}
+	plugin.SupportedFeatures = internal_gengo.SupportedFeatures
+	plugin.SupportedEditionsMinimum = internal_gengo.SupportedEditionsMinimum
+	plugin.SupportedEditionsMaximum = internal_gengo.SupportedEditionsMaximum
+
+	for _, f := range plugin.Files {
+		if f.Generate {
+			internal_gengo.GenerateFile(plugin, f)
+			generateGRPC(plugin, f)
+		}
+	}
+
+	res := plugin.Response()
+	if res.Error != nil {
+		return errors.Errorf("failed to generate code for '%s': %s", file.Path(), res.GetError())
+	}
+	for _, f := range res.File {
+		path := filepath.Join(out, f.GetName())
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err = os.WriteFile(path, []byte(f.GetContent()), 0666); err != nil {
+			return errors.Wrapf(err, "failed to write file '%s'", path)
+		}
+	}
+
+	return nil
+}
+
+// fileDescriptorProtos returns the descriptors of file and all its imports,
+// dependencies first as required by protogen.
+func fileDescriptorProtos(file protoreflect.FileDescriptor, visited map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if visited[file.Path()] {
+		return nil
+	}
+	visited[file.Path()] = true
+
+	res := make([]*descriptorpb.FileDescriptorProto, 0)
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		res = append(res, fileDescriptorProtos(imports.Get(i).FileDescriptor, visited)...)
+	}
+
+	if r, ok := file.(linker.Result); ok {
+		return append(res, r.FileDescriptorProto())
+	}
+	return append(res, protodesc.ToFileDescriptorProto(file))
+}
@@ -0,0 +1,238 @@
+package proto
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type CompileTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestCompileTestSuite(t *testing.T) {
+	suite.Run(t, new(CompileTestSuite))
+}
+
+func (s *CompileTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *CompileTestSuite) writeFile(name, content string) string {
+	file := filepath.Join(s.dir, name)
+	s.Require().NoError(os.MkdirAll(filepath.Dir(file), 0777))
+	s.Require().NoError(os.WriteFile(file, []byte(content), 0666))
+	return file
+}
+
+func (s *CompileTestSuite) readFile(name string) string {
+	data, err := os.ReadFile(filepath.Join(s.dir, name))
+	s.Require().NoError(err)
+	return string(data)
+}
+
+// TestCompile_Golden compares the code generated for testdata/golden with the
+// checked in files, they are regenerated if the environment variable
+// HAWK_GENERATE is set. A difference shows a change of the generators run
+// in-process, e.g. by an update of google.golang.org/protobuf.
+func (s *CompileTestSuite) TestCompile_Golden() {
+	dir := filepath.Join("testdata", "golden")
+	s.Require().NoError(CompileSourceRelative(filepath.Join(dir, "greeter.proto"), s.dir, dir))
+
+	for _, name := range []string{"greeter.pb.go", "greeter_grpc.pb.go"} {
+		generated := s.readFile(name)
+		golden := filepath.Join(dir, name)
+		if os.Getenv("HAWK_GENERATE") != "" {
+			s.Require().NoError(os.WriteFile(golden, []byte(generated), 0666))
+		}
+		current, err := os.ReadFile(golden)
+		s.Require().NoError(err)
+		s.Equal(string(current), generated, "%s is outdated, run the test with HAWK_GENERATE=1", golden)
+	}
+}
+
+func (s *CompileTestSuite) TestCompile() {
+	s.writeFile("common/common.proto", `syntax = "proto3";
+package common;
+option go_package = "example.com/test/common";
+message Empty {}
+`)
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+option go_package = ".;test";
+import "common/common.proto";
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.ServiceOptions {
+  optional string prefix = 10000;
+}
+
+message Request {
+  string id = 1;
+}
+
+// The service
+service Test {
+  option (prefix) = "/api";
+  rpc Get(Request) returns (common.Empty);
+  rpc Watch(Request) returns (stream common.Empty);
+}
+`)
+
+	s.Require().NoError(Compile(file, s.dir, s.dir))
+
+	pb := s.readFile("test.pb.go")
+	s.Contains(pb, "package test")
+	s.Contains(pb, "type Request struct")
+	s.Contains(pb, `common "example.com/test/common"`)
+
+	grpc := s.readFile("test_grpc.pb.go")
+	s.Contains(grpc, "type TestServer interface")
+	s.Contains(grpc, "Get(context.Context, *Request) (*common.Empty, error)")
+	s.Contains(grpc, "Watch(*Request, grpc.ServerStreamingServer[common.Empty]) error")
+	s.Contains(grpc, "type UnimplementedTestServer struct")
+	s.Contains(grpc, "func RegisterTestServer(")
+	s.Contains(grpc, "// The service")
+}
+
+func (s *CompileTestSuite) TestCompileSourceRelative() {
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+option go_package = "example.com/test/api/v1;apiv1";
+
+message Request {
+  string id = 1;
+}
+
+service Test {
+  rpc Get(Request) returns (Request);
+}
+`)
+
+	out := filepath.Join(s.dir, "api", "v1")
+	s.Require().NoError(CompileSourceRelative(file, out, s.dir))
+
+	s.Contains(s.readFile("api/v1/test.pb.go"), "package apiv1")
+	s.Contains(s.readFile("api/v1/test_grpc.pb.go"), "type TestServer interface")
+}
+
+func (s *CompileTestSuite) TestCompilePackage() {
+	file := s.writeFile("api/v1/test.proto", `syntax = "proto3";
+package test;
+option go_package = "./api/v1;apiv1";
+
+message Request {
+  string id = 1;
+}
+
+service Test {
+  rpc Get(Request) returns (Request);
+}
+`)
+
+	out := filepath.Join(s.dir, "api", "v1")
+	s.Require().NoError(CompilePackage(file, out, "example.com/test/api/v1", s.dir))
+
+	s.Contains(s.readFile("api/v1/test.pb.go"), "package apiv1")
+	s.Contains(s.readFile("api/v1/test_grpc.pb.go"), "type TestServer interface")
+	_, err := os.Stat(filepath.Join(out, "api"))
+	s.True(os.IsNotExist(err), "the directory of the proto file is repeated")
+}
+
+func (s *CompileTestSuite) TestCompile_Errors() {
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+option go_package = ".;test";
+
+message Request {
+  string name = 1;
+  Unknown id = 2;
+  Other other = 3;
+}
+`)
+
+	err := Compile(file, s.dir)
+	s.Require().Error(err)
+	var diagnostics Diagnostics
+	s.Require().True(errors.As(err, &diagnostics))
+	s.Require().Len(diagnostics, 2)
+	s.Equal(file+":7:3", diagnostics[0].Pos.String())
+	s.Contains(diagnostics[0].Message, "Unknown")
+	s.Equal(8, diagnostics[1].Pos.Line)
+
+	_, err = os.Stat(filepath.Join(s.dir, "test.pb.go"))
+	s.True(os.IsNotExist(err))
+}
+
+func (s *CompileTestSuite) TestCompile_MissingImport() {
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+import "missing.proto";
+`)
+
+	err := Compile(file, s.dir, s.dir)
+	var diagnostics Diagnostics
+	s.Require().True(errors.As(err, &diagnostics))
+	s.Equal(3, diagnostics[0].Pos.Line)
+	s.Contains(diagnostics[0].Message, "missing.proto")
+}
+
+func (s *CompileTestSuite) TestCompileIncludes() {
+	includes, name := compileIncludes("/a/b/c.proto", []string{"/x", "/a"})
+	s.Equal([]string{"/x", "/a"}, includes)
+	s.Equal("b/c.proto", name)
+
+	includes, name = compileIncludes("/a/b/c.proto", []string{"/x"})
+	s.Equal([]string{"/a/b", "/x"}, includes)
+	s.Equal("c.proto", name)
+}
+
+func (s *CompileTestSuite) TestCompile_Googleapis() {
+	s.T().Setenv("XDG_CACHE_HOME", s.T().TempDir())
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+option go_package = ".;test";
+import "googleapis/google/api/annotations.proto";
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.ServiceOptions {
+  optional string prefix = 10000;
+}
+
+message Request {
+  string id = 1;
+}
+
+service Test {
+  option (prefix) = "/api";
+  rpc Get(Request) returns (Request) {
+    option (google.api.http) = {
+      get: "/entity/{id}"
+    };
+  }
+}
+`)
+
+	s.Require().NoError(NewService().CompileProto(file, s.dir, "example.com/test", s.dir))
+	s.Contains(s.readFile("test.pb.go"), `_ "google.golang.org/genproto/googleapis/api/annotations"`)
+}
+
+func (s *CompileTestSuite) TestCompile_CreateFile() {
+	s.T().Setenv("XDG_CACHE_HOME", s.T().TempDir())
+	file := filepath.Join(s.dir, "sample.proto")
+	p := NewService()
+	s.Require().NoError(p.CreateFile(file, "sample", "Sample", "example.com/sample"))
+
+	s.Require().NoError(p.Parse(file))
+	s.Equal("/api/sample", p.Definition().Services[0].HttpPrefix)
+	importPath, name := p.Definition().GoPackage()
+	s.Equal("example.com/sample", importPath)
+	s.Equal("sample", name)
+
+	s.Require().NoError(p.CompileProto(file, s.dir, "example.com/sample", s.dir))
+	s.Contains(s.readFile("sample.pb.go"), `_ "github.com/niiigoo/hawk/proto/options/hawk"`)
+	s.Contains(s.readFile("sample_grpc.pb.go"), "type SampleServer interface")
+}
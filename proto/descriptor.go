@@ -0,0 +1,575 @@
+package proto
+
+import (
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DescriptorSetProtoc is the value of the descriptor set letting protoc
+// produce it from the proto file.
+const DescriptorSetProtoc = "protoc"
+
+// The field numbers of the descriptors used in the paths of the source code info.
+const (
+	fileMessagePath   = 4
+	fileEnumPath      = 5
+	fileServicePath   = 6
+	messageFieldPath  = 2
+	messageNestedPath = 3
+	messageEnumPath   = 4
+	messageOneOfPath  = 8
+	enumValuePath     = 2
+	serviceMethodPath = 2
+
+	fileOptionsPath      = 8
+	messageOptionsPath   = 7
+	fieldOptionsPath     = 8
+	enumOptionsPath      = 3
+	enumValueOptionsPath = 3
+	serviceOptionsPath   = 3
+	methodOptionsPath    = 4
+)
+
+// ProtoFromDescriptorSet converts the files of a FileDescriptorSet into the AST
+// of hawk. The file named file is returned first followed by all other files of
+// the set, they are used as imports. The set is expected to contain the source
+// code info (`protoc --include_source_info`) to provide positions and comments,
+// custom options are decoded if the set contains their declarations
+// (`protoc --include_imports`). The positions of file are reported as filename.
+func ProtoFromDescriptorSet(set *descriptorpb.FileDescriptorSet, file, filename string) (*io.Proto, []*io.Proto, error) {
+	if len(set.File) == 0 {
+		return nil, nil, errors.New("descriptor set contains no files")
+	}
+	main := slices.IndexFunc(set.File, func(f *descriptorpb.FileDescriptorProto) bool {
+		return f.GetName() == file
+	})
+	if main < 0 && file != "" {
+		main = slices.IndexFunc(set.File, func(f *descriptorpb.FileDescriptorProto) bool {
+			return strings.HasSuffix(file, "/"+f.GetName())
+		})
+	}
+	if main < 0 {
+		// protoc adds the files requested last
+		main = len(set.File) - 1
+	}
+
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(set)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid descriptor set")
+	}
+	types := dynamicpb.NewTypes(files)
+
+	var data *io.Proto
+	imports := make([]*io.Proto, 0, len(set.File)-1)
+	for i, f := range set.File {
+		name := f.GetName()
+		if i == main && filename != "" {
+			name = filename
+		}
+		c := &descriptorConverter{
+			file:      f,
+			filename:  name,
+			types:     types,
+			locations: make(map[string]*descriptorpb.SourceCodeInfo_Location),
+		}
+		for _, location := range f.GetSourceCodeInfo().GetLocation() {
+			c.locations[pathKey(location.Path)] = location
+		}
+		if i == main {
+			data = c.proto()
+		} else {
+			imports = append(imports, c.proto())
+		}
+	}
+
+	return data, imports, nil
+}
+
+type descriptorConverter struct {
+	file      *descriptorpb.FileDescriptorProto
+	filename  string
+	types     *dynamicpb.Types
+	locations map[string]*descriptorpb.SourceCodeInfo_Location
+}
+
+func pathKey(path []int32) string {
+	parts := make([]string, len(path))
+	for i, p := range path {
+		parts[i] = strconv.Itoa(int(p))
+	}
+	return strings.Join(parts, ".")
+}
+
+// child appends the field number and the index of an element to the path of its parent.
+func child(path []int32, field, index int) []int32 {
+	return append(slices.Clone(path), int32(field), int32(index))
+}
+
+// pos returns the position of the element declared at path, the first position
+// of the file is used if the source code info is missing.
+func (c *descriptorConverter) pos(path []int32) lexer.Position {
+	pos, _ := c.lookup(path)
+	return pos
+}
+
+func (c *descriptorConverter) lookup(path []int32) (lexer.Position, bool) {
+	pos := lexer.Position{Filename: c.filename, Line: 1, Column: 1}
+	location, ok := c.locations[pathKey(path)]
+	if !ok || len(location.Span) < 2 {
+		return pos, false
+	}
+	pos.Line = int(location.Span[0]) + 1
+	pos.Column = int(location.Span[1]) + 1
+	return pos, true
+}
+
+// comments returns the leading comments of the element declared at path in
+// the format of the parser (`// comment`).
+func (c *descriptorConverter) comments(path []int32) []string {
+	location, ok := c.locations[pathKey(path)]
+	if !ok || location.LeadingComments == nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(location.GetLeadingComments(), "\n"), "\n")
+	comments := make([]string, len(lines))
+	for i, line := range lines {
+		comments[i] = "//" + line
+	}
+	return comments
+}
+
+func (c *descriptorConverter) proto() *io.Proto {
+	f := c.file
+	data := &io.Proto{Pos: c.pos(nil)}
+	add := func(entry *io.Entry) {
+		data.Entries = append(data.Entries, entry)
+	}
+
+	syntax := f.GetSyntax()
+	if syntax == "" {
+		syntax = "proto2"
+	}
+	add(&io.Entry{Pos: data.Pos, Syntax: syntax})
+	if f.Package != nil {
+		add(&io.Entry{Pos: data.Pos, Package: f.GetPackage()})
+	}
+	for _, dependency := range f.Dependency {
+		add(&io.Entry{Pos: data.Pos, Import: dependency})
+	}
+	for _, option := range c.options(nil, fileOptionsPath, f.Options) {
+		add(&io.Entry{Pos: option.Pos, Option: option})
+	}
+	for i, m := range f.MessageType {
+		msg := c.message(child(nil, fileMessagePath, i), m)
+		add(&io.Entry{Pos: msg.Pos, Message: msg})
+	}
+	for i, e := range f.EnumType {
+		enum := c.enum(child(nil, fileEnumPath, i), e)
+		add(&io.Entry{Pos: enum.Pos, Enum: enum})
+	}
+	for i, s := range f.Service {
+		service := c.service(child(nil, fileServicePath, i), s)
+		add(&io.Entry{Pos: service.Pos, Service: service})
+	}
+	for _, extend := range c.extends(data.Pos, f.Extension) {
+		add(&io.Entry{Pos: extend.Pos, Extend: extend})
+	}
+
+	return data
+}
+
+func (c *descriptorConverter) message(path []int32, m *descriptorpb.DescriptorProto) *io.Message {
+	msg := &io.Message{
+		Pos:      c.pos(path),
+		Comments: c.comments(path),
+		Name:     m.GetName(),
+	}
+	add := func(entry *io.MessageEntry) {
+		msg.Entries = append(msg.Entries, entry)
+	}
+
+	for _, option := range c.options(path, messageOptionsPath, m.Options) {
+		add(&io.MessageEntry{Pos: option.Pos, Option: option})
+	}
+
+	oneOfs := make(map[int32]*io.OneOf)
+	for i, f := range m.Field {
+		field := c.field(child(path, messageFieldPath, i), f, m)
+		if f.OneofIndex == nil || f.GetProto3Optional() {
+			add(&io.MessageEntry{Pos: field.Pos, Field: field})
+			continue
+		}
+		oneOf, ok := oneOfs[f.GetOneofIndex()]
+		if !ok {
+			oneOfPath := child(path, messageOneOfPath, int(f.GetOneofIndex()))
+			oneOf = &io.OneOf{
+				Pos:      c.pos(oneOfPath),
+				Comments: c.comments(oneOfPath),
+				Name:     m.OneofDecl[f.GetOneofIndex()].GetName(),
+			}
+			oneOfs[f.GetOneofIndex()] = oneOf
+			add(&io.MessageEntry{Pos: oneOf.Pos, OneOf: oneOf})
+		}
+		oneOf.Entries = append(oneOf.Entries, &io.OneOfEntry{Pos: field.Pos, Field: field})
+	}
+
+	for i, nested := range m.NestedType {
+		if nested.GetOptions().GetMapEntry() {
+			continue
+		}
+		n := c.message(child(path, messageNestedPath, i), nested)
+		add(&io.MessageEntry{Pos: n.Pos, Message: n})
+	}
+	for i, e := range m.EnumType {
+		enum := c.enum(child(path, messageEnumPath, i), e)
+		add(&io.MessageEntry{Pos: enum.Pos, Enum: enum})
+	}
+	for _, extend := range c.extends(msg.Pos, m.Extension) {
+		add(&io.MessageEntry{Pos: extend.Pos, Extend: extend})
+	}
+
+	if len(m.ReservedRange) > 0 || len(m.ReservedName) > 0 {
+		reserved := &io.Reserved{Pos: msg.Pos}
+		for _, r := range m.ReservedRange {
+			reserved.Reserved = append(reserved.Reserved, descriptorRange(r.GetStart(), r.GetEnd()))
+		}
+		for _, name := range m.ReservedName {
+			reserved.Reserved = append(reserved.Reserved, io.Range{Ident: name})
+		}
+		add(&io.MessageEntry{Pos: msg.Pos, Reserved: reserved})
+	}
+	if len(m.ExtensionRange) > 0 {
+		extensions := &io.Extensions{Pos: msg.Pos}
+		for _, r := range m.ExtensionRange {
+			extensions.Extensions = append(extensions.Extensions, descriptorRange(r.GetStart(), r.GetEnd()))
+		}
+		add(&io.MessageEntry{Pos: msg.Pos, Extensions: extensions})
+	}
+
+	return msg
+}
+
+// descriptorRange converts a range with exclusive end into the inclusive range of the parser.
+func descriptorRange(start, end int32) io.Range {
+	r := io.Range{Start: int(start)}
+	if end > 536870911 {
+		r.Max = true
+	} else if end-1 != start {
+		r.End = ref(int(end - 1))
+	}
+	return r
+}
+
+func (c *descriptorConverter) field(path []int32, f *descriptorpb.FieldDescriptorProto, parent *descriptorpb.DescriptorProto) *io.Field {
+	pos := c.pos(path)
+	field := &io.Field{
+		Pos:      pos,
+		Comments: c.comments(path),
+		Name:     f.GetName(),
+		Tag:      int(f.GetNumber()),
+		Repeated: f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED,
+		Required: f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REQUIRED,
+		Optional: f.GetProto3Optional() ||
+			f.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL && c.file.GetSyntax() == "proto2",
+		Type: c.fieldType(pos, f),
+	}
+
+	if entry := mapEntry(f, parent); entry != nil && len(entry.Field) == 2 {
+		field.Repeated = false
+		key, value := c.fieldType(field.Pos, entry.Field[0]), c.fieldType(field.Pos, entry.Field[1])
+		field.Type = io.Type{Pos: field.Pos, Map: &io.MapType{Pos: field.Pos, Key: &key, Value: &value}}
+	}
+
+	if f.JsonName != nil && f.GetJsonName() != JSONName(f.GetName()) {
+		field.Options = append(field.Options, &io.Option{
+			Pos:   field.Pos,
+			Name:  optionJSONName,
+			Value: &io.Value{Pos: field.Pos, String: ref(f.GetJsonName())},
+		})
+	}
+	if f.DefaultValue != nil {
+		field.Options = append(field.Options, &io.Option{
+			Pos:   field.Pos,
+			Name:  "default",
+			Value: defaultValue(field.Pos, f),
+		})
+	}
+	field.Options = append(field.Options, c.options(path, fieldOptionsPath, f.Options)...)
+
+	return field
+}
+
+// mapEntry returns the message generated for a map field, nil if the field is no map.
+func mapEntry(f *descriptorpb.FieldDescriptorProto, parent *descriptorpb.DescriptorProto) *descriptorpb.DescriptorProto {
+	if parent == nil || f.GetLabel() != descriptorpb.FieldDescriptorProto_LABEL_REPEATED ||
+		f.GetType() != descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	name := f.GetTypeName()[strings.LastIndex(f.GetTypeName(), ".")+1:]
+	for _, nested := range parent.NestedType {
+		if nested.GetName() == name && nested.GetOptions().GetMapEntry() {
+			return nested
+		}
+	}
+	return nil
+}
+
+var descriptorScalars = map[descriptorpb.FieldDescriptorProto_Type]io.Scalar{
+	descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:   io.Double,
+	descriptorpb.FieldDescriptorProto_TYPE_FLOAT:    io.Float,
+	descriptorpb.FieldDescriptorProto_TYPE_INT64:    io.Int64,
+	descriptorpb.FieldDescriptorProto_TYPE_UINT64:   io.Uint64,
+	descriptorpb.FieldDescriptorProto_TYPE_INT32:    io.Int32,
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED64:  io.Fixed64,
+	descriptorpb.FieldDescriptorProto_TYPE_FIXED32:  io.Fixed32,
+	descriptorpb.FieldDescriptorProto_TYPE_BOOL:     io.Bool,
+	descriptorpb.FieldDescriptorProto_TYPE_STRING:   io.String,
+	descriptorpb.FieldDescriptorProto_TYPE_BYTES:    io.Bytes,
+	descriptorpb.FieldDescriptorProto_TYPE_UINT32:   io.Uint32,
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED32: io.SFixed32,
+	descriptorpb.FieldDescriptorProto_TYPE_SFIXED64: io.SFixed64,
+	descriptorpb.FieldDescriptorProto_TYPE_SINT32:   io.Sint32,
+	descriptorpb.FieldDescriptorProto_TYPE_SINT64:   io.Sint64,
+}
+
+// fieldType returns the type of the field, messages, enums and groups are
+// referenced by their fully-qualified name.
+func (c *descriptorConverter) fieldType(pos lexer.Position, f *descriptorpb.FieldDescriptorProto) io.Type {
+	if scalar, ok := descriptorScalars[f.GetType()]; ok {
+		return io.Type{Pos: pos, Scalar: scalar}
+	}
+	return io.Type{Pos: pos, Reference: f.GetTypeName()}
+}
+
+// defaultValue converts the default value of a proto2 field, it is stored as text.
+func defaultValue(pos lexer.Position, f *descriptorpb.FieldDescriptorProto) *io.Value {
+	v := &io.Value{Pos: pos}
+	str := f.GetDefaultValue()
+	switch f.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		v.String = &str
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		v.Bool = ref(io.Boolean(str == "true"))
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		v.Reference = &str
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		if n, err := strconv.ParseFloat(str, 64); err == nil {
+			v.Number = &n
+		} else {
+			v.Reference = &str
+		}
+	default:
+		if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+			v.Int = &n
+		} else {
+			v.Reference = &str
+		}
+	}
+	return v
+}
+
+func (c *descriptorConverter) enum(path []int32, e *descriptorpb.EnumDescriptorProto) *io.Enum {
+	enum := &io.Enum{
+		Pos:      c.pos(path),
+		Comments: c.comments(path),
+		Name:     e.GetName(),
+	}
+	for _, option := range c.options(path, enumOptionsPath, e.Options) {
+		enum.Values = append(enum.Values, &io.EnumEntry{Pos: option.Pos, Option: option})
+	}
+	for i, v := range e.Value {
+		valuePath := child(path, enumValuePath, i)
+		pos := c.pos(valuePath)
+		enum.Values = append(enum.Values, &io.EnumEntry{
+			Pos: pos,
+			Value: &io.EnumValue{
+				Pos:     pos,
+				Key:     v.GetName(),
+				Value:   int(v.GetNumber()),
+				Options: c.options(valuePath, enumValueOptionsPath, v.Options),
+			},
+		})
+	}
+	return enum
+}
+
+func (c *descriptorConverter) service(path []int32, s *descriptorpb.ServiceDescriptorProto) *io.Service {
+	service := &io.Service{
+		Pos:      c.pos(path),
+		Comments: c.comments(path),
+		Name:     s.GetName(),
+	}
+	for _, option := range c.options(path, serviceOptionsPath, s.Options) {
+		service.Entries = append(service.Entries, &io.ServiceEntry{Pos: option.Pos, Option: option})
+	}
+	for i, m := range s.Method {
+		methodPath := child(path, serviceMethodPath, i)
+		pos := c.pos(methodPath)
+		service.Entries = append(service.Entries, &io.ServiceEntry{
+			Pos: pos,
+			Method: &io.Method{
+				Pos:               pos,
+				Comments:          c.comments(methodPath),
+				Name:              m.GetName(),
+				StreamingRequest:  m.GetClientStreaming(),
+				Request:           &io.Type{Pos: pos, Reference: m.GetInputType()},
+				StreamingResponse: m.GetServerStreaming(),
+				Response:          &io.Type{Pos: pos, Reference: m.GetOutputType()},
+				Options:           c.options(methodPath, methodOptionsPath, m.Options),
+			},
+		})
+	}
+	return service
+}
+
+// extends groups the extensions by the extended message.
+func (c *descriptorConverter) extends(pos lexer.Position, fields []*descriptorpb.FieldDescriptorProto) []*io.Extend {
+	extends := make([]*io.Extend, 0)
+	for _, f := range fields {
+		extendee := strings.TrimPrefix(f.GetExtendee(), ".")
+		i := slices.IndexFunc(extends, func(e *io.Extend) bool {
+			return e.Reference == extendee
+		})
+		if i < 0 {
+			extends = append(extends, &io.Extend{Pos: pos, Reference: extendee})
+			i = len(extends) - 1
+		}
+		extends[i].Fields = append(extends[i].Fields, c.field(nil, f, nil))
+	}
+	return extends
+}
+
+// options converts the options of the element declared at path, field is the
+// number of the options within the element. Custom options are encoded as
+// unknown fields, they are decoded by the declarations of the descriptor set.
+// Extensions declared in the package of the file are named relative to it
+// (`(config)` instead of `(pkg.config)`).
+func (c *descriptorConverter) options(path []int32, field int32, options protobuf.Message) []*io.Option {
+	if options == nil || !options.ProtoReflect().IsValid() {
+		return nil
+	}
+	data, err := protobuf.Marshal(options)
+	if err != nil {
+		return nil
+	}
+	msg := dynamicpb.NewMessage(options.ProtoReflect().Descriptor())
+	if err = (protobuf.UnmarshalOptions{Resolver: c.types}).Unmarshal(data, msg); err != nil {
+		return nil
+	}
+
+	res := make([]*io.Option, 0)
+	for _, fd := range sortedFields(msg) {
+		name := string(fd.Name())
+		if fd.IsExtension() {
+			name = strings.TrimPrefix(string(fd.FullName()), c.file.GetPackage()+".")
+		}
+		pos, ok := c.lookup(append(slices.Clone(path), field, int32(fd.Number())))
+		if !ok {
+			pos = c.pos(path)
+		}
+		values := []protoreflect.Value{msg.Get(fd)}
+		if fd.IsList() {
+			values = values[:0]
+			list := msg.Get(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				values = append(values, list.Get(i))
+			}
+		}
+		for _, v := range values {
+			res = append(res, &io.Option{
+				Pos:    pos,
+				Custom: fd.IsExtension(),
+				Name:   name,
+				Value:  c.value(pos, fd, v),
+			})
+		}
+	}
+	return res
+}
+
+// sortedFields returns the fields set on msg ordered by their number.
+func sortedFields(msg protoreflect.Message) []protoreflect.FieldDescriptor {
+	fields := make([]protoreflect.FieldDescriptor, 0)
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	slices.SortFunc(fields, func(a, b protoreflect.FieldDescriptor) int {
+		return int(a.Number()) - int(b.Number())
+	})
+	return fields
+}
+
+// value converts a single value of an option, messages are converted into
+// maps in the text format (repeated fields are added once per element).
+func (c *descriptorConverter) value(pos lexer.Position, fd protoreflect.FieldDescriptor, v protoreflect.Value) *io.Value {
+	res := &io.Value{Pos: pos}
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		res.Bool = ref(io.Boolean(v.Bool()))
+	case protoreflect.EnumKind:
+		name := strconv.Itoa(int(v.Enum()))
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			name = string(ev.Name())
+		}
+		res.Reference = &name
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		res.Int = ref(v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		res.Int = ref(int64(v.Uint()))
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		res.Number = ref(v.Float())
+	case protoreflect.StringKind:
+		res.String = ref(v.String())
+	case protoreflect.BytesKind:
+		res.String = ref(string(v.Bytes()))
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		res.Map = &io.Map{Pos: pos}
+		msg := v.Message()
+		for _, field := range sortedFields(msg) {
+			key := &io.Value{Pos: pos, Reference: ref(string(field.Name()))}
+			if field.IsExtension() {
+				key.Reference = ref(string(field.FullName()))
+			}
+			if field.IsList() {
+				list := msg.Get(field).List()
+				if field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind {
+					array := &io.Array{Pos: pos}
+					for i := 0; i < list.Len(); i++ {
+						array.Elements = append(array.Elements, c.value(pos, field, list.Get(i)))
+					}
+					res.Map.Entries = append(res.Map.Entries, &io.MapEntry{Pos: pos, Key: key, Value: &io.Value{Pos: pos, Array: array}})
+					continue
+				}
+				for i := 0; i < list.Len(); i++ {
+					res.Map.Entries = append(res.Map.Entries, &io.MapEntry{Pos: pos, Key: key, Value: c.value(pos, field, list.Get(i))})
+				}
+				continue
+			}
+			if field.IsMap() {
+				// maps are lists of entries in the text format
+				msg.Get(field).Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+					entry := &io.Map{Pos: pos, Entries: []*io.MapEntry{
+						{Pos: pos, Key: &io.Value{Pos: pos, Reference: ref("key")}, Value: c.value(pos, field.MapKey(), k.Value())},
+						{Pos: pos, Key: &io.Value{Pos: pos, Reference: ref("value")}, Value: c.value(pos, field.MapValue(), mv)},
+					}}
+					res.Map.Entries = append(res.Map.Entries, &io.MapEntry{Pos: pos, Key: key, Value: &io.Value{Pos: pos, Map: entry}})
+					return true
+				})
+				continue
+			}
+			res.Map.Entries = append(res.Map.Entries, &io.MapEntry{Pos: pos, Key: key, Value: c.value(pos, field, msg.Get(field))})
+		}
+	}
+	return res
+}
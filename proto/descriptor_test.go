@@ -0,0 +1,228 @@
+package proto
+
+import (
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/prototext"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type DescriptorTestSuite struct {
+	suite.Suite
+}
+
+func TestDescriptorTestSuite(t *testing.T) {
+	suite.Run(t, new(DescriptorTestSuite))
+}
+
+// descriptorSet is the output of `protoc --include_imports --include_source_info`
+// for the file below, descriptor.proto is added by the test.
+//
+//	syntax = "proto3";
+//	package test;
+//	import "google/api/annotations.proto";
+//	import "google/protobuf/descriptor.proto";
+//
+//	message Config {
+//	  string HttpPrefix = 1;
+//	}
+//	extend google.protobuf.ServiceOptions {
+//	  Config config = 10000;
+//	}
+//
+//	// A request
+//	message Request {
+//	  // the id
+//	  string id = 1;
+//	  map<string, int32> counts = 2;
+//	  oneof kind {
+//	    string name = 3;
+//	  }
+//	  string display_name = 4 [json_name = "title", deprecated = true];
+//	  reserved 5, 10 to max;
+//	}
+//	message Response {}
+//	// The service
+//	service Test {
+//	  option (config) = { HttpPrefix: "/api" };
+//	  // Get an entity
+//	  rpc Get(Request) returns (Response) {
+//	    option (google.api.http) = {
+//	      get: "/entity/{id}"
+//	      additional_bindings { post: "/entity" body: "*" }
+//	    };
+//	  }
+//	}
+const descriptorSet = `
+file {
+  name: "google/api/http.proto"
+  package: "google.api"
+  message_type {
+    name: "HttpRule"
+    field { name: "get" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "get" oneof_index: 0 }
+    field { name: "put" number: 3 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "put" oneof_index: 0 }
+    field { name: "post" number: 4 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "post" oneof_index: 0 }
+    field { name: "body" number: 7 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "body" }
+    field { name: "additional_bindings" number: 11 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".google.api.HttpRule" json_name: "additionalBindings" }
+    oneof_decl { name: "pattern" }
+  }
+  syntax: "proto3"
+}
+file {
+  name: "google/api/annotations.proto"
+  package: "google.api"
+  dependency: "google/api/http.proto"
+  dependency: "google/protobuf/descriptor.proto"
+  extension { name: "http" number: 72295728 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".google.api.HttpRule" extendee: ".google.protobuf.MethodOptions" json_name: "http" }
+  syntax: "proto3"
+}
+file {
+  name: "test.proto"
+  package: "test"
+  dependency: "google/api/annotations.proto"
+  dependency: "google/protobuf/descriptor.proto"
+  message_type {
+    name: "Config"
+    field { name: "HttpPrefix" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "HttpPrefix" }
+  }
+  message_type {
+    name: "Request"
+    field { name: "id" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "id" }
+    field { name: "counts" number: 2 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.Request.CountsEntry" json_name: "counts" }
+    field { name: "name" number: 3 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "name" oneof_index: 0 }
+    field { name: "display_name" number: 4 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "title" options { deprecated: true } }
+    nested_type {
+      name: "CountsEntry"
+      field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "key" }
+      field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_INT32 json_name: "value" }
+      options { map_entry: true }
+    }
+    oneof_decl { name: "kind" }
+    reserved_range { start: 5 end: 6 }
+    reserved_range { start: 10 end: 536870912 }
+  }
+  message_type { name: "Response" }
+  service {
+    name: "Test"
+    method {
+      name: "Get"
+      input_type: ".test.Request"
+      output_type: ".test.Response"
+      options {
+        [google.api.http] {
+          get: "/entity/{id}"
+          additional_bindings { post: "/entity" body: "*" }
+        }
+      }
+    }
+    options { [test.config] { HttpPrefix: "/api" } }
+  }
+  extension { name: "config" number: 10000 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.Config" extendee: ".google.protobuf.ServiceOptions" json_name: "config" }
+  source_code_info {
+    location { path: [4, 1] span: [14, 0, 23, 1] leading_comments: " A request\n" }
+    location { path: [4, 1, 2, 0] span: [16, 2, 16] leading_comments: " the id\n" }
+    location { path: [6, 0] span: [26, 0, 35, 1] leading_comments: " The service\n" }
+    location { path: [6, 0, 2, 0] span: [29, 2, 34, 3] leading_comments: " Get an entity\n" }
+    location { path: [6, 0, 2, 0, 4, 72295728] span: [30, 4, 33, 6] }
+  }
+  syntax: "proto3"
+}
+`
+
+// set parses the descriptor set, it is parsed twice to resolve the custom
+// options by the declarations of the set.
+func (s *DescriptorTestSuite) set() *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	s.Require().NoError(prototext.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(descriptorSet), set))
+	set.File = append([]*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+	}, set.File...)
+
+	files, err := protodesc.NewFiles(set)
+	s.Require().NoError(err)
+	res := &descriptorpb.FileDescriptorSet{}
+	s.Require().NoError(prototext.UnmarshalOptions{Resolver: dynamicpb.NewTypes(files)}.Unmarshal([]byte(descriptorSet), res))
+	res.File = append(set.File[:1], res.File...)
+	return res
+}
+
+func (s *DescriptorTestSuite) definition() *Definition {
+	data, imports, err := ProtoFromDescriptorSet(s.set(), "test.proto", "/path/test.proto")
+	s.Require().NoError(err)
+	s.Require().Len(imports, 3)
+
+	def, err := DefinitionFromProto(data, imports...)
+	s.Require().NoError(err)
+	return def
+}
+
+func (s *DescriptorTestSuite) TestDefinition_Service() {
+	def := s.definition()
+
+	s.Require().Len(def.Services, 1)
+	srv := def.Services[0]
+	s.Equal("Test", srv.Name)
+	s.Equal("The service", srv.Description)
+	s.Equal("/api", srv.HttpPrefix)
+
+	s.Require().Len(srv.Methods, 1)
+	m := srv.Methods[0]
+	s.Equal("Request", m.Request)
+	s.Equal("Response", m.Response)
+	s.Equal([]string{"// Get an entity"}, m.Comments)
+	s.Equal("/path/test.proto:30:3", m.Pos.String())
+
+	// the additional bindings are added first
+	s.Require().Len(m.HttpBindings, 2)
+	s.Equal("post", m.HttpBindings[0].Method)
+	s.Equal("*", m.HttpBindings[0].Body)
+	s.Equal("get", m.HttpBindings[1].Method)
+	s.Equal("/api/entity/{id}", m.HttpBindings[1].GorillaMuxPath())
+	s.Equal("/path/test.proto:31:5", m.HttpBindings[1].Pos.String())
+	s.Require().NotEmpty(m.HttpBindings[1].Params)
+	s.Equal(LocationPath, m.HttpBindings[1].Params[0].Location)
+}
+
+func (s *DescriptorTestSuite) TestDefinition_Message() {
+	def := s.definition()
+
+	msg := def.Messages["Request"]
+	s.Require().NotNil(msg)
+	s.Equal("test.Request", msg.FullName)
+	s.Equal([]string{"// A request"}, msg.Comments)
+	s.Require().Len(msg.Fields, 4)
+
+	s.Equal("id", msg.Fields[0].Name)
+	s.Equal([]string{"// the id"}, msg.Fields[0].Comments)
+	s.Require().NotNil(msg.Fields[1].Type.Map)
+	s.False(msg.Fields[1].Repeated)
+	s.Equal("kind", msg.Fields[2].OneOf)
+	s.Equal("title", msg.Fields[3].JSONName)
+	s.True(msg.Fields[3].Deprecated)
+
+	s.True(reservedTag(msg.Message, 5))
+	s.True(reservedTag(msg.Message, 100))
+	s.False(reservedTag(msg.Message, 6))
+	s.Nil(def.Messages["Request.CountsEntry"])
+}
+
+func (s *DescriptorTestSuite) TestParse_DescriptorSet() {
+	data, err := protobuf.Marshal(s.set())
+	s.Require().NoError(err)
+	dir := s.T().TempDir()
+	path := filepath.Join(dir, "service.binpb")
+	s.Require().NoError(os.WriteFile(path, data, 0666))
+
+	p := NewService()
+	p.SetDescriptorSet(path)
+	s.Require().NoError(p.Parse(filepath.Join(dir, "test.proto")))
+
+	s.Require().Len(p.Definition().Services, 1)
+	s.Equal("Test", p.Definition().Services[0].Name)
+	s.Equal(filepath.Join(dir, "test.proto"), p.Definition().Services[0].Pos.Filename)
+}
@@ -0,0 +1,98 @@
+package proto
+
+import (
+	"fmt"
+	"github.com/alecthomas/participle/v2/lexer"
+	"strings"
+)
+
+type Severity int
+
+const (
+	SeverityError Severity = iota + 1
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Diagnostic is a semantic error or warning found in a proto file.
+type Diagnostic struct {
+	Pos      lexer.Position
+	Severity Severity
+	Message  string
+	// Rule is the ID of the lint rule reporting the diagnostic, empty if it cannot be disabled
+	Rule string
+}
+
+// Error renders the diagnostic compiler-style: `file:line:col: message`.
+// Warnings are marked as such.
+func (d *Diagnostic) Error() string {
+	msg := d.Message
+	if d.Severity == SeverityWarning {
+		msg = "warning: " + msg
+	}
+	if d.Pos.Filename == "" && d.Pos.Line == 0 {
+		return msg
+	}
+	return d.Pos.String() + ": " + msg
+}
+
+// Diagnostics collects all errors and warnings instead of stopping at the first one.
+type Diagnostics []*Diagnostic
+
+func (d *Diagnostics) Errorf(pos lexer.Position, format string, args ...any) {
+	*d = append(*d, &Diagnostic{Pos: pos, Severity: SeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *Diagnostics) Warnf(pos lexer.Position, format string, args ...any) {
+	*d = append(*d, &Diagnostic{Pos: pos, Severity: SeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// Reportf adds a diagnostic of a lint rule, see Diagnostic.Rule.
+func (d *Diagnostics) Reportf(pos lexer.Position, severity Severity, rule, format string, args ...any) {
+	*d = append(*d, &Diagnostic{Pos: pos, Severity: severity, Message: fmt.Sprintf(format, args...), Rule: rule})
+}
+
+func (d Diagnostics) Errors() Diagnostics {
+	return d.filter(SeverityError)
+}
+
+func (d Diagnostics) Warnings() Diagnostics {
+	return d.filter(SeverityWarning)
+}
+
+func (d Diagnostics) HasErrors() bool {
+	return len(d.Errors()) > 0
+}
+
+// Err returns the diagnostics as error if at least one of them is an error, nil otherwise.
+func (d Diagnostics) Err() error {
+	if d.HasErrors() {
+		return d
+	}
+	return nil
+}
+
+// Error renders one diagnostic per line.
+func (d Diagnostics) Error() string {
+	lines := make([]string, len(d))
+	for i, diagnostic := range d {
+		lines[i] = diagnostic.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (d Diagnostics) filter(severity Severity) Diagnostics {
+	res := make(Diagnostics, 0)
+	for _, diagnostic := range d {
+		if diagnostic.Severity == severity {
+			res = append(res, diagnostic)
+		}
+	}
+	return res
+}
@@ -0,0 +1,87 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/pmezard/go-difflib/difflib"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ProtoFiles returns the proto files of the given paths, directories are
+// walked recursively skipping hidden ones.
+func ProtoFiles(paths ...string) ([]string, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	files := make([]string, 0)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() && file != path && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			if !d.IsDir() && strings.HasSuffix(file, ".proto") {
+				files = append(files, file)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
+
+// FormatFile formats a proto file and returns the unified diff of the changes,
+// it is empty if the file is formatted already. The file is only rewritten if
+// write is set.
+func FormatFile(file string, write bool) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	formatted, err := io.Format(file, data)
+	if err != nil {
+		return "", err
+	}
+	if string(formatted) == string(data) {
+		return "", nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(data)),
+		B:        difflib.SplitLines(string(formatted)),
+		FromFile: file,
+		ToFile:   file + " (formatted)",
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if write {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+		if err = os.WriteFile(file, formatted, info.Mode().Perm()); err != nil {
+			return "", err
+		}
+	}
+
+	return diff, nil
+}
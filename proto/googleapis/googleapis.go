@@ -0,0 +1,91 @@
+// Package googleapis bundles the proto files of googleapis required by hawk,
+// namely `google/api/annotations.proto` and `google/api/http.proto`, as well
+// as `google/protobuf/descriptor.proto`.
+package googleapis
+
+import (
+	"bytes"
+	"embed"
+	"github.com/pkg/errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Revision is the commit of https://github.com/googleapis/googleapis the
+// bundled files are taken from.
+const Revision = "3544ab16c3342d790b00764251e348705991ea4b"
+
+//go:embed google
+var files embed.FS
+
+// Dir returns the directory the bundled files are materialized to, located in
+// the user's cache directory. Every revision has its own directory.
+func Dir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "hawk", "googleapis", Revision)
+}
+
+// Includes returns the include paths providing the googleapis files. If dir is
+// empty, the bundled files are written to Dir first, otherwise dir is expected
+// to be a checkout of googleapis, e.g. to use another revision.
+//
+// Besides dir, its parent is included so that the files can be imported as
+// `google/api/annotations.proto` and `googleapis/google/api/annotations.proto`.
+func Includes(dir string) ([]string, error) {
+	if dir == "" {
+		dir = filepath.Join(Dir(), "googleapis")
+		if err := Materialize(dir); err != nil {
+			return nil, err
+		}
+	}
+	dir = os.ExpandEnv(dir)
+
+	return []string{filepath.Dir(dir), dir}, nil
+}
+
+// Materialize writes the bundled files to dir, see WriteFiles.
+func Materialize(dir string) error {
+	return WriteFiles(files, dir)
+}
+
+// WriteFiles writes all files of fsys to dir. Existing files are only
+// overwritten if their content differs, e.g. after an update of hawk.
+func WriteFiles(fsys fs.ReadFileFS, dir string) error {
+	return fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		data, err := fsys.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, filepath.FromSlash(path))
+		if current, err := os.ReadFile(target); err == nil && bytes.Equal(current, data) {
+			return nil
+		}
+
+		if err = os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return errors.Wrapf(err, "failed to create directory '%s'", filepath.Dir(target))
+		}
+		// written to a temporary file first, another process may read the file concurrently
+		tmp, err := os.CreateTemp(filepath.Dir(target), ".hawk-*")
+		if err != nil {
+			return errors.Wrapf(err, "failed to write file '%s'", target)
+		}
+		_, err = tmp.Write(data)
+		_ = tmp.Close()
+		if err == nil {
+			err = os.Rename(tmp.Name(), target)
+		}
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+			return errors.Wrapf(err, "failed to write file '%s'", target)
+		}
+		return nil
+	})
+}
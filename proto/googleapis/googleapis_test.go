@@ -0,0 +1,60 @@
+package googleapis
+
+import (
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type GoogleapisTestSuite struct {
+	suite.Suite
+}
+
+func TestGoogleapisTestSuite(t *testing.T) {
+	suite.Run(t, new(GoogleapisTestSuite))
+}
+
+func (s *GoogleapisTestSuite) TestIncludes() {
+	s.T().Setenv("XDG_CACHE_HOME", s.T().TempDir())
+
+	includes, err := Includes("")
+	s.Require().NoError(err)
+	s.Require().Len(includes, 2)
+	s.Equal(filepath.Join(Dir(), "googleapis"), includes[1])
+	for _, file := range []string{"google/api/annotations.proto", "google/api/http.proto", "google/protobuf/descriptor.proto"} {
+		s.FileExists(filepath.Join(includes[1], file))
+	}
+}
+
+func (s *GoogleapisTestSuite) TestIncludes_Override() {
+	s.T().Setenv("GOOGLEAPIS", "/src/googleapis")
+
+	includes, err := Includes("$GOOGLEAPIS")
+	s.Require().NoError(err)
+	s.Equal([]string{"/src", "/src/googleapis"}, includes)
+}
+
+func (s *GoogleapisTestSuite) TestMaterialize_Outdated() {
+	dir := s.T().TempDir()
+	file := filepath.Join(dir, "google/api/http.proto")
+	s.Require().NoError(os.MkdirAll(filepath.Dir(file), 0755))
+	s.Require().NoError(os.WriteFile(file, []byte("outdated"), 0644))
+
+	s.Require().NoError(Materialize(dir))
+
+	data, err := os.ReadFile(file)
+	s.Require().NoError(err)
+	bundled, err := files.ReadFile("google/api/http.proto")
+	s.Require().NoError(err)
+	s.Equal(string(bundled), string(data))
+	s.FileExists(filepath.Join(dir, "google/api/annotations.proto"))
+
+	// unchanged files are not written again
+	info, err := os.Stat(file)
+	s.Require().NoError(err)
+	s.Require().NoError(Materialize(dir))
+	again, err := os.Stat(file)
+	s.Require().NoError(err)
+	s.Equal(info.ModTime(), again.ModTime())
+}
@@ -5,7 +5,6 @@ import (
 	"github.com/alecthomas/participle/v2/lexer"
 	"io"
 	"regexp"
-	"strings"
 	"text/scanner"
 )
 
@@ -23,7 +22,8 @@ type Proto struct {
 }
 
 type Entry struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Syntax  string   `parser:"  'syntax' '=' @String"`
 	Package string   `parser:"| 'package' @(Ident ( '.' Ident )*)"`
@@ -37,20 +37,23 @@ type Entry struct {
 }
 
 type Option struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string `parser:"@Comment*"`
-	Name     string   `parser:"( '(' @Ident @( '.' Ident )* ')' | @Ident @( '.' @Ident )* )"`
-	Attr     *string  `parser:"( '.' @Ident ( '.' @Ident )* )?"`
+	Custom   bool     `parser:"( @'('"`
+	Name     string   `parser:"    @Ident @( '.' Ident )* ')' | @Ident @( '.' @Ident )* )"`
+	Attr     *string  `parser:"( '.' @(Ident ( '.' Ident )*) )?"`
 	Value    *Value   `parser:"'=' @@"`
 }
 
 type Value struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	String    *string  `parser:"  @String"`
-	Number    *float64 `parser:"| @Float"`
-	Int       *int64   `parser:"| '-'?@Int"`
+	Number    *float64 `parser:"| @('-'? Float)"`
+	Int       *int64   `parser:"| @('-'? Int)"`
 	Bool      *Boolean `parser:"| @('true' | 'false')"`
 	Reference *string  `parser:"| @Ident @( '.' Ident )*"`
 	Map       *Map     `parser:"| @@"`
@@ -58,33 +61,38 @@ type Value struct {
 }
 
 type Array struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Elements []*Value `parser:"'[' ( @@ ( ','? @@ )* )? ']'"`
 }
 
 type Map struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Entries []*MapEntry `parser:"'{' ( @@ ( ( ',' )? @@ )* )? '}'"`
 }
 
 type MapEntry struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Key   *Value `parser:"@@"`
 	Value *Value `parser:"':'? @@"`
 }
 
 type Extensions struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments   []string `parser:"@Comment* (?= 'extensions')"`
 	Extensions []Range  `parser:"'extensions' @@ ( ',' @@ )*"`
 }
 
 type Reserved struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string `parser:"@Comment* (?= 'reserved')"`
 	Reserved []Range  `parser:"'reserved' @@ ( ',' @@ )*"`
@@ -98,7 +106,8 @@ type Range struct {
 }
 
 type Extend struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments  []string `parser:"@Comment* (?= 'extend')"`
 	Reference string   `parser:"'extend' @Ident ( '.' @Ident )*"`
@@ -106,7 +115,8 @@ type Extend struct {
 }
 
 type Service struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string        `parser:"@Comment* (?= 'service')"`
 	Name     string          `parser:"'service' @Ident"`
@@ -114,14 +124,16 @@ type Service struct {
 }
 
 type ServiceEntry struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Option *Option `parser:"  'option' @@"`
 	Method *Method `parser:"| @@"`
 }
 
 type Method struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments          []string  `parser:"@Comment* (?= 'rpc')"`
 	Name              string    `parser:"'rpc' @Ident"`
@@ -133,7 +145,8 @@ type Method struct {
 }
 
 type Enum struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string     `parser:"@Comment* (?= 'enum')"`
 	Name     string       `parser:"'enum' @Ident"`
@@ -141,14 +154,16 @@ type Enum struct {
 }
 
 type EnumEntry struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Value  *EnumValue `parser:"  @@"`
 	Option *Option    `parser:"| 'option' @@"`
 }
 
 type EnumValue struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Key   string `parser:"@Ident"`
 	Value int    `parser:"'=' @( [ '-' ] Int )"`
@@ -157,7 +172,8 @@ type EnumValue struct {
 }
 
 type Message struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string        `parser:"@Comment* (?= 'message')"`
 	Name     string          `parser:"'message' @Ident"`
@@ -165,7 +181,8 @@ type Message struct {
 }
 
 type MessageEntry struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Enum       *Enum       `parser:"( @@"`
 	Option     *Option     `parser:" | 'option' @@"`
@@ -178,7 +195,8 @@ type MessageEntry struct {
 }
 
 type OneOf struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string      `parser:"@Comment* (?= 'oneof')"`
 	Name     string        `parser:"'oneof' @Ident"`
@@ -186,14 +204,16 @@ type OneOf struct {
 }
 
 type OneOfEntry struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Field  *Field  `parser:"  @@"`
 	Option *Option `parser:"| 'option' @@"`
 }
 
 type Field struct {
-	Pos lexer.Position
+	Pos    lexer.Position
+	EndPos lexer.Position
 
 	Comments []string `parser:"@Comment*"`
 	Optional bool     `parser:"(   @'optional'"`
@@ -258,7 +278,7 @@ type Type struct {
 
 	Scalar    Scalar   `parser:"  @@"`
 	Map       *MapType `parser:"| @@"`
-	Reference string   `parser:"| @(Ident ( '.' Ident )*)"`
+	Reference string   `parser:"| @('.'? Ident ( '.' Ident )*)"`
 }
 
 type MapType struct {
@@ -268,37 +288,62 @@ type MapType struct {
 	Value *Type `parser:"',' @@ '>'"`
 }
 
+// Path is a path template of `google.api.http`:
+//
+//	Template = "/" Segments [ Verb ] ;
+//	Segments = Segment { "/" Segment } ;
+//	Segment  = "*" | "**" | LITERAL | Variable ;
+//	Variable = "{" FieldPath [ "=" Segments ] [ ":" Pattern ] "}" ;
+//	Verb     = ":" LITERAL ;
+//
+// The pattern of a variable is a regular expression, it overrides the segments.
 type Path struct {
-	Segments []Segment
-	//SegmentsRaw []string `parser:"'/' (@Element|@Ident)+ ('/' (@Element|@Ident)+)* (?= (':' Ident)?)"`
-	Verb *string `parser:"(':' (@Element|@Ident)+)?"`
+	Pos lexer.Position
+
+	Segments []Segment `parser:"'/' ( @@ ( '/' @@ )* )?"`
+	Verb     *string   `parser:"( ':' @Literal )?"`
 }
 
 type Segment struct {
-	Wildcard *string
-	Literal  *string
-	Variable *Variable
+	Pos lexer.Position
+
+	Wildcard *string   `parser:"  @Wildcard"`
+	Literal  *string   `parser:"| @Literal"`
+	Variable *Variable `parser:"| '{' @@ '}'"`
 }
 
 type Variable struct {
-	Field    string   `parser:"@Ident"`
-	Segments []string `parser:"('=' @Ident ('/' @Ident)*)?"`
-	Pattern  *string  `parser:"(':' (@Ident|@Element|@Symbol)+)?"`
+	Pos lexer.Position
+
+	Field    string   `parser:"@Element"`
+	Segments []string `parser:"( '=' @( Wildcard | Element ) ( '/' @( Wildcard | Element ) )* )?"`
+	Pattern  *string  `parser:"@Pattern?"`
 }
 
 var (
-	//parserPath = participle.MustBuild[Path](participle.Lexer(lexer.MustSimple([]lexer.SimpleRule{
-	//	{"Ident", `[a-zA-Z_][a-zA-Z0-9_-]*`},
-	//	{"Symbol", `[/:]`},
-	//	{"Element", `[^/:]+`},
-	//})))
-	//parserPathSegment = participle.MustBuild[Variable](participle.Lexer(lexer.MustSimple([]lexer.SimpleRule{
-	//	{"Ident", `[a-zA-Z_][a-zA-Z0-9_-]*`},
-	//	{"Symbol", `[=:]`},
-	//	{"Slash", `/`},
-	//	{"Element", `[^/]+`},
-	//})))
-	patternVerb = regexp.MustCompile(`(.*):([A-Za-z]+)$`)
+	parserPath = participle.MustBuild[Path](
+		participle.Lexer(lexer.MustStateful(lexer.Rules{
+			"Root": {
+				{Name: "Wildcard", Pattern: `\*\*?`},
+				{Name: "Symbol", Pattern: `[/:]`},
+				{Name: "Open", Pattern: `{`, Action: lexer.Push("Variable")},
+				{Name: "Literal", Pattern: `[^/*{}:]+`},
+			},
+			"Variable": {
+				{Name: "Close", Pattern: `}`, Action: lexer.Pop()},
+				// a regular expression which may contain one level of braces, e.g. `[a-z]{1,3}`
+				{Name: "Pattern", Pattern: `:(?:[^{}]|{[^{}]*})+`},
+				{Name: "Wildcard", Pattern: `\*\*?`},
+				{Name: "Operator", Pattern: `[/=]`},
+				{Name: "Element", Pattern: `[^/*{}:=]+`},
+			},
+		})),
+		participle.Map(func(token lexer.Token) (lexer.Token, error) {
+			token.Value = token.Value[1:]
+			return token, nil
+		}, "Pattern"),
+	)
+	patternFieldPath = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
 )
 
 func parser(comments bool) *participle.Parser[Proto] {
@@ -321,79 +366,32 @@ func ParseString(filename string, data string, comments bool) (*Proto, error) {
 	return parser(comments).ParseString(filename, data)
 }
 
-// TODO: improve this function
+// ParsePath parses a path template of `google.api.http`. The positions of
+// the errors are relative to the template.
 func ParsePath(data string) (*Path, error) {
-	path := Path{
-		Segments: make([]Segment, 0),
-	}
-
-	matches := patternVerb.FindStringSubmatch(data)
-	if len(matches) > 0 {
-		path.Verb = &matches[2]
-		data = matches[1]
+	path, err := parserPath.ParseString("", data)
+	if err != nil {
+		return nil, err
 	}
 
-	parts := strings.Split(strings.TrimPrefix(data, "/"), "/")
-	for i := 0; i < len(parts); i++ {
-		s := parts[i]
-
-		if s == "*" || s == "**" {
-			path.Segments = append(path.Segments, Segment{
-				Wildcard: &s,
-			})
-		} else if strings.HasPrefix(s, "{") {
-			v := Variable{
-				Segments: make([]string, 0),
-			}
-			s = s[1:]
-			pos := strings.Index(s, "=")
-			segments := false
-			if pos >= 0 {
-				segments = true
-				v.Field = s[:pos]
-				s = s[pos+1:]
-				for {
-					pos = strings.Index(s, "}")
-					if pos >= 0 {
-						break
-					}
-					if len(s) > 0 {
-						v.Segments = append(v.Segments, s)
-					}
-					i++
-					s = parts[i]
-				}
-			}
-			s = s[:len(s)-1]
-			if len(s) > 0 {
-				pos = strings.Index(s, ":")
-				if pos < 0 {
-					if segments {
-						v.Segments = append(v.Segments, s)
-					} else {
-						v.Field = s
-					}
-				} else {
-					if pos > 0 {
-						if segments {
-							v.Segments = append(v.Segments, s[:pos])
-						} else {
-							v.Field = s[:pos]
-						}
-					}
-					p := s[pos+1:]
-					v.Pattern = &p
-				}
+	last := len(path.Segments) - 1
+	for i, segment := range path.Segments {
+		if segment.Wildcard != nil && *segment.Wildcard == "**" && i != last {
+			return nil, participle.Errorf(segment.Pos, "`**` is only allowed as last segment")
+		}
+		if segment.Variable == nil {
+			continue
+		}
+		v := segment.Variable
+		if !patternFieldPath.MatchString(v.Field) {
+			return nil, participle.Errorf(v.Pos, "invalid field path `%s`", v.Field)
+		}
+		for j, s := range v.Segments {
+			if s == "**" && (i != last || j != len(v.Segments)-1) {
+				return nil, participle.Errorf(v.Pos, "`**` is only allowed as last segment")
 			}
-			path.Segments = append(path.Segments, Segment{
-				Variable: &v,
-			})
-		} else {
-			path.Segments = append(path.Segments, Segment{
-				Literal: &s,
-			})
 		}
 	}
 
-	return &path, nil
+	return path, nil
 }
@@ -126,3 +126,42 @@ func (s *ParserTestSuite) TestParsePath_Complex() {
 	s.Equal("**", *p.Segments[4].Wildcard)
 	s.Require().Len(p.Segments[3].Variable.Segments, 2)
 }
+
+func (s *ParserTestSuite) TestParsePath_FieldPath() {
+	path := `/v1/{book.name=shelves/*/books/**}`
+
+	p, err := ParsePath(path)
+
+	s.Require().NoError(err)
+	s.Require().Len(p.Segments, 2)
+	s.Equal("book.name", p.Segments[1].Variable.Field)
+	s.Equal([]string{"shelves", "*", "books", "**"}, p.Segments[1].Variable.Segments)
+}
+
+func (s *ParserTestSuite) TestParsePath_Root() {
+	p, err := ParsePath(`/`)
+
+	s.Require().NoError(err)
+	s.Empty(p.Segments)
+}
+
+func (s *ParserTestSuite) TestParsePath_Unterminated() {
+	_, err := ParsePath(`/v1/{name=shelves/*`)
+
+	s.Require().Error(err)
+	s.Contains(err.Error(), "1:20:")
+}
+
+func (s *ParserTestSuite) TestParsePath_DoubleWildcardNotLast() {
+	_, err := ParsePath(`/v1/**/entity`)
+
+	s.Require().Error(err)
+	s.Equal("1:5: `**` is only allowed as last segment", err.Error())
+}
+
+func (s *ParserTestSuite) TestParsePath_InvalidFieldPath() {
+	_, err := ParsePath(`/v1/{1name}`)
+
+	s.Require().Error(err)
+	s.Equal("1:6: invalid field path `1name`", err.Error())
+}
@@ -0,0 +1,722 @@
+package io
+
+import (
+	"bytes"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/pkg/errors"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// indentation is the indentation of a single level
+const indentation = "  "
+
+// Comment is a comment of a proto file including its position.
+type Comment struct {
+	Pos  lexer.Position
+	Text string
+}
+
+// lastLine returns the line the comment ends on, block comments may span multiple lines.
+func (c *Comment) lastLine() int {
+	return c.Pos.Line + strings.Count(c.Text, "\n")
+}
+
+// Comments contains the comments of a proto file and the positions of the
+// other tokens, they are needed to assign the comments to the elements.
+type Comments struct {
+	List   []*Comment
+	tokens []token
+}
+
+// token is the start offset and the end position of a token.
+type token struct {
+	start int
+	end   lexer.Position
+}
+
+// ParseComments returns all comments of a proto file ordered by their position.
+func ParseComments(filename string, data []byte) (*Comments, error) {
+	def := parser(true).Lexer()
+	lex, err := def.Lex(filename, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	commentType := def.Symbols()["Comment"]
+
+	comments := &Comments{
+		List:   make([]*Comment, 0),
+		tokens: make([]token, 0),
+	}
+	for {
+		t, err := lex.Next()
+		if err != nil {
+			return nil, err
+		}
+		if t.EOF() {
+			return comments, nil
+		}
+		if t.Type == commentType {
+			comments.List = append(comments.List, &Comment{Pos: t.Pos, Text: t.Value})
+			continue
+		}
+		end := t.Pos
+		end.Offset += len(t.Value)
+		end.Column += len(t.Value)
+		comments.tokens = append(comments.tokens, token{start: t.Pos.Offset, end: end})
+	}
+}
+
+// last returns the last token before pos. The end positions set by the
+// parser point to the token following an element, the element ends with the
+// token before.
+func (c *Comments) last(pos lexer.Position) token {
+	i, _ := slices.BinarySearchFunc(c.tokens, pos.Offset, func(t token, offset int) int {
+		return t.start - offset
+	})
+	if i == 0 {
+		return token{start: pos.Offset, end: pos}
+	}
+	return c.tokens[i-1]
+}
+
+// Format re-prints a proto file in the canonical style:
+//   - two spaces per indentation level
+//   - syntax, package, sorted imports and file options first
+//   - `=` of consecutive fields, enum values and options aligned
+//   - one blank line between top-level definitions, at most one blank line
+//     within blocks
+//
+// Comments are preserved, either on their own lines or trailing the element
+// on the same line. The result is parsed again to ensure the definition did
+// not change.
+func Format(filename string, data []byte) ([]byte, error) {
+	proto, err := ParseString(filename, string(data), false)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := ParseComments(filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	out := Print(proto, comments)
+
+	formatted, err := ParseString(filename, string(out), false)
+	if err != nil {
+		return nil, errors.Wrap(err, "formatted file cannot be parsed")
+	}
+	if !bytes.Equal(Print(proto, nil), Print(formatted, nil)) {
+		return nil, errors.Errorf("formatting changed the definition of %s", filename)
+	}
+
+	return out, nil
+}
+
+// Print prints the AST in the canonical style, the comments are inserted
+// based on their positions.
+func Print(proto *Proto, comments *Comments) []byte {
+	if comments == nil {
+		comments = &Comments{}
+	}
+	p := &printer{
+		source:   comments,
+		comments: comments.List,
+		used:     make([]bool, len(comments.List)),
+	}
+	p.proto(proto)
+	return p.bytes()
+}
+
+// line is a single line of the output. Lines with a value are aligned on the
+// `=` with their neighbours of the same indentation.
+type line struct {
+	indent  int
+	text    string
+	value   string
+	aligned bool
+	comment string
+	// raw lines are comments, they do not break the alignment
+	raw bool
+	// block lines open or close a nested declaration, the alignment
+	// restarts after them
+	block bool
+}
+
+type printer struct {
+	source   *Comments
+	comments []*Comment
+	used     []bool
+	lines    []*line
+	indent   int
+}
+
+func (p *printer) add(text string) {
+	p.lines = append(p.lines, &line{indent: p.indent, text: text})
+}
+
+func (p *printer) addAligned(text, value string) {
+	p.lines = append(p.lines, &line{indent: p.indent, text: text, value: value, aligned: true})
+}
+
+// addBlock adds a line opening or closing a nested declaration.
+func (p *printer) addBlock(text string) {
+	p.lines = append(p.lines, &line{indent: p.indent, text: text, block: true})
+}
+
+// blank adds an empty line unless the previous one is empty or opens a block.
+func (p *printer) blank() {
+	if len(p.lines) == 0 {
+		return
+	}
+	last := p.lines[len(p.lines)-1]
+	if last.text == "" && !last.aligned || strings.HasSuffix(last.text, "{") || strings.HasSuffix(last.text, "[") {
+		return
+	}
+	p.lines = append(p.lines, &line{})
+}
+
+// take returns the unused comments within [from, to), they are marked as used.
+func (p *printer) take(from, to int) []*Comment {
+	res := make([]*Comment, 0)
+	for i, c := range p.comments {
+		if !p.used[i] && c.Pos.Offset >= from && c.Pos.Offset < to {
+			p.used[i] = true
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// printComments prints comments on their own lines, blank lines between them
+// and before next are preserved.
+func (p *printer) printComments(comments []*Comment, next int) {
+	for i, c := range comments {
+		text := c.Text
+		if strings.HasPrefix(text, "/*") {
+			// keep the layout of block comments
+			p.lines = append(p.lines, &line{indent: p.indent, text: text, raw: true})
+		} else {
+			p.lines = append(p.lines, &line{indent: p.indent, text: strings.TrimRight(text, " \t\r\n"), raw: true})
+		}
+		nextLine := next
+		if i+1 < len(comments) {
+			nextLine = comments[i+1].Pos.Line
+		}
+		if nextLine > c.lastLine()+1 {
+			p.blank()
+		}
+	}
+}
+
+// leading prints the comments between prev and pos. A blank line is kept if
+// the source contains one.
+func (p *printer) leading(prev *lexer.Position, pos lexer.Position) {
+	comments := p.take(prev.Offset, pos.Offset)
+	first := pos.Line
+	if len(comments) > 0 {
+		first = comments[0].Pos.Line
+	}
+	if prev.Line > 0 && first > prev.Line+1 {
+		p.blank()
+	}
+	p.printComments(comments, pos.Line)
+}
+
+// trailing attaches the comments on the line the element ends on to the last
+// line, endPos is the position of the token following the element.
+func (p *printer) trailing(endPos lexer.Position) {
+	end := p.source.last(endPos).end
+	for i, c := range p.comments {
+		if !p.used[i] && c.Pos.Line == end.Line && c.Pos.Offset >= end.Offset {
+			p.used[i] = true
+			p.attach(c.Text)
+		}
+	}
+}
+
+// trailingOpen attaches the comments following the opening brace of a block
+// on the same line, they belong to the block itself.
+func (p *printer) trailingOpen(pos lexer.Position, before int) {
+	for i, c := range p.comments {
+		if !p.used[i] && c.Pos.Line == pos.Line && c.Pos.Offset > pos.Offset && c.Pos.Offset < before {
+			p.used[i] = true
+			p.attach(c.Text)
+		}
+	}
+}
+
+func (p *printer) attach(comment string) {
+	last := p.lines[len(p.lines)-1]
+	if last.comment != "" {
+		last.comment += " "
+	}
+	last.comment += strings.TrimRight(comment, " \t\r\n")
+}
+
+// child prints an element of a block including its comments.
+func (p *printer) child(prev *lexer.Position, pos, end lexer.Position, printEntry func()) {
+	p.leading(prev, pos)
+	printEntry()
+	p.trailing(end)
+	*prev = p.source.last(end).end
+}
+
+// closeBlock prints the comments before the closing brace and the brace
+// itself, end is the position of the token following the block.
+func (p *printer) closeBlock(prev *lexer.Position, end lexer.Position, suffix string) {
+	comments := p.take(prev.Offset, p.source.last(end).start)
+	if len(comments) > 0 && prev.Line > 0 && comments[0].Pos.Line > prev.Line+1 {
+		p.blank()
+	}
+	p.printComments(comments, 0)
+	p.indent--
+	p.addBlock("}" + suffix)
+}
+
+func (p *printer) bytes() []byte {
+	// align consecutive lines on `=`
+	for i := 0; i < len(p.lines); {
+		if !p.lines[i].aligned {
+			i++
+			continue
+		}
+		j, width := i, 0
+		for ; j < len(p.lines); j++ {
+			l := p.lines[j]
+			if l.block {
+				break
+			}
+			if l.raw && l.indent == p.lines[i].indent {
+				continue
+			}
+			if !l.aligned || l.indent != p.lines[i].indent {
+				break
+			}
+			width = max(width, len(l.text))
+		}
+		for k := i; k < j; k++ {
+			if p.lines[k].aligned {
+				p.lines[k].text += strings.Repeat(" ", width-len(p.lines[k].text))
+			}
+		}
+		i = j
+	}
+
+	var b strings.Builder
+	for _, l := range p.lines {
+		text := l.text
+		if l.aligned {
+			text += " = " + l.value
+		}
+		if l.comment != "" {
+			text += " " + l.comment
+		}
+		if text != "" {
+			b.WriteString(strings.Repeat(indentation, l.indent))
+		}
+		b.WriteString(text)
+		b.WriteString("\n")
+	}
+	return []byte(b.String())
+}
+
+func (p *printer) proto(proto *Proto) {
+	// assign the comments between the top-level entries before reordering them
+	type entry struct {
+		*Entry
+		leading  []*Comment
+		trailing []*Comment
+	}
+	var header, imports, options, definitions []*entry
+	prev := 0
+	for _, e := range proto.Entries {
+		if e.Comment != "" {
+			continue
+		}
+		en := &entry{Entry: e, leading: p.take(prev, e.Pos.Offset)}
+		end := p.source.last(e.EndPos).end
+		for i, c := range p.comments {
+			if !p.used[i] && c.Pos.Line == end.Line && c.Pos.Offset >= end.Offset {
+				p.used[i] = true
+				en.trailing = append(en.trailing, c)
+			}
+		}
+		prev = end.Offset
+		switch {
+		case e.Syntax != "" || e.Package != "":
+			header = append(header, en)
+		case e.Import != "":
+			imports = append(imports, en)
+		case e.Option != nil:
+			options = append(options, en)
+		default:
+			definitions = append(definitions, en)
+		}
+	}
+	slices.SortStableFunc(imports, func(a, b *entry) int {
+		return strings.Compare(a.Import, b.Import)
+	})
+
+	printEntry := func(e *entry) {
+		p.printComments(e.leading, e.Pos.Line)
+		switch {
+		case e.Syntax != "":
+			p.add("syntax = " + strconv.Quote(e.Syntax) + ";")
+		case e.Package != "":
+			p.add("package " + e.Package + ";")
+		case e.Import != "":
+			p.add("import " + strconv.Quote(e.Import) + ";")
+		case e.Option != nil:
+			p.option(e.Option, ";")
+		case e.Message != nil:
+			p.message(e.Message)
+		case e.Service != nil:
+			p.service(e.Service)
+		case e.Enum != nil:
+			p.enum(e.Enum)
+		case e.Extend != nil:
+			p.extend(e.Extend)
+		}
+		for _, c := range e.trailing {
+			p.attach(c.Text)
+		}
+	}
+
+	for _, e := range header {
+		printEntry(e)
+		p.blank()
+	}
+	for _, group := range [][]*entry{imports, options} {
+		for _, e := range group {
+			printEntry(e)
+		}
+		p.blank()
+	}
+	for _, e := range definitions {
+		printEntry(e)
+		p.blank()
+	}
+
+	// dangling comments at the end of the file
+	p.printComments(p.take(0, int(^uint(0)>>1)), 0)
+	for len(p.lines) > 0 && p.lines[len(p.lines)-1].text == "" && !p.lines[len(p.lines)-1].aligned {
+		p.lines = p.lines[:len(p.lines)-1]
+	}
+}
+
+// optionName returns the name of the option as it is written in the file.
+func optionName(o *Option) string {
+	name := o.Name
+	if o.Custom {
+		name = "(" + name + ")"
+	}
+	if o.Attr != nil {
+		name += "." + *o.Attr
+	}
+	return name
+}
+
+// option prints an option statement, maps are printed on multiple lines.
+func (p *printer) option(o *Option, suffix string) {
+	if o.Value != nil && o.Value.Map != nil && len(o.Value.Map.Entries) > 0 {
+		p.add("option " + optionName(o) + " = {")
+		p.mapEntries(o.Value.Map)
+		p.add("}" + suffix)
+		return
+	}
+	p.addAligned("option "+optionName(o), p.inlineValue(o.Value)+suffix)
+}
+
+// options returns the inline list of field or enum value options.
+func (p *printer) options(options []*Option) string {
+	if len(options) == 0 {
+		return ""
+	}
+	list := make([]string, len(options))
+	for i, o := range options {
+		list[i] = optionName(o) + " = " + p.inlineValue(o.Value)
+	}
+	return " [" + strings.Join(list, ", ") + "]"
+}
+
+func (p *printer) mapEntries(m *Map) {
+	p.indent++
+	prev := m.Pos
+	for _, entry := range m.Entries {
+		p.child(&prev, entry.Pos, entry.EndPos, func() {
+			key := p.inlineValue(entry.Key)
+			v := entry.Value
+			switch {
+			case v != nil && v.Map != nil && len(v.Map.Entries) > 0:
+				p.add(key + " {")
+				p.mapEntries(v.Map)
+				p.add("}")
+			case v != nil && v.Array != nil && slices.ContainsFunc(v.Array.Elements, func(e *Value) bool { return e.Map != nil }):
+				p.add(key + ": [")
+				p.indent++
+				for i, e := range v.Array.Elements {
+					suffix := ","
+					if i == len(v.Array.Elements)-1 {
+						suffix = ""
+					}
+					if e.Map != nil && len(e.Map.Entries) > 0 {
+						p.add("{")
+						p.mapEntries(e.Map)
+						p.add("}" + suffix)
+					} else {
+						p.add(p.inlineValue(e) + suffix)
+					}
+				}
+				p.indent--
+				p.add("]")
+			default:
+				p.add(key + ": " + p.inlineValue(v))
+			}
+		})
+	}
+	comments := p.take(prev.Offset, p.source.last(m.EndPos).start)
+	p.printComments(comments, 0)
+	p.indent--
+}
+
+// inlineValue returns the value on a single line.
+func (p *printer) inlineValue(v *Value) string {
+	switch {
+	case v == nil:
+		return ""
+	case v.String != nil:
+		return strconv.Quote(*v.String)
+	case v.Number != nil:
+		str := strconv.FormatFloat(*v.Number, 'g', -1, 64)
+		if !strings.ContainsAny(str, ".eEnN") {
+			str += ".0"
+		}
+		return str
+	case v.Int != nil:
+		return strconv.FormatInt(*v.Int, 10)
+	case v.Bool != nil:
+		return strconv.FormatBool(bool(*v.Bool))
+	case v.Reference != nil:
+		return *v.Reference
+	case v.Map != nil:
+		entries := make([]string, len(v.Map.Entries))
+		for i, e := range v.Map.Entries {
+			entries[i] = p.inlineValue(e.Key) + ": " + p.inlineValue(e.Value)
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	case v.Array != nil:
+		elements := make([]string, len(v.Array.Elements))
+		for i, e := range v.Array.Elements {
+			elements[i] = p.inlineValue(e)
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	}
+	return ""
+}
+
+func typeName(t *Type) string {
+	if t.Scalar != None {
+		return t.Scalar.GoString()
+	} else if t.Map != nil {
+		return "map<" + typeName(t.Map.Key) + ", " + typeName(t.Map.Value) + ">"
+	}
+	return t.Reference
+}
+
+func (p *printer) field(f *Field) {
+	label := ""
+	if f.Optional {
+		label = "optional "
+	} else if f.Required {
+		label = "required "
+	} else if f.Repeated {
+		label = "repeated "
+	}
+	p.addAligned(label+typeName(&f.Type)+" "+f.Name, strconv.Itoa(f.Tag)+p.options(f.Options)+";")
+}
+
+func ranges(list []Range) string {
+	res := make([]string, len(list))
+	for i, r := range list {
+		if r.Ident != "" {
+			res[i] = strconv.Quote(r.Ident)
+			continue
+		}
+		res[i] = strconv.Itoa(r.Start)
+		if r.Max {
+			res[i] += " to max"
+		} else if r.End != nil {
+			res[i] += " to " + strconv.Itoa(*r.End)
+		}
+	}
+	return strings.Join(res, ", ")
+}
+
+// open prints the first line of a block, empty blocks without comments are
+// closed on the same line.
+func (p *printer) open(text string, pos, end lexer.Position, empty bool) bool {
+	if empty && !p.hasComments(pos.Offset, p.source.last(end).start) {
+		p.addBlock(text + " {}")
+		return false
+	}
+	p.addBlock(text + " {")
+	p.indent++
+	return true
+}
+
+// hasComments checks whether there are unused comments within [from, to).
+func (p *printer) hasComments(from, to int) bool {
+	for i, c := range p.comments {
+		if !p.used[i] && c.Pos.Offset >= from && c.Pos.Offset < to {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *printer) message(m *Message) {
+	if !p.open("message "+m.Name, m.Pos, m.EndPos, len(m.Entries) == 0) {
+		return
+	}
+	prev := m.Pos
+	if len(m.Entries) > 0 {
+		p.trailingOpen(m.Pos, m.Entries[0].Pos.Offset)
+	}
+	for _, e := range m.Entries {
+		p.child(&prev, e.Pos, e.EndPos, func() {
+			switch {
+			case e.Field != nil:
+				p.field(e.Field)
+			case e.Option != nil:
+				p.option(e.Option, ";")
+			case e.Message != nil:
+				p.message(e.Message)
+			case e.Enum != nil:
+				p.enum(e.Enum)
+			case e.OneOf != nil:
+				p.oneOf(e.OneOf)
+			case e.Extend != nil:
+				p.extend(e.Extend)
+			case e.Reserved != nil:
+				p.add("reserved " + ranges(e.Reserved.Reserved) + ";")
+			case e.Extensions != nil:
+				p.add("extensions " + ranges(e.Extensions.Extensions) + ";")
+			}
+		})
+	}
+	p.closeBlock(&prev, m.EndPos, "")
+}
+
+func (p *printer) oneOf(o *OneOf) {
+	if !p.open("oneof "+o.Name, o.Pos, o.EndPos, len(o.Entries) == 0) {
+		return
+	}
+	prev := o.Pos
+	if len(o.Entries) > 0 {
+		p.trailingOpen(o.Pos, o.Entries[0].Pos.Offset)
+	}
+	for _, e := range o.Entries {
+		p.child(&prev, e.Pos, e.EndPos, func() {
+			if e.Field != nil {
+				p.field(e.Field)
+			} else if e.Option != nil {
+				p.option(e.Option, ";")
+			}
+		})
+	}
+	p.closeBlock(&prev, o.EndPos, "")
+}
+
+func (p *printer) enum(e *Enum) {
+	if !p.open("enum "+e.Name, e.Pos, e.EndPos, len(e.Values) == 0) {
+		return
+	}
+	prev := e.Pos
+	if len(e.Values) > 0 {
+		p.trailingOpen(e.Pos, e.Values[0].Pos.Offset)
+	}
+	for _, v := range e.Values {
+		p.child(&prev, v.Pos, v.EndPos, func() {
+			if v.Value != nil {
+				p.addAligned(v.Value.Key, strconv.Itoa(v.Value.Value)+p.options(v.Value.Options)+";")
+			} else if v.Option != nil {
+				p.option(v.Option, ";")
+			}
+		})
+	}
+	p.closeBlock(&prev, e.EndPos, "")
+}
+
+func (p *printer) extend(e *Extend) {
+	if !p.open("extend "+e.Reference, e.Pos, e.EndPos, len(e.Fields) == 0) {
+		return
+	}
+	prev := e.Pos
+	if len(e.Fields) > 0 {
+		p.trailingOpen(e.Pos, e.Fields[0].Pos.Offset)
+	}
+	for _, f := range e.Fields {
+		p.child(&prev, f.Pos, f.EndPos, func() {
+			p.field(f)
+		})
+	}
+	p.closeBlock(&prev, e.EndPos, "")
+}
+
+func (p *printer) service(s *Service) {
+	if !p.open("service "+s.Name, s.Pos, s.EndPos, len(s.Entries) == 0) {
+		return
+	}
+	prev := s.Pos
+	if len(s.Entries) > 0 {
+		p.trailingOpen(s.Pos, s.Entries[0].Pos.Offset)
+	}
+	for _, e := range s.Entries {
+		p.child(&prev, e.Pos, e.EndPos, func() {
+			if e.Option != nil {
+				p.option(e.Option, ";")
+			} else if e.Method != nil {
+				p.method(e.Method)
+			}
+		})
+	}
+	p.closeBlock(&prev, s.EndPos, "")
+}
+
+func (p *printer) method(m *Method) {
+	signature := "rpc " + m.Name + "("
+	if m.StreamingRequest {
+		signature += "stream "
+	}
+	signature += typeName(m.Request) + ") returns ("
+	if m.StreamingResponse {
+		signature += "stream "
+	}
+	signature += typeName(m.Response) + ")"
+
+	if len(m.Options) == 0 {
+		// keep the braces if there are comments inside
+		if !p.hasComments(m.Response.Pos.Offset, p.source.last(m.EndPos).start) {
+			p.add(signature + ";")
+			return
+		}
+		p.addBlock(signature + " {")
+		p.indent++
+		prev := m.Pos
+		p.closeBlock(&prev, m.EndPos, "")
+		return
+	}
+
+	p.addBlock(signature + " {")
+	p.indent++
+	prev := m.Pos
+	p.trailingOpen(m.Pos, m.Options[0].Pos.Offset)
+	for _, o := range m.Options {
+		p.child(&prev, o.Pos, o.EndPos, func() {
+			p.option(o, ";")
+		})
+	}
+	p.closeBlock(&prev, m.EndPos, "")
+}
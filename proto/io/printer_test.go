@@ -0,0 +1,214 @@
+package io
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type PrinterTestSuite struct {
+	suite.Suite
+}
+
+func TestPrinterTestSuite(t *testing.T) {
+	suite.Run(t, new(PrinterTestSuite))
+}
+
+func (s *PrinterTestSuite) format(input string) string {
+	out, err := Format("test.proto", []byte(input))
+	s.Require().NoError(err)
+
+	again, err := Format("test.proto", out)
+	s.Require().NoError(err)
+	s.Equal(string(out), string(again), "formatting is not idempotent")
+
+	return string(out)
+}
+
+func (s *PrinterTestSuite) TestFormat_Canonical() {
+	input := `syntax="proto3";
+package test;
+import "b.proto";
+import "a.proto";
+option go_package="x/y";
+message A{
+int32 id=1;
+map<string,int32> values=2 [deprecated=true];
+    repeated string long_name = 3;
+  oneof kind{string name=4;int64 number=5;}
+reserved 6, 8 to 10;
+message Nested{}
+}
+enum E{E_UNKNOWN=0;E_NEG=-1;}
+service S{rpc Get(A)returns(A);rpc Watch(stream A)returns(stream A){}}
+`
+	expected := `syntax = "proto3";
+
+package test;
+
+import "a.proto";
+import "b.proto";
+
+option go_package = "x/y";
+
+message A {
+  int32 id                  = 1;
+  map<string, int32> values = 2 [deprecated = true];
+  repeated string long_name = 3;
+  oneof kind {
+    string name  = 4;
+    int64 number = 5;
+  }
+  reserved 6, 8 to 10;
+  message Nested {}
+}
+
+enum E {
+  E_UNKNOWN = 0;
+  E_NEG     = -1;
+}
+
+service S {
+  rpc Get(A) returns (A);
+  rpc Watch(stream A) returns (stream A);
+}
+`
+	s.Equal(expected, s.format(input))
+}
+
+func (s *PrinterTestSuite) TestFormat_Comments() {
+	input := `// file comment
+syntax = "proto3";
+
+package test;
+
+/* block
+   comment */
+message A { // the message
+  // leading
+
+  // second
+  int32 a = 1; // trailing
+  int32 b = 2;
+
+
+  // dangling
+}
+
+service S {
+  rpc Get(A) returns (A) {
+    // kept inside
+  }
+}
+
+// end of file
+`
+	expected := `// file comment
+syntax = "proto3";
+
+package test;
+
+/* block
+   comment */
+message A { // the message
+  // leading
+
+  // second
+  int32 a = 1; // trailing
+  int32 b = 2;
+
+  // dangling
+}
+
+service S {
+  rpc Get(A) returns (A) {
+    // kept inside
+  }
+}
+
+// end of file
+`
+	s.Equal(expected, s.format(input))
+}
+
+func (s *PrinterTestSuite) TestFormat_Options() {
+	input := `syntax = "proto3";
+service S {
+  option (config) = { HttpPrefix: "/api" };
+  rpc Get(A) returns (A) {
+    option (google.api.http) = { get: "/a/{a}" additional_bindings { post: "/a" body: "*" } };
+  }
+}
+message A {
+  string a = 1 [(buf.validate.field).string = {min_len: 1, max_len: 5}, json_name = "x"];
+  double b = 2 [default = 1];
+}
+`
+	expected := `syntax = "proto3";
+
+service S {
+  option (config) = {
+    HttpPrefix: "/api"
+  };
+  rpc Get(A) returns (A) {
+    option (google.api.http) = {
+      get: "/a/{a}"
+      additional_bindings {
+        post: "/a"
+        body: "*"
+      }
+    };
+  }
+}
+
+message A {
+  string a = 1 [(buf.validate.field).string = {min_len: 1, max_len: 5}, json_name = "x"];
+  double b = 2 [default = 1];
+}
+`
+	s.Equal(expected, s.format(input))
+}
+
+func (s *PrinterTestSuite) TestFormat_NestedAlignment() {
+	input := `syntax = "proto3";
+message A {
+  string id = 1;
+  // inner message
+  message Inner {
+    int32 x = 1;
+    repeated string long_inner_name = 2;
+  }
+  int32 long_field_name = 2;
+  enum Kind { KIND_UNSPECIFIED = 0; KIND_A = 1; }
+  map<string, string> labels = 3;
+  oneof value { string s = 4; }
+  bool ok = 5;
+}
+`
+	expected := `syntax = "proto3";
+
+message A {
+  string id = 1;
+  // inner message
+  message Inner {
+    int32 x                         = 1;
+    repeated string long_inner_name = 2;
+  }
+  int32 long_field_name = 2;
+  enum Kind {
+    KIND_UNSPECIFIED = 0;
+    KIND_A           = 1;
+  }
+  map<string, string> labels = 3;
+  oneof value {
+    string s = 4;
+  }
+  bool ok = 5;
+}
+`
+	s.Equal(expected, s.format(input))
+}
+
+func (s *PrinterTestSuite) TestFormat_Invalid() {
+	_, err := Format("test.proto", []byte(`message A {`))
+	s.Error(err)
+}
@@ -3,16 +3,89 @@ package proto
 import (
 	"errors"
 	"fmt"
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/iancoleman/strcase"
 	"github.com/niiigoo/hawk/proto/io"
-	errors2 "github.com/pkg/errors"
+	"github.com/niiigoo/hawk/proto/options"
+	"os"
 	"path"
+	"path/filepath"
+	"regexp"
 	"strings"
 )
 
 type ProtocConfig struct {
 	Imports []string
+	// Compiler selects the compiler of the proto file, the built-in one is
+	// used unless it is CompilerProtoc
+	Compiler string
+	// Googleapis is a checkout of googleapis used instead of the bundled files
+	Googleapis string
+	Lint       LintConfig
+	// Templates is a directory of templates overriding the embedded ones of
+	// `hawk generate`, `.hawk/templates` is used if empty
+	Templates string
+	// Plugins are external generators run by `hawk generate`
+	Plugins []PluginConfig
 }
 
+// resolve expands the environment variables of the paths and makes the
+// relative ones relative to dir, the directory of `protoc.yaml`.
+func (c *ProtocConfig) resolve(dir string) {
+	for i, p := range c.Imports {
+		c.Imports[i] = resolvePath(dir, p)
+	}
+	if c.Googleapis != "" {
+		c.Googleapis = resolvePath(dir, c.Googleapis)
+	}
+	if c.Templates != "" {
+		c.Templates = resolvePath(dir, c.Templates)
+	}
+	for i, plugin := range c.Plugins {
+		// commands without a separator are looked up in the PATH
+		if strings.ContainsRune(filepath.ToSlash(plugin.Command), '/') {
+			c.Plugins[i].Command = resolvePath(dir, plugin.Command)
+		}
+	}
+}
+
+func resolvePath(dir, p string) string {
+	p = os.ExpandEnv(p)
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(dir, p)
+}
+
+// PluginConfig configures an external generator, see the package plugin.
+type PluginConfig struct {
+	// Name identifies the plugin in logs and errors, the base name of the
+	// command is used if empty
+	Name    string
+	Command string
+	Args    []string
+	// Parameter is passed to the plugin as is
+	Parameter string
+	// Out is the directory the files of the plugin are written to, relative to
+	// the project root
+	Out string
+}
+
+// LintConfig configures `hawk lint`.
+type LintConfig struct {
+	// Rules enables or disables single rules by their ID, rules not listed are enabled
+	Rules map[string]bool
+}
+
+// The IDs of the lint rules reported while building the definition.
+const (
+	RuleHttpNonBaseType  = "HTTP_NON_BASE_TYPE"
+	RuleHttpRepeatedPath = "HTTP_REPEATED_PATH"
+	RuleHttpStreaming    = "HTTP_STREAMING"
+	RuleServiceConfigKey = "SERVICE_CONFIG_KEY"
+)
+
 type Type int
 
 const (
@@ -27,30 +100,82 @@ type Location string
 
 const (
 	LocationPath  Location = "path"
-	LocationQuery          = "query"
-	LocationBody           = "body"
+	LocationQuery Location = "query"
+	LocationBody  Location = "body"
 )
 
 type Definition struct {
 	syntax      string
 	pack        string
+	options     []*io.Option
 	services    []*io.Service
 	imports     []string
 	enums       []*io.Enum
 	enumsMap    map[string]*io.Enum
 	messages    []*io.Message
 	MessagesMap map[string]*io.Message
+	symbols     *symbols
+	fields      map[*io.Field]*Field
+
+	// Messages contains the messages with their interpreted options, the keys
+	// are the same as the ones of MessagesMap
+	Messages map[string]*Message
 
 	Services []*Service
+	// Diagnostics contains the warnings found while building the definition
+	Diagnostics Diagnostics
 }
 
 func (d Definition) Package() string {
 	return d.pack
 }
 
+// Options returns the file-level options, e.g. `go_package`.
+func (d Definition) Options() []*io.Option {
+	return d.options
+}
+
+// GoPackage returns the import path and the package name declared by the
+// option `go_package`, e.g. `example.com/api/v1;apiv1`. The name defaults to
+// the last element of the import path. Both are empty if the option is
+// missing.
+func (d Definition) GoPackage() (string, string) {
+	return goPackageOf(d.options)
+}
+
+// goPackageOf returns the import path and the name of the Go package declared
+// by the option `go_package` of a file.
+func goPackageOf(options []*io.Option) (string, string) {
+	for _, option := range options {
+		if option.Custom || option.Name != "go_package" || option.Value == nil || option.Value.String == nil {
+			continue
+		}
+		importPath, name, ok := strings.Cut(*option.Value.String, ";")
+		if !ok {
+			name = path.Base(importPath)
+		}
+		return importPath, name
+	}
+	return "", ""
+}
+
+// Enums returns all enums including the nested and imported ones.
+func (d Definition) Enums() []*io.Enum {
+	return d.enums
+}
+
+// EnumsMap returns the enums by name, the keys are the same as the ones of
+// MessagesMap.
+func (d Definition) EnumsMap() map[string]*io.Enum {
+	return d.enumsMap
+}
+
 type Service struct {
 	*io.Service
-	Name          string
+	Name    string
+	Package string
+	// GoPackage is the import path of the option `go_package`
+	GoPackage     string
 	Description   string
 	HttpPrefix    string
 	Compressed    *bool
@@ -72,11 +197,46 @@ type Method struct {
 	HttpBindings   []*OptionHttp
 	Compressed     bool
 	WebSocket      bool
+	Deprecated     bool
 
 	Parent *Service
+	// RequestSymbol and ResponseSymbol are the resolved messages, nil if they
+	// are unknown
+	RequestSymbol  *Symbol
+	ResponseSymbol *Symbol
+}
+
+// GoRequest returns the Go type of the request as it is referenced by the
+// generated code, e.g. `pb.Outer_Inner`.
+func (m *Method) GoRequest() string {
+	return m.goType(m.RequestSymbol, m.Request)
+}
+
+// GoResponse returns the Go type of the response as it is referenced by the
+// generated code.
+func (m *Method) GoResponse() string {
+	return m.goType(m.ResponseSymbol, m.Response)
+}
+
+func (m *Method) goType(sym *Symbol, name string) string {
+	if sym == nil {
+		return "pb." + strcase.ToCamel(name)
+	}
+	goPackage := ""
+	if m.Parent != nil {
+		goPackage = m.Parent.GoPackage
+	}
+	return sym.GoType(goPackage)
+}
+
+// Streaming reports whether the method is a client-, server- or bidirectional
+// streaming method.
+func (m *Method) Streaming() bool {
+	return m.RequestStream || m.ResponseStream
 }
 
 type OptionHttp struct {
+	Pos          lexer.Position
 	Method       string
 	PathRaw      string
 	Body         string
@@ -89,78 +249,128 @@ type OptionHttp struct {
 }
 
 type Param struct {
-	*io.Field
+	*Field
 	Type        Type
 	Location    Location
 	OneOfFields map[string]*Param
+	// Symbol is the resolved message or enum, nil for scalars and maps
+	Symbol *Symbol
+}
+
+// Resolve looks up a message or an enum referenced from within scope, e.g. the
+// fully-qualified name of a message. Nil is returned if it cannot be found.
+func (d Definition) Resolve(scope, ref string) *Symbol {
+	return d.symbols.resolve(scope, ref)
 }
 
-func (d Definition) getType(field *io.Field) Type {
+func (d Definition) getType(scope string, field *io.Field) (Type, *Symbol) {
 	if field.Type.Scalar > io.None {
-		return TypeScalar
+		return TypeScalar, nil
 	} else if field.Type.Map != nil {
-		return TypeMap
-	} else if _, ok := d.MessagesMap[field.Type.Reference]; ok {
-		return TypeMessage
-	} else if _, ok = d.enumsMap[field.Type.Reference]; ok {
-		return TypeEnum
+		return TypeMap, nil
+	} else if sym := d.Resolve(scope, field.Type.Reference); sym != nil {
+		return sym.Type, sym
+	}
+	return 0, nil
+}
+
+func (d *Definition) newParam(scope string, field *io.Field) *Param {
+	t, sym := d.getType(scope, field)
+	return &Param{
+		Field:  d.field(field),
+		Type:   t,
+		Symbol: sym,
 	}
-	return 0
 }
 
+// GorillaMuxPath returns the path template of the binding in the format of
+// gorilla/mux including the http prefix of the service. Wildcards outside of
+// variables are turned into unnamed variables (`_wildcardN`). The custom verb
+// is kept as literal suffix (`/v1/{name}:cancel`).
 func (o *OptionHttp) GorillaMuxPath() string {
 	var path string
 	if o.Parent != nil && o.Parent.Parent != nil {
 		path = strings.TrimSuffix(o.Parent.Parent.HttpPrefix, "/")
 	}
+	if len(o.Path.Segments) == 0 {
+		path += "/"
+	}
 
-	l := len(o.Path.Segments)
 	for i, segment := range o.Path.Segments {
 		path += "/"
 		if segment.Literal != nil {
 			path += *segment.Literal
 		} else if segment.Wildcard != nil {
-			if len(*segment.Wildcard) > 1 && i == l-1 {
-				// TODO: Does gorilla support **?
-				path += ".*"
-			} else {
-				path += ".*"
-			}
+			path += fmt.Sprintf("{_wildcard%d:%s}", i, wildcardPattern(*segment.Wildcard))
 		} else if segment.Variable != nil {
 			path += "{" + segment.Variable.Field
 			if segment.Variable.Pattern != nil {
 				path += ":" + *segment.Variable.Pattern
+			} else if len(segment.Variable.Segments) > 0 {
+				patterns := make([]string, len(segment.Variable.Segments))
+				for j, s := range segment.Variable.Segments {
+					if s == "*" || s == "**" {
+						patterns[j] = wildcardPattern(s)
+					} else {
+						patterns[j] = regexp.QuoteMeta(s)
+					}
+				}
+				path += ":" + strings.Join(patterns, "/")
 			}
 			path += "}"
 		}
 	}
+	if o.Path.Verb != nil {
+		path += ":" + *o.Path.Verb
+	}
 
 	return path
 }
 
+// wildcardPattern returns the regular expression matching a single segment
+// (`*`) or the remaining segments (`**`).
+func wildcardPattern(wildcard string) string {
+	if wildcard == "**" {
+		return ".+"
+	}
+	return "[^/]+"
+}
+
+// CheckParams assigns the fields of the request message to the http bindings
+// of the method. All problems found are collected in the diagnostics of def,
+// the errors found for this method are returned.
 func (m *Method) CheckParams(def *Definition) error {
-	msg, ok := def.MessagesMap[m.Request]
-	if !ok {
-		return errors.New("message `" + m.Request + "` not found")
+	diagnostics := make(Diagnostics, 0)
+	defer func() {
+		def.Diagnostics = append(def.Diagnostics, diagnostics...)
+	}()
+
+	sym := def.Resolve(def.pack, m.Request)
+	if sym == nil || (sym.Message == nil && !sym.WellKnown) {
+		diagnostics.Errorf(m.Pos, "message `%s` not found (method `%s`)", m.Request, m.Name)
+		return diagnostics.Err()
+	}
+	msg := sym.Message
+	if msg == nil {
+		// the fields of the well-known types are not parsed, e.g. of
+		// google.protobuf.Empty
+		msg = &io.Message{}
 	}
 	fields := make(map[string]*Param)
 	for _, f := range msg.Entries {
 		if f.Field != nil {
-			fields[f.Field.Name] = &Param{
-				Field: f.Field,
-				Type:  def.getType(f.Field),
-			}
+			fields[f.Field.Name] = def.newParam(sym.FullName, f.Field)
 		} else if f.OneOf != nil {
 			fields[f.OneOf.Name] = &Param{
 				OneOfFields: map[string]*Param{},
-				Field:       &io.Field{Name: f.OneOf.Name},
+				Field:       def.field(&io.Field{Pos: f.OneOf.Pos, Name: f.OneOf.Name}),
 				Type:        TypeOneOf,
 			}
 			for _, entry := range f.OneOf.Entries {
-				fields[f.OneOf.Name].OneOfFields[entry.Field.Name] = &Param{
-					Field: entry.Field,
-					Type:  def.getType(entry.Field),
+				if entry.Field == nil {
+					continue
 				}
+				fields[f.OneOf.Name].OneOfFields[entry.Field.Name] = def.newParam(sym.FullName, entry.Field)
 			}
 		}
 	}
@@ -178,14 +388,28 @@ func (m *Method) CheckParams(def *Definition) error {
 		}
 
 		for _, s := range binding.Path.Segments {
-			if s.Variable != nil {
-				if _, ok = fields[s.Variable.Field]; !ok {
-					return errors.New(fmt.Sprintf("path parameter `%s` not found (method `%s`)", s.Variable.Field, m.Name))
-				}
-				fields[s.Variable.Field].Location = LocationPath
-				binding.Params = append(binding.Params, fields[s.Variable.Field])
-				params[s.Variable.Field] = true
+			if s.Wildcard != nil {
+				// the client has no value to fill the segment with
+				diagnostics.Errorf(binding.Pos, "wildcard `%s` is not bound to a field, use a variable like `{name=%s}` (method `%s`)", *s.Wildcard, *s.Wildcard, m.Name)
+				continue
+			}
+			if s.Variable == nil {
+				continue
+			}
+			// a field path (`book.name`) binds a field of a message, the
+			// message is decoded from all variables binding its fields
+			name, nested, _ := strings.Cut(s.Variable.Field, ".")
+			f, ok := fields[name]
+			if !ok || (nested != "" && !def.hasFieldPath(f.Symbol, nested)) {
+				diagnostics.Errorf(binding.Pos, "path parameter `%s` not found (method `%s`)", s.Variable.Field, m.Name)
+				continue
 			}
+			if params[name] {
+				continue
+			}
+			f.Location = LocationPath
+			binding.Params = append(binding.Params, f)
+			params[name] = true
 		}
 
 		if binding.Body != "*" {
@@ -195,12 +419,19 @@ func (m *Method) CheckParams(def *Definition) error {
 					binding.Params = append(binding.Params, f)
 					params[binding.Body] = true
 				} else {
-					return errors.New(fmt.Sprintf("body field `%s` not found (method `%s`)", binding.Body, m.Name))
+					diagnostics.Errorf(binding.Pos, "body field `%s` not found (method `%s`)", binding.Body, m.Name)
 				}
 			}
 
-			for name, handled := range params {
-				if handled {
+			// in the order of declaration, the map of the params is unordered
+			for _, entry := range msg.Entries {
+				name := ""
+				if entry.Field != nil {
+					name = entry.Field.Name
+				} else if entry.OneOf != nil {
+					name = entry.OneOf.Name
+				}
+				if handled, ok := params[name]; !ok || handled {
 					continue
 				}
 
@@ -208,19 +439,74 @@ func (m *Method) CheckParams(def *Definition) error {
 				binding.Params = append(binding.Params, fields[name])
 			}
 		}
+
+		for _, param := range binding.Params {
+			if param.Location == LocationBody || param.Type == TypeOneOf {
+				continue
+			}
+			if param.Type == TypeMap || (param.Type == 0 && param.Field.Type.Reference != "") ||
+				(param.Type == TypeMessage && (param.Repeated || !queryMessages[param.Symbol.FullName])) {
+				diagnostics.Reportf(param.Pos, SeverityWarning, RuleHttpNonBaseType, "%s.%s is a non-base type specified to be located outside of the body. "+
+					"Non-base types outside the body may result in generated code which fails to compile.", m.Name, param.Name)
+			}
+			if param.Repeated && param.Location == LocationPath {
+				diagnostics.Reportf(param.Pos, SeverityWarning, RuleHttpRepeatedPath, "%s.%s is a repeated field specified to be in the path. "+
+					"Repeated fields are not supported in the path and may result in generated code which fails to compile.", m.Name, param.Name)
+			}
+		}
 	}
 
-	return nil
+	return diagnostics.Err()
+}
+
+// queryMessages contains the messages the transport decodes from the path and
+// the query, e.g. a timestamp formatted as RFC 3339.
+var queryMessages = map[string]bool{
+	"google.protobuf.Timestamp": true,
 }
 
+// hasFieldPath reports whether the message sym declares the field path, e.g.
+// `author.name`. The fields of oneofs are considered as well.
+func (d Definition) hasFieldPath(sym *Symbol, fieldPath string) bool {
+	if sym == nil || sym.Message == nil {
+		return false
+	}
+	name, nested, _ := strings.Cut(fieldPath, ".")
+	for _, entry := range sym.Message.Entries {
+		candidates := make([]*io.Field, 0)
+		if entry.Field != nil {
+			candidates = append(candidates, entry.Field)
+		} else if entry.OneOf != nil {
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil {
+					candidates = append(candidates, e.Field)
+				}
+			}
+		}
+		for _, field := range candidates {
+			if field.Name != name {
+				continue
+			}
+			if nested == "" {
+				return true
+			}
+			_, fieldSym := d.getType(sym.FullName, field)
+			return d.hasFieldPath(fieldSym, nested)
+		}
+	}
+	return false
+}
+
+// CheckParams checks the parameters of all methods, see Method.CheckParams.
 func (s *Service) CheckParams(def *Definition) error {
+	diagnostics := make(Diagnostics, 0)
 	for _, method := range s.Methods {
-		err := method.CheckParams(def)
-		if err != nil {
-			return err
+		var methodDiagnostics Diagnostics
+		if errors.As(method.CheckParams(def), &methodDiagnostics) {
+			diagnostics = append(diagnostics, methodDiagnostics...)
 		}
 	}
-	return nil
+	return diagnostics.Err()
 }
 
 func (s *Service) CompressionEnabled() bool {
@@ -236,9 +522,88 @@ func (s *Service) CompressionUsed() bool {
 	return false
 }
 
-func (d Definition) methodFromProto(s *Service, method *io.Method) (*Method, error) {
+// StreamingUsed reports whether a method of the service streams its requests
+// or responses.
+func (s *Service) StreamingUsed() bool {
+	for _, m := range s.Methods {
+		if m.Streaming() {
+			return true
+		}
+	}
+	return false
+}
+
+// GoImports returns the Go packages of the requests and responses declared
+// outside the Go package of the service, the import paths are keyed by the
+// alias they are imported as (see Symbol.GoAlias).
+func (s *Service) GoImports() map[string]string {
+	imports := make(map[string]string)
+	for _, m := range s.Methods {
+		for _, sym := range []*Symbol{m.RequestSymbol, m.ResponseSymbol} {
+			if sym != nil && sym.GoPackage != "" && sym.GoPackage != s.GoPackage {
+				imports[sym.GoAlias()] = sym.GoPackage
+			}
+		}
+	}
+	return imports
+}
+
+// HttpExposed reports whether the service is reachable via HTTP, either by a
+// method with a HTTP binding or the WebSocket endpoint.
+func (s *Service) HttpExposed() bool {
+	if s.WSPath != "" {
+		return true
+	}
+	for _, m := range s.Methods {
+		if len(m.HttpBindings) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HttpPathPrefix returns the HttpPrefix with a trailing slash, all HTTP paths
+// of the service start with it.
+func (s *Service) HttpPathPrefix() string {
+	return strings.TrimSuffix(s.HttpPrefix, "/") + "/"
+}
+
+// CheckHttpPrefixes checks that the services reachable via HTTP can share a
+// listener: each of them needs a HttpPrefix not overlapping with the prefix
+// of another service. A single service does not need a prefix.
+func CheckHttpPrefixes(services []*Service) error {
+	exposed := make([]*Service, 0, len(services))
+	for _, s := range services {
+		if s.HttpExposed() {
+			exposed = append(exposed, s)
+		}
+	}
+	if len(exposed) < 2 {
+		return nil
+	}
+
+	diagnostics := make(Diagnostics, 0)
+	for i, s := range exposed {
+		if s.HttpPathPrefix() == "/" {
+			diagnostics.Errorf(s.Pos, "service `%s` requires a HttpPrefix, its HTTP endpoints are served together with %d other services", s.Name, len(exposed)-1)
+			continue
+		}
+		for _, other := range exposed[:i] {
+			if other.HttpPathPrefix() == "/" {
+				continue
+			}
+			if strings.HasPrefix(s.HttpPathPrefix(), other.HttpPathPrefix()) || strings.HasPrefix(other.HttpPathPrefix(), s.HttpPathPrefix()) {
+				diagnostics.Errorf(s.Pos, "HttpPrefix `%s` of service `%s` overlaps with `%s` of service `%s`", s.HttpPrefix, s.Name, other.HttpPrefix, other.Name)
+			}
+		}
+	}
+	return diagnostics.Err()
+}
+
+func (d *Definition) methodFromProto(s *Service, method *io.Method) *Method {
 	if method.Request == nil || method.Response == nil {
-		return nil, errors.New("invalid method definition (`" + method.Name + "`)")
+		d.Diagnostics.Errorf(method.Pos, "invalid method definition (`%s`)", method.Name)
+		return nil
 	}
 
 	m := &Method{
@@ -251,6 +616,14 @@ func (d Definition) methodFromProto(s *Service, method *io.Method) (*Method, err
 		HttpBindings:   make([]*OptionHttp, 0),
 		Parent:         s,
 	}
+	if sym := d.Resolve(d.pack, m.Request); sym != nil {
+		m.Request = sym.RelativeName(d.pack)
+		m.RequestSymbol = sym
+	}
+	if sym := d.Resolve(d.pack, m.Response); sym != nil {
+		m.Response = sym.RelativeName(d.pack)
+		m.ResponseSymbol = sym
+	}
 	if s.Compressed != nil {
 		m.Compressed = *s.Compressed
 	}
@@ -261,39 +634,68 @@ func (d Definition) methodFromProto(s *Service, method *io.Method) (*Method, err
 	for _, option := range method.Options {
 		if option.Name == "google.api.http" {
 			if method.StreamingRequest || method.StreamingResponse {
-				return nil, errors.New("streaming methods cannot have `google.api.http` option (method `" + method.Name + "`)")
+				d.Diagnostics.Reportf(option.Pos, SeverityError, RuleHttpStreaming, "streaming methods cannot have `google.api.http` option (method `%s`)", method.Name)
+				continue
 			}
 
 			if option.Value == nil || option.Value.Map == nil {
-				return nil, errors.New("invalid value provided for `google.api.http` (method `" + method.Name + "`)")
-			}
-			err := m.parseBinding(option.Value.Map.Entries)
-			if err != nil {
-				return nil, err
+				d.Diagnostics.Errorf(option.Pos, "invalid value provided for `google.api.http` (method `%s`)", method.Name)
+				continue
 			}
+			m.parseBinding(&d.Diagnostics, option.Pos, option.Value.Map.Entries)
+		} else if d.isOption(option, options.Method) {
+			d.methodOption(m, option)
 		} else if option.Name == "httpCompress" {
 			if option.Value == nil || option.Value.Bool == nil {
-				return nil, errors.New("invalid value provided for `httpCompress` (method `" + method.Name + "`)")
+				d.Diagnostics.Errorf(option.Pos, "invalid value provided for `httpCompress` (method `%s`)", method.Name)
+				continue
 			}
 			m.Compressed = bool(*option.Value.Bool)
 		} else if option.Name == "webSocket" {
 			if option.Value == nil || option.Value.Bool == nil {
-				return nil, errors.New("invalid value provided for `webSocket` (method `" + method.Name + "`)")
+				d.Diagnostics.Errorf(option.Pos, "invalid value provided for `webSocket` (method `%s`)", method.Name)
+				continue
 			}
 			m.WebSocket = bool(*option.Value.Bool)
+		} else if option.Name == optionDeprecated && option.Attr == nil {
+			m.Deprecated = d.boolOption(option)
 		}
 	}
 
-	return m, nil
+	return m
+}
+
+// pathErrorPosition returns the position of the error of io.ParsePath in the
+// proto file and its message without position. pos is the position of the
+// string literal containing the path template, the positions of the errors
+// are relative to the template.
+func pathErrorPosition(pos lexer.Position, err error) (lexer.Position, string) {
+	var parseErr participle.Error
+	if !errors.As(err, &parseErr) {
+		return pos, err.Error()
+	}
+	// the template starts after the opening quote
+	errPos := parseErr.Position()
+	pos.Offset += errPos.Offset + 1
+	pos.Column += errPos.Column
+	return pos, parseErr.Message()
 }
 
-func (m *Method) parseBinding(data []*io.MapEntry) error {
+// parseBinding adds the http binding declared at pos (including the additional
+// bindings) to the method. Invalid bindings are reported and skipped.
+func (m *Method) parseBinding(diagnostics *Diagnostics, pos lexer.Position, data []*io.MapEntry) {
 	b := &OptionHttp{
+		Pos:    pos,
 		Parent: m,
 	}
+	valid := true
+	// the position of the string literal of the path
+	pathPos := pos
 	for _, entry := range data {
 		if entry.Key == nil || entry.Key.Reference == nil {
-			return errors.New("invalid key of `google.api.http` (method `" + m.Name + "`)")
+			diagnostics.Errorf(entry.Pos, "invalid key of `google.api.http` (method `%s`)", m.Name)
+			valid = false
+			continue
 		}
 		switch *entry.Key.Reference {
 		case "get":
@@ -307,65 +709,87 @@ func (m *Method) parseBinding(data []*io.MapEntry) error {
 		case "delete":
 			b.Method = *entry.Key.Reference
 			if entry.Value == nil || entry.Value.String == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				diagnostics.Errorf(entry.Pos, "invalid value provided of `%s` (method `%s`)", *entry.Key.Reference, m.Name)
+				valid = false
+				continue
 			}
 			b.PathRaw = *entry.Value.String
+			pathPos = entry.Value.Pos
 		case "body":
 			if entry.Value == nil || entry.Value.String == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				diagnostics.Errorf(entry.Pos, "invalid value provided of `%s` (method `%s`)", *entry.Key.Reference, m.Name)
+				valid = false
+				continue
 			}
 			b.Body = *entry.Value.String
 		case "response_body":
 			if entry.Value == nil || entry.Value.String == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				diagnostics.Errorf(entry.Pos, "invalid value provided of `%s` (method `%s`)", *entry.Key.Reference, m.Name)
+				valid = false
+				continue
 			}
 			b.ResponseBody = *entry.Value.String
 		case "custom":
 			if entry.Value == nil || entry.Value.Map == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				diagnostics.Errorf(entry.Pos, "invalid value provided of `%s` (method `%s`)", *entry.Key.Reference, m.Name)
+				valid = false
+				continue
 			}
 			for _, e := range entry.Value.Map.Entries {
 				if e.Key == nil || e.Key.Reference == nil {
-					return errors.New("invalid attribute of `custom` (method `" + m.Name + "`)")
+					diagnostics.Errorf(e.Pos, "invalid attribute of `custom` (method `%s`)", m.Name)
+					valid = false
+					continue
 				}
 				if e.Value == nil || e.Value.String == nil {
-					return errors.New("invalid value provided of `" + *e.Key.Reference + "` (method `" + m.Name + "`)")
+					diagnostics.Errorf(e.Pos, "invalid value provided of `%s` (method `%s`)", *e.Key.Reference, m.Name)
+					valid = false
+					continue
 				}
 				if *e.Key.Reference == "kind" {
 					b.Method = *e.Value.String
 				} else if *e.Key.Reference == "path" {
 					b.PathRaw = *e.Value.String
+					pathPos = e.Value.Pos
 				}
 			}
 			if b.Method == "" || b.PathRaw == "" {
-				return errors.New("http binding incomplete (method `" + m.Name + "`)")
+				diagnostics.Errorf(entry.Pos, "http binding incomplete (method `%s`)", m.Name)
+				valid = false
 			}
 		case "additional_bindings":
 			if entry.Value == nil || entry.Value.Map == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.String + "` (method `" + m.Name + "`)")
-			}
-			err := m.parseBinding(entry.Value.Map.Entries)
-			if err != nil {
-				return err
+				diagnostics.Errorf(entry.Pos, "invalid value provided of `%s` (method `%s`)", *entry.Key.Reference, m.Name)
+				continue
 			}
+			m.parseBinding(diagnostics, entry.Pos, entry.Value.Map.Entries)
 		}
 	}
+	if !valid {
+		return
+	}
+	if b.Method == "" {
+		diagnostics.Errorf(pos, "http binding incomplete (method `%s`)", m.Name)
+		return
+	}
 	var err error
 	b.Path, err = io.ParsePath(b.PathRaw)
 	if err != nil {
-		return errors2.Wrap(err, "failed to parse path `"+b.PathRaw+"`")
+		errPos, msg := pathErrorPosition(pathPos, err)
+		diagnostics.Errorf(errPos, "failed to parse path `%s` (method `%s`): %s", b.PathRaw, m.Name, msg)
+		return
 	}
 	m.HttpBindings = append(m.HttpBindings, b)
-
-	return nil
 }
 
-func (d Definition) serviceFromProto(service *io.Service) (*Service, error) {
+func (d *Definition) serviceFromProto(service *io.Service) *Service {
 	s := &Service{
 		Service: service,
 		Name:    service.Name,
+		Package: d.pack,
 		Methods: make([]*Method, 0),
 	}
+	s.GoPackage, _ = d.GoPackage()
 	if service.Comments != nil {
 		for i, c := range service.Comments {
 			c = strings.Trim(c, "/* ")
@@ -375,48 +799,154 @@ func (d Definition) serviceFromProto(service *io.Service) (*Service, error) {
 			s.Description += c
 		}
 	}
+	// the options are applied first, they are the defaults of the methods
+	for _, entry := range service.Entries {
+		if entry.Option == nil {
+			continue
+		}
+		if d.isOption(entry.Option, options.Service) {
+			d.serviceOption(s, entry.Option, false)
+		} else if entry.Option.Name == "config" {
+			d.serviceOption(s, entry.Option, true)
+		}
+	}
 	for _, entry := range service.Entries {
 		if entry.Method != nil {
-			m, err := d.methodFromProto(s, entry.Method)
-			if err != nil {
-				return nil, err
-			}
-			s.Methods = append(s.Methods, m)
-		} else if entry.Option != nil {
-			if entry.Option.Name == "config" {
-				if entry.Option.Value == nil || entry.Option.Value.Map == nil {
-					return nil, errors.New("invalid value provided for `(httpConfig)`")
-				}
-				for _, mapEntry := range entry.Option.Value.Map.Entries {
-					switch *mapEntry.Key.Reference {
-					case "HttpPrefix":
-						s.HttpPrefix = *mapEntry.Value.String
-					case "HttpCompress":
-						if mapEntry.Value != nil && mapEntry.Value.Bool != nil {
-							s.Compressed = ref(bool(*mapEntry.Value.Bool))
-						}
-					case "WebSocketPath":
-						s.WSPath = *mapEntry.Value.String
-					case "WebSocketByDefault":
-						if mapEntry.Value != nil && mapEntry.Value.Bool != nil {
-							s.WSDefault = ref(bool(*mapEntry.Value.Bool))
-						}
-					case "WebSocketMaxMessageSize":
-						if mapEntry.Value != nil && mapEntry.Value.Int != nil {
-							s.WSMaxSize = uint(*mapEntry.Value.Int)
-						}
-					}
-				}
+			if m := d.methodFromProto(s, entry.Method); m != nil {
+				s.Methods = append(s.Methods, m)
 			}
 		}
 	}
 	if s.HttpPrefix != "" && s.WSPath != "" {
 		s.WSPath = path.Join(s.HttpPrefix, s.WSPath)
 	}
-	return s, nil
+	return s
+}
+
+// isOption reports whether option refers to the extension with the
+// fully-qualified name, the name is resolved relative to the package of the
+// definition like protoc does (`(service)` within the package `hawk`).
+func (d *Definition) isOption(option *io.Option, qualified string) bool {
+	name := strings.TrimPrefix(option.Name, ".")
+	if name == qualified {
+		return true
+	}
+	if strings.HasPrefix(option.Name, ".") {
+		return false
+	}
+	for scope := d.pack; scope != ""; scope = parentScope(scope) {
+		if scope+"."+name == qualified {
+			return true
+		}
+	}
+	return false
+}
+
+// parentScope returns the enclosing package (`a.b` -> `a`).
+func parentScope(scope string) string {
+	if i := strings.LastIndex(scope, "."); i >= 0 {
+		return scope[:i]
+	}
+	return ""
+}
+
+// optionEntries returns the entries of an option with a message value, the
+// single field of `(option).Field = value` is returned as entry as well.
+// Invalid entries are reported and skipped.
+func (d *Definition) optionEntries(option *io.Option, element string) []*io.MapEntry {
+	if option.Attr != nil {
+		return []*io.MapEntry{{Pos: option.Pos, Key: &io.Value{Reference: option.Attr}, Value: option.Value}}
+	}
+	if option.Value == nil || option.Value.Map == nil {
+		d.Diagnostics.Errorf(option.Pos, "invalid value provided for `(%s)` (%s)", option.Name, element)
+		return nil
+	}
+
+	entries := make([]*io.MapEntry, 0, len(option.Value.Map.Entries))
+	for _, entry := range option.Value.Map.Entries {
+		if entry.Key == nil || entry.Key.Reference == nil {
+			d.Diagnostics.Errorf(entry.Pos, "invalid key of `(%s)` (%s)", option.Name, element)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// serviceOption applies `(hawk.service)` to the service. The legacy option
+// `(config)` declared by the proto file itself is supported as well, its
+// unknown keys are reported as warnings instead of errors.
+func (d *Definition) serviceOption(s *Service, option *io.Option, legacy bool) {
+	element := fmt.Sprintf("service `%s`", s.Name)
+	for _, entry := range d.optionEntries(option, element) {
+		key := *entry.Key.Reference
+		switch key {
+		case "HttpPrefix":
+			s.HttpPrefix = d.stringEntry(option, entry, s.HttpPrefix, element)
+		case "HttpCompress":
+			s.Compressed = ref(d.boolEntry(option, entry, s.Compressed != nil && *s.Compressed, element))
+		case "WebSocketPath":
+			s.WSPath = d.stringEntry(option, entry, s.WSPath, element)
+		case "WebSocketByDefault":
+			s.WSDefault = ref(d.boolEntry(option, entry, s.WSDefault != nil && *s.WSDefault, element))
+		case "WebSocketMaxMessageSize":
+			if entry.Value == nil || entry.Value.Int == nil || *entry.Value.Int < 0 {
+				d.Diagnostics.Errorf(entry.Pos, "invalid value provided for `%s` of `(%s)` (%s)", key, option.Name, element)
+				continue
+			}
+			s.WSMaxSize = uint(*entry.Value.Int)
+		default:
+			if legacy {
+				d.Diagnostics.Reportf(entry.Pos, SeverityWarning, RuleServiceConfigKey, "unknown key `%s` of `(config)` (service `%s`)", key, s.Name)
+			} else {
+				d.Diagnostics.Errorf(entry.Pos, "unknown key `%s` of `(%s)` (%s)", key, option.Name, element)
+			}
+		}
+	}
 }
 
-func DefinitionFromProto(data *io.Proto) (*Definition, error) {
+// methodOption applies `(hawk.method)` to the method.
+func (d *Definition) methodOption(m *Method, option *io.Option) {
+	element := fmt.Sprintf("method `%s`", m.Name)
+	for _, entry := range d.optionEntries(option, element) {
+		switch key := *entry.Key.Reference; key {
+		case "HttpCompress":
+			m.Compressed = d.boolEntry(option, entry, m.Compressed, element)
+		case "WebSocket":
+			m.WebSocket = d.boolEntry(option, entry, m.WebSocket, element)
+		default:
+			d.Diagnostics.Errorf(entry.Pos, "unknown key `%s` of `(%s)` (%s)", key, option.Name, element)
+		}
+	}
+}
+
+// stringEntry returns the string value of the entry, invalid values are
+// reported and current is returned.
+func (d *Definition) stringEntry(option *io.Option, entry *io.MapEntry, current, element string) string {
+	if entry.Value == nil || entry.Value.String == nil {
+		d.Diagnostics.Errorf(entry.Pos, "invalid value provided for `%s` of `(%s)` (%s)", *entry.Key.Reference, option.Name, element)
+		return current
+	}
+	return *entry.Value.String
+}
+
+// boolEntry returns the boolean value of the entry, invalid values are
+// reported and current is returned.
+func (d *Definition) boolEntry(option *io.Option, entry *io.MapEntry, current bool, element string) bool {
+	if entry.Value == nil || entry.Value.Bool == nil {
+		d.Diagnostics.Errorf(entry.Pos, "invalid value provided for `%s` of `(%s)` (%s)", *entry.Key.Reference, option.Name, element)
+		return current
+	}
+	return bool(*entry.Value.Bool)
+}
+
+// DefinitionFromProto builds the definition of the given proto file. The
+// imported files are only used to resolve the referenced types, services
+// declared in them are ignored.
+// All semantic problems are collected in Definition.Diagnostics. If at least
+// one of them is an error, the diagnostics are returned as error together
+// with the incomplete definition.
+func DefinitionFromProto(data *io.Proto, imports ...*io.Proto) (*Definition, error) {
 	d := &Definition{
 		services:    make([]*io.Service, 0),
 		imports:     make([]string, 0),
@@ -424,15 +954,14 @@ func DefinitionFromProto(data *io.Proto) (*Definition, error) {
 		enumsMap:    make(map[string]*io.Enum),
 		messages:    make([]*io.Message, 0),
 		MessagesMap: make(map[string]*io.Message),
+		symbols:     newSymbols(),
+		fields:      make(map[*io.Field]*Field),
+		Messages:    make(map[string]*Message),
+		Diagnostics: make(Diagnostics, 0),
 	}
 
 	for _, entry := range data.Entries {
-		if entry.Message != nil {
-			d.messages = append(d.messages, entry.Message)
-			d.MessagesMap[entry.Message.Name] = entry.Message
-		} else if entry.Enum != nil {
-			d.enums = append(d.enums, entry.Enum)
-		} else if entry.Service != nil {
+		if entry.Service != nil {
 			d.services = append(d.services, entry.Service)
 		} else if entry.Syntax != "" {
 			d.syntax = entry.Syntax
@@ -440,23 +969,62 @@ func DefinitionFromProto(data *io.Proto) (*Definition, error) {
 			d.pack = entry.Package
 		} else if entry.Import != "" {
 			d.imports = append(d.imports, entry.Import)
+		} else if entry.Option != nil {
+			d.options = append(d.options, entry.Option)
 		}
 	}
+	d.addTypes(data)
+	for _, imported := range imports {
+		d.addTypes(imported)
+	}
 
 	d.Services = make([]*Service, len(d.services))
 	for i, service := range d.services {
-		s, err := d.serviceFromProto(service)
-		if err != nil {
-			return nil, err
+		s := d.serviceFromProto(service)
+		_ = s.CheckParams(d)
+		d.Services[i] = s
+	}
+
+	return d, d.Diagnostics.Err()
+}
+
+// addTypes registers the messages and enums of a file, including the nested
+// ones. They are always accessible by their fully-qualified name (`pkg.Name`).
+// Types declared in the package of the definition are accessible by their
+// relative name as well (`Outer.Inner`).
+func (d *Definition) addTypes(data *io.Proto) {
+	for _, sym := range d.symbols.addFile(data) {
+		names := []string{sym.FullName}
+		if sym.Package == d.pack && sym.Name != sym.FullName {
+			names = append(names, sym.Name)
 		}
-		err = s.CheckParams(d)
-		if err != nil {
-			return nil, err
+		var msg *Message
+		if sym.Message != nil {
+			msg = d.newMessage(sym)
+		}
+		for _, name := range names {
+			if sym.Message != nil {
+				d.MessagesMap[name] = sym.Message
+				d.Messages[name] = msg
+			} else if sym.Enum != nil {
+				d.enumsMap[name] = sym.Enum
+			}
+		}
+		if sym.Message != nil {
+			d.messages = append(d.messages, sym.Message)
+		} else if sym.Enum != nil {
+			d.enums = append(d.enums, sym.Enum)
 		}
-		d.Services[i] = s
 	}
+}
 
-	return d, nil
+func packageOf(data *io.Proto) string {
+	for _, entry := range data.Entries {
+		if entry.Package != "" {
+			return entry.Package
+		}
+	}
+	return ""
 }
 
 func ref[T any](v T) *T {
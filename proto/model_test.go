@@ -0,0 +1,97 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type ModelTestSuite struct {
+	suite.Suite
+}
+
+func TestModelTestSuite(t *testing.T) {
+	suite.Run(t, new(ModelTestSuite))
+}
+
+func (s *ModelTestSuite) gorillaMuxPath(prefix, raw string) string {
+	path, err := io.ParsePath(raw)
+	s.Require().NoError(err)
+	binding := &OptionHttp{
+		PathRaw: raw,
+		Path:    path,
+		Parent:  &Method{Parent: &Service{HttpPrefix: prefix}},
+	}
+	return binding.GorillaMuxPath()
+}
+
+func (s *ModelTestSuite) TestGorillaMuxPath() {
+	tests := map[string]string{
+		"/":                                 "/",
+		"/v1/{parent}/books":                "/v1/{parent}/books",
+		"/v1/{parent=shelves}/books":        "/v1/{parent:shelves}/books",
+		"/v1/{parent=shelves/*}/books":      "/v1/{parent:shelves/[^/]+}/books",
+		"/v1/{name=shelves/*/books/**}":     "/v1/{name:shelves/[^/]+/books/.+}",
+		"/v1/{id=v1/test:[0-9]+[a-z]{1,3}}": "/v1/{id:[0-9]+[a-z]{1,3}}",
+		"/v1/*/entity/**":                   "/v1/{_wildcard1:[^/]+}/entity/{_wildcard3:.+}",
+		"/v1/{name=a.b/*}":                  `/v1/{name:a\.b/[^/]+}`,
+		"/v1/{name=operations/*}:cancel":    "/v1/{name:operations/[^/]+}:cancel",
+		"/v1/{name}:cancel":                 "/v1/{name}:cancel",
+		"/v1/books:batchGet":                "/v1/books:batchGet",
+	}
+	for raw, want := range tests {
+		s.Equal(want, s.gorillaMuxPath("", raw), raw)
+	}
+}
+
+func (s *ModelTestSuite) TestGorillaMuxPath_Prefix() {
+	s.Equal("/api/v1/{id}", s.gorillaMuxPath("/api/", "/v1/{id}"))
+	s.Equal("/api/", s.gorillaMuxPath("/api", "/"))
+}
+
+func (s *ModelTestSuite) exposedService(name, prefix string) *Service {
+	return &Service{
+		Service:    &io.Service{Name: name},
+		Name:       name,
+		HttpPrefix: prefix,
+		Methods:    []*Method{{Name: "Get", HttpBindings: []*OptionHttp{{Method: "get", PathRaw: "/"}}}},
+	}
+}
+
+func (s *ModelTestSuite) TestCheckHttpPrefixes() {
+	s.NoError(CheckHttpPrefixes([]*Service{s.exposedService("Public", "")}))
+	s.NoError(CheckHttpPrefixes([]*Service{
+		s.exposedService("Public", "/api/public"),
+		s.exposedService("Admin", "/api/admin/"),
+		s.exposedService("AdminV2", "/api/admin2"),
+		// not reachable via HTTP, no prefix required
+		{Service: &io.Service{Name: "Internal"}, Name: "Internal"},
+	}))
+
+	err := CheckHttpPrefixes([]*Service{
+		s.exposedService("Public", "/api"),
+		s.exposedService("Admin", "/api/admin"),
+		s.exposedService("Other", "/"),
+	})
+	var diagnostics Diagnostics
+	s.Require().ErrorAs(err, &diagnostics)
+	s.Require().Len(diagnostics, 2)
+	s.Contains(diagnostics[0].Message, "`/api/admin` of service `Admin` overlaps with `/api` of service `Public`")
+	s.Contains(diagnostics[1].Message, "service `Other` requires a HttpPrefix")
+}
+
+func (s *ModelTestSuite) TestStreaming() {
+	srv := &Service{Methods: []*Method{{Name: "Get"}}}
+	s.False(srv.Methods[0].Streaming())
+	s.False(srv.StreamingUsed())
+
+	srv.Methods = append(srv.Methods,
+		&Method{Name: "Watch", ResponseStream: true},
+		&Method{Name: "Upload", RequestStream: true},
+		&Method{Name: "Chat", RequestStream: true, ResponseStream: true},
+	)
+	for _, m := range srv.Methods[1:] {
+		s.True(m.Streaming(), m.Name)
+	}
+	s.True(srv.StreamingUsed())
+}
@@ -0,0 +1,324 @@
+package proto
+
+import (
+	"fmt"
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/niiigoo/hawk/proto/options"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	optionJSONName   = "json_name"
+	optionDeprecated = "deprecated"
+	optionValidate   = "buf.validate.field"
+)
+
+// Message wraps a message with its interpreted options.
+type Message struct {
+	*io.Message
+	// FullName is the fully-qualified name without the leading dot
+	FullName   string
+	Deprecated bool
+	// Fields contains the fields of the message including the ones of oneofs
+	Fields []*Field
+	// Options contains the options not interpreted by hawk
+	Options []*io.Option
+}
+
+// Field wraps a field with its interpreted options.
+type Field struct {
+	*io.Field
+	// JSONName is the name used by the JSON mapping, either provided by the
+	// `json_name` option or derived from the name (`foo_bar` -> `fooBar`).
+	JSONName   string
+	Deprecated bool
+	// OneOf is the name of the oneof containing the field
+	OneOf string
+	// Validation contains the `buf.validate.field` constraints, nil if none are set
+	Validation *Validation
+	// Options contains the options not interpreted by hawk
+	Options []*io.Option
+}
+
+// Validation contains the constraints of `buf.validate.field`. The common
+// rules are available as typed values, Rules contains all of them.
+type Validation struct {
+	Required bool
+	// Kind is the type the rules are declared for, e.g. `string`, `int32` or `repeated`
+	Kind string
+
+	Const    *string
+	MinLen   *uint64
+	MaxLen   *uint64
+	Pattern  *string
+	Format   string
+	Gt       *float64
+	Gte      *float64
+	Lt       *float64
+	Lte      *float64
+	In       []string
+	NotIn    []string
+	MinItems *uint64
+	MaxItems *uint64
+	Unique   bool
+	// DefinedOnly restricts enums to the declared values
+	DefinedOnly bool
+
+	// Rules maps the path of each rule (`string.min_len`) to its value
+	Rules map[string]string
+}
+
+// validationFormats are the boolean rules of strings and bytes restricting the format.
+var validationFormats = []string{
+	"email", "hostname", "ip", "ipv4", "ipv6", "uri", "uri_ref", "address", "uuid", "tuuid",
+	"ip_with_prefixlen", "ipv4_with_prefixlen", "ipv6_with_prefixlen", "ip_prefix", "ipv4_prefix",
+	"ipv6_prefix", "host_and_port",
+}
+
+// Strings returns the rules formatted as `rule = value`, sorted by rule.
+func (v *Validation) Strings() []string {
+	res := make([]string, 0, len(v.Rules))
+	for rule, value := range v.Rules {
+		res = append(res, rule+" = "+value)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// JSONName derives the name of a field used by the JSON mapping the same way protoc does.
+func JSONName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, c := range name {
+		if c == '_' {
+			upper = true
+		} else if upper && c >= 'a' && c <= 'z' {
+			b.WriteRune(c - 'a' + 'A')
+			upper = false
+		} else {
+			b.WriteRune(c)
+			upper = false
+		}
+	}
+	return b.String()
+}
+
+// FormatValue returns the value of an option as it is written in the proto file.
+func FormatValue(v *io.Value) string {
+	if v == nil {
+		return ""
+	} else if v.Reference != nil {
+		return *v.Reference
+	} else if v.Bool != nil {
+		return strconv.FormatBool(bool(*v.Bool))
+	} else if v.Int != nil {
+		return strconv.FormatInt(*v.Int, 10)
+	} else if v.Number != nil {
+		return strconv.FormatFloat(*v.Number, 'f', -1, 64)
+	} else if v.String != nil {
+		return *v.String
+	} else if v.Array != nil {
+		strValues := make([]string, len(v.Array.Elements))
+		for i, v := range v.Array.Elements {
+			strValues[i] = FormatValue(v)
+		}
+		return "[" + strings.Join(strValues, ", ") + "]"
+	} else if v.Map != nil {
+		str := "{"
+		for i, entry := range v.Map.Entries {
+			if i > 0 {
+				str += ", "
+			}
+			str += FormatValue(entry.Key) + ": " + FormatValue(entry.Value)
+		}
+		str += "}"
+		return str
+	}
+
+	return ""
+}
+
+func (d *Definition) newMessage(sym *Symbol) *Message {
+	m := &Message{
+		Message:  sym.Message,
+		FullName: sym.FullName,
+		Fields:   make([]*Field, 0),
+		Options:  make([]*io.Option, 0),
+	}
+	for _, entry := range sym.Message.Entries {
+		if entry.Field != nil {
+			m.Fields = append(m.Fields, d.newField(entry.Field, ""))
+		} else if entry.OneOf != nil {
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil {
+					m.Fields = append(m.Fields, d.newField(e.Field, entry.OneOf.Name))
+				}
+			}
+		} else if entry.Option != nil {
+			if entry.Option.Name == optionDeprecated && entry.Option.Attr == nil {
+				m.Deprecated = d.boolOption(entry.Option)
+			} else {
+				m.Options = append(m.Options, entry.Option)
+			}
+		}
+	}
+	return m
+}
+
+func (d *Definition) newField(field *io.Field, oneOf string) *Field {
+	f := &Field{
+		Field:    field,
+		JSONName: JSONName(field.Name),
+		OneOf:    oneOf,
+		Options:  make([]*io.Option, 0),
+	}
+	d.fields[field] = f
+
+	for _, option := range field.Options {
+		switch {
+		case option.Name == optionJSONName && option.Attr == nil:
+			if option.Value == nil || option.Value.String == nil {
+				d.Diagnostics.Errorf(option.Pos, "invalid value provided for `%s` (field `%s`)", optionJSONName, field.Name)
+				continue
+			}
+			f.JSONName = *option.Value.String
+		case option.Name == optionDeprecated && option.Attr == nil:
+			f.Deprecated = d.boolOption(option)
+		case d.isOption(option, options.Field):
+			d.fieldOption(f, option)
+		case option.Name == optionValidate:
+			if f.Validation == nil {
+				f.Validation = &Validation{Rules: make(map[string]string)}
+			}
+			prefix := ""
+			if option.Attr != nil {
+				prefix = *option.Attr
+			}
+			f.Validation.add(prefix, option.Value)
+		default:
+			f.Options = append(f.Options, option)
+		}
+	}
+	return f
+}
+
+// fieldOption applies `(hawk.field)` to the field. No keys are declared yet,
+// all of them are reported as unknown.
+func (d *Definition) fieldOption(f *Field, option *io.Option) {
+	element := fmt.Sprintf("field `%s`", f.Name)
+	for _, entry := range d.optionEntries(option, element) {
+		d.Diagnostics.Errorf(entry.Pos, "unknown key `%s` of `(%s)` (%s)", *entry.Key.Reference, option.Name, element)
+	}
+}
+
+// field returns the wrapper of the field, fields of messages not registered
+// (e.g. the placeholder of a oneof) are wrapped on demand.
+func (d *Definition) field(field *io.Field) *Field {
+	if f, ok := d.fields[field]; ok {
+		return f
+	}
+	return d.newField(field, "")
+}
+
+func (d *Definition) boolOption(option *io.Option) bool {
+	if option.Value == nil || option.Value.Bool == nil {
+		d.Diagnostics.Errorf(option.Pos, "invalid value provided for `%s`, boolean expected", option.Name)
+		return false
+	}
+	return bool(*option.Value.Bool)
+}
+
+// add registers the rules of value, maps are flattened (`{min_len: 1}` -> `min_len`).
+func (v *Validation) add(path string, value *io.Value) {
+	if value != nil && value.Map != nil {
+		for _, entry := range value.Map.Entries {
+			key := FormatValue(entry.Key)
+			if path != "" {
+				key = path + "." + key
+			}
+			v.add(key, entry.Value)
+		}
+		return
+	}
+	if path == "" {
+		return
+	}
+
+	str := FormatValue(value)
+	v.Rules[path] = str
+
+	parts := strings.SplitN(path, ".", 2)
+	if len(parts) == 1 {
+		if parts[0] == "required" {
+			v.Required = str == "true"
+		}
+		return
+	}
+	v.Kind = parts[0]
+	rule := parts[1]
+	switch rule {
+	case "const":
+		v.Const = &str
+	case "len":
+		v.MinLen, v.MaxLen = parseUint(str), parseUint(str)
+	case "min_len", "min_bytes":
+		v.MinLen = parseUint(str)
+	case "max_len", "max_bytes":
+		v.MaxLen = parseUint(str)
+	case "pattern":
+		v.Pattern = &str
+	case "gt":
+		v.Gt = parseFloat(str)
+	case "gte":
+		v.Gte = parseFloat(str)
+	case "lt":
+		v.Lt = parseFloat(str)
+	case "lte":
+		v.Lte = parseFloat(str)
+	case "in":
+		v.In = arrayValues(value)
+	case "not_in":
+		v.NotIn = arrayValues(value)
+	case "min_items", "min_pairs":
+		v.MinItems = parseUint(str)
+	case "max_items", "max_pairs":
+		v.MaxItems = parseUint(str)
+	case "unique":
+		v.Unique = str == "true"
+	case "defined_only":
+		v.DefinedOnly = str == "true"
+	default:
+		if slices.Contains(validationFormats, rule) && str == "true" {
+			v.Format = rule
+		}
+	}
+}
+
+func arrayValues(value *io.Value) []string {
+	if value == nil || value.Array == nil {
+		return []string{FormatValue(value)}
+	}
+	values := make([]string, len(value.Array.Elements))
+	for i, e := range value.Array.Elements {
+		values[i] = FormatValue(e)
+	}
+	return values
+}
+
+func parseUint(str string) *uint64 {
+	v, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+func parseFloat(str string) *float64 {
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,337 @@
+// The options of hawk, import this file as `hawk/options.proto`.
+//
+// Version: v1
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: hawk/options.proto
+
+package hawk
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The options of a service.
+type Service struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The prefix of all HTTP paths, e.g. `/api/user`.
+	HttpPrefix string `protobuf:"bytes,1,opt,name=HttpPrefix,proto3" json:"HttpPrefix,omitempty"`
+	// Compresses the HTTP responses of all methods.
+	HttpCompress bool `protobuf:"varint,2,opt,name=HttpCompress,proto3" json:"HttpCompress,omitempty"`
+	// The path of the WebSocket endpoint relative to HttpPrefix, WebSocket is disabled if empty.
+	WebSocketPath string `protobuf:"bytes,3,opt,name=WebSocketPath,proto3" json:"WebSocketPath,omitempty"`
+	// The maximal size of a WebSocket message in bytes.
+	WebSocketMaxMessageSize uint32 `protobuf:"varint,4,opt,name=WebSocketMaxMessageSize,proto3" json:"WebSocketMaxMessageSize,omitempty"`
+	// The default of the method option WebSocket.
+	WebSocketByDefault bool `protobuf:"varint,5,opt,name=WebSocketByDefault,proto3" json:"WebSocketByDefault,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_hawk_options_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_hawk_options_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_hawk_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Service) GetHttpPrefix() string {
+	if x != nil {
+		return x.HttpPrefix
+	}
+	return ""
+}
+
+func (x *Service) GetHttpCompress() bool {
+	if x != nil {
+		return x.HttpCompress
+	}
+	return false
+}
+
+func (x *Service) GetWebSocketPath() string {
+	if x != nil {
+		return x.WebSocketPath
+	}
+	return ""
+}
+
+func (x *Service) GetWebSocketMaxMessageSize() uint32 {
+	if x != nil {
+		return x.WebSocketMaxMessageSize
+	}
+	return 0
+}
+
+func (x *Service) GetWebSocketByDefault() bool {
+	if x != nil {
+		return x.WebSocketByDefault
+	}
+	return false
+}
+
+// The options of a method, they take precedence over the ones of the service.
+type Method struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Compresses the HTTP response, overrides HttpCompress of the service.
+	HttpCompress *bool `protobuf:"varint,1,opt,name=HttpCompress,proto3,oneof" json:"HttpCompress,omitempty"`
+	// Marks the method to be used via WebSocket.
+	WebSocket     *bool `protobuf:"varint,2,opt,name=WebSocket,proto3,oneof" json:"WebSocket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Method) Reset() {
+	*x = Method{}
+	mi := &file_hawk_options_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Method) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Method) ProtoMessage() {}
+
+func (x *Method) ProtoReflect() protoreflect.Message {
+	mi := &file_hawk_options_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Method.ProtoReflect.Descriptor instead.
+func (*Method) Descriptor() ([]byte, []int) {
+	return file_hawk_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Method) GetHttpCompress() bool {
+	if x != nil && x.HttpCompress != nil {
+		return *x.HttpCompress
+	}
+	return false
+}
+
+func (x *Method) GetWebSocket() bool {
+	if x != nil && x.WebSocket != nil {
+		return *x.WebSocket
+	}
+	return false
+}
+
+// The options of a field, none are declared in v1 yet.
+type Field struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	mi := &file_hawk_options_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_hawk_options_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_hawk_options_proto_rawDescGZIP(), []int{2}
+}
+
+var file_hawk_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*Service)(nil),
+		Field:         10100,
+		Name:          "hawk.service",
+		Tag:           "bytes,10100,opt,name=service",
+		Filename:      "hawk/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Method)(nil),
+		Field:         10100,
+		Name:          "hawk.method",
+		Tag:           "bytes,10100,opt,name=method",
+		Filename:      "hawk/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*Field)(nil),
+		Field:         10100,
+		Name:          "hawk.field",
+		Tag:           "bytes,10100,opt,name=field",
+		Filename:      "hawk/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// Configures the transport layers of the service.
+	//
+	// optional hawk.Service service = 10100;
+	E_Service = &file_hawk_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// Configures the transport layers of the method.
+	//
+	// optional hawk.Method method = 10100;
+	E_Method = &file_hawk_options_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// Configures the field, reserved for the options of fields interpreted by hawk.
+	//
+	// optional hawk.Field field = 10100;
+	E_Field = &file_hawk_options_proto_extTypes[2]
+)
+
+var File_hawk_options_proto protoreflect.FileDescriptor
+
+const file_hawk_options_proto_rawDesc = "" +
+	"\n" +
+	"\x12hawk/options.proto\x12\x04hawk\x1a google/protobuf/descriptor.proto\"\xdd\x01\n" +
+	"\aService\x12\x1e\n" +
+	"\n" +
+	"HttpPrefix\x18\x01 \x01(\tR\n" +
+	"HttpPrefix\x12\"\n" +
+	"\fHttpCompress\x18\x02 \x01(\bR\fHttpCompress\x12$\n" +
+	"\rWebSocketPath\x18\x03 \x01(\tR\rWebSocketPath\x128\n" +
+	"\x17WebSocketMaxMessageSize\x18\x04 \x01(\rR\x17WebSocketMaxMessageSize\x12.\n" +
+	"\x12WebSocketByDefault\x18\x05 \x01(\bR\x12WebSocketByDefault\"s\n" +
+	"\x06Method\x12'\n" +
+	"\fHttpCompress\x18\x01 \x01(\bH\x00R\fHttpCompress\x88\x01\x01\x12!\n" +
+	"\tWebSocket\x18\x02 \x01(\bH\x01R\tWebSocket\x88\x01\x01B\x0f\n" +
+	"\r_HttpCompressB\f\n" +
+	"\n" +
+	"_WebSocket\"\a\n" +
+	"\x05Field:I\n" +
+	"\aservice\x12\x1f.google.protobuf.ServiceOptions\x18\xf4N \x01(\v2\r.hawk.ServiceR\aservice:E\n" +
+	"\x06method\x12\x1e.google.protobuf.MethodOptions\x18\xf4N \x01(\v2\f.hawk.MethodR\x06method:A\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18\xf4N \x01(\v2\v.hawk.FieldR\x05fieldB1Z/github.com/niiigoo/hawk/proto/options/hawk;hawkb\x06proto3"
+
+var (
+	file_hawk_options_proto_rawDescOnce sync.Once
+	file_hawk_options_proto_rawDescData []byte
+)
+
+func file_hawk_options_proto_rawDescGZIP() []byte {
+	file_hawk_options_proto_rawDescOnce.Do(func() {
+		file_hawk_options_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hawk_options_proto_rawDesc), len(file_hawk_options_proto_rawDesc)))
+	})
+	return file_hawk_options_proto_rawDescData
+}
+
+var file_hawk_options_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hawk_options_proto_goTypes = []any{
+	(*Service)(nil),                     // 0: hawk.Service
+	(*Method)(nil),                      // 1: hawk.Method
+	(*Field)(nil),                       // 2: hawk.Field
+	(*descriptorpb.ServiceOptions)(nil), // 3: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 4: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),   // 5: google.protobuf.FieldOptions
+}
+var file_hawk_options_proto_depIdxs = []int32{
+	3, // 0: hawk.service:extendee -> google.protobuf.ServiceOptions
+	4, // 1: hawk.method:extendee -> google.protobuf.MethodOptions
+	5, // 2: hawk.field:extendee -> google.protobuf.FieldOptions
+	0, // 3: hawk.service:type_name -> hawk.Service
+	1, // 4: hawk.method:type_name -> hawk.Method
+	2, // 5: hawk.field:type_name -> hawk.Field
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	3, // [3:6] is the sub-list for extension type_name
+	0, // [0:3] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hawk_options_proto_init() }
+func file_hawk_options_proto_init() {
+	if File_hawk_options_proto != nil {
+		return
+	}
+	file_hawk_options_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hawk_options_proto_rawDesc), len(file_hawk_options_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 3,
+			NumServices:   0,
+		},
+		GoTypes:           file_hawk_options_proto_goTypes,
+		DependencyIndexes: file_hawk_options_proto_depIdxs,
+		MessageInfos:      file_hawk_options_proto_msgTypes,
+		ExtensionInfos:    file_hawk_options_proto_extTypes,
+	}.Build()
+	File_hawk_options_proto = out.File
+	file_hawk_options_proto_goTypes = nil
+	file_hawk_options_proto_depIdxs = nil
+}
@@ -0,0 +1,45 @@
+// Package options bundles `hawk/options.proto` declaring the options of
+// services, methods and fields interpreted by hawk.
+package options
+
+import (
+	"embed"
+	"github.com/niiigoo/hawk/proto/googleapis"
+	"os"
+	"path/filepath"
+)
+
+// Version is the version of `hawk/options.proto`.
+const Version = "v1"
+
+// File is the name `hawk/options.proto` is imported with.
+const File = "hawk/options.proto"
+
+// The fully-qualified names of the options.
+const (
+	Service = "hawk.service"
+	Method  = "hawk.method"
+	Field   = "hawk.field"
+)
+
+//go:embed hawk/options.proto
+var files embed.FS
+
+// Dir returns the directory `hawk/options.proto` is materialized to, located
+// in the user's cache directory. Every version has its own directory.
+func Dir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "hawk", "options", Version)
+}
+
+// Includes writes `hawk/options.proto` to Dir and returns the include path.
+func Includes() ([]string, error) {
+	dir := Dir()
+	if err := googleapis.WriteFiles(files, dir); err != nil {
+		return nil, err
+	}
+	return []string{dir}, nil
+}
@@ -0,0 +1,50 @@
+package options_test
+
+import (
+	"github.com/niiigoo/hawk/proto"
+	"github.com/niiigoo/hawk/proto/options"
+	"github.com/niiigoo/hawk/proto/options/hawk"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type OptionsTestSuite struct {
+	suite.Suite
+}
+
+func TestOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
+
+func (s *OptionsTestSuite) TestIncludes() {
+	s.T().Setenv("XDG_CACHE_HOME", s.T().TempDir())
+
+	includes, err := options.Includes()
+	s.Require().NoError(err)
+	s.Equal([]string{options.Dir()}, includes)
+	s.FileExists(filepath.Join(options.Dir(), options.File))
+}
+
+func (s *OptionsTestSuite) TestNames() {
+	s.Equal(options.Service, string(hawk.E_Service.TypeDescriptor().FullName()))
+	s.Equal(options.Method, string(hawk.E_Method.TypeDescriptor().FullName()))
+	s.Equal(options.Field, string(hawk.E_Field.TypeDescriptor().FullName()))
+}
+
+// TestGenerated ensures the generated code is up-to-date, it is regenerated
+// if the environment variable HAWK_GENERATE is set.
+func (s *OptionsTestSuite) TestGenerated() {
+	out := s.T().TempDir()
+	s.Require().NoError(proto.Compile(options.File, out, "."))
+
+	generated, err := os.ReadFile(filepath.Join(out, "github.com/niiigoo/hawk/proto/options/hawk/options.pb.go"))
+	s.Require().NoError(err)
+	if os.Getenv("HAWK_GENERATE") != "" {
+		s.Require().NoError(os.WriteFile("hawk/options.pb.go", generated, 0666))
+	}
+	current, err := os.ReadFile("hawk/options.pb.go")
+	s.Require().NoError(err)
+	s.Equal(string(generated), string(current), "hawk/options.pb.go is outdated, run the test with HAWK_GENERATE=1")
+}
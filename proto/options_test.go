@@ -0,0 +1,226 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/niiigoo/hawk/proto/options"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type OptionsTestSuite struct {
+	suite.Suite
+	def *Definition
+}
+
+func TestOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
+
+func (s *OptionsTestSuite) SetupTest() {
+	p := NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package test;
+		import "buf/validate/validate.proto";
+		message Request {
+			option deprecated = true;
+			string user_name = 1 [json_name = "login", (buf.validate.field).string.min_len = 3];
+			string email = 2 [(buf.validate.field).string = {email: true, max_len: 64}, (buf.validate.field).required = true];
+			repeated int32 ids = 3 [(buf.validate.field).repeated.min_items = 1, deprecated = true];
+			int32 page_size = 4 [(buf.validate.field).int32 = {gt: 0, lte: 100, in: [10, 50, 100]}];
+			oneof filter {
+				string name_prefix = 5;
+			}
+		}
+		message Response {}
+		service Test {
+			rpc Get(Request) returns (Response) {
+				option deprecated = true;
+			}
+		}
+	`)
+	s.Require().NoError(err)
+	s.def = p.Definition()
+}
+
+func (s *OptionsTestSuite) fields() map[string]*Field {
+	msg := s.def.Messages["Request"]
+	s.Require().NotNil(msg)
+	fields := make(map[string]*Field)
+	for _, f := range msg.Fields {
+		fields[f.Name] = f
+	}
+	return fields
+}
+
+func (s *OptionsTestSuite) TestJSONName() {
+	s.Equal("fooBar", JSONName("foo_bar"))
+	s.Equal("fooBar2", JSONName("foo_bar2"))
+	s.Equal("Foo", JSONName("_foo"))
+	s.Equal("fooBAR", JSONName("foo_b_a_r"))
+}
+
+func (s *OptionsTestSuite) TestField_JSONName() {
+	fields := s.fields()
+
+	s.Equal("login", fields["user_name"].JSONName)
+	s.Equal("pageSize", fields["page_size"].JSONName)
+	s.Equal("namePrefix", fields["name_prefix"].JSONName)
+	s.Equal("filter", fields["name_prefix"].OneOf)
+}
+
+func (s *OptionsTestSuite) TestField_Deprecated() {
+	fields := s.fields()
+
+	s.True(fields["ids"].Deprecated)
+	s.False(fields["email"].Deprecated)
+	s.True(s.def.Messages["Request"].Deprecated)
+	s.True(s.def.Services[0].Methods[0].Deprecated)
+}
+
+func (s *OptionsTestSuite) TestField_Validation() {
+	fields := s.fields()
+
+	s.Require().NotNil(fields["user_name"].Validation)
+	s.Equal("string", fields["user_name"].Validation.Kind)
+	s.Equal(uint64(3), *fields["user_name"].Validation.MinLen)
+	s.Empty(fields["user_name"].Options)
+
+	email := fields["email"].Validation
+	s.Require().NotNil(email)
+	s.True(email.Required)
+	s.Equal("email", email.Format)
+	s.Equal(uint64(64), *email.MaxLen)
+	s.Equal([]string{"required = true", "string.email = true", "string.max_len = 64"}, email.Strings())
+
+	s.Equal(uint64(1), *fields["ids"].Validation.MinItems)
+
+	pageSize := fields["page_size"].Validation
+	s.Require().NotNil(pageSize)
+	s.Equal(float64(0), *pageSize.Gt)
+	s.Equal(float64(100), *pageSize.Lte)
+	s.Equal([]string{"10", "50", "100"}, pageSize.In)
+
+	s.Nil(fields["name_prefix"].Validation)
+}
+
+func (s *OptionsTestSuite) TestParams_Field() {
+	param := s.def.newParam("test.Request", s.fields()["user_name"].Field)
+	s.Equal("login", param.JSONName)
+}
+
+func (s *OptionsTestSuite) parseService(content string) (*Service, error) {
+	p := NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package test;
+		import "hawk/options.proto";
+		message Request {}
+	` + content)
+	if len(p.Definition().Services) == 0 {
+		return nil, err
+	}
+	return p.Definition().Services[0], err
+}
+
+func (s *OptionsTestSuite) TestHawkOptions() {
+	srv, err := s.parseService(`
+		service Test {
+			rpc Get(Request) returns (Request) {
+				option (hawk.method) = { HttpCompress: false };
+			}
+			rpc Watch(Request) returns (Request) {
+				option (hawk.method).WebSocket = false;
+			}
+			option (hawk.service) = {
+				HttpPrefix: "/api"
+				HttpCompress: true
+				WebSocketPath: "/ws"
+				WebSocketByDefault: true
+				WebSocketMaxMessageSize: 1024
+			};
+		}
+	`)
+	s.Require().NoError(err)
+
+	s.Equal("/api", srv.HttpPrefix)
+	s.True(srv.CompressionEnabled())
+	s.Equal("/api/ws", srv.WSPath)
+	s.Equal(uint(1024), srv.WSMaxSize)
+	s.Require().Len(srv.Methods, 2)
+	s.False(srv.Methods[0].Compressed)
+	s.True(srv.Methods[0].WebSocket)
+	s.True(srv.Methods[1].Compressed)
+	s.False(srv.Methods[1].WebSocket)
+}
+
+func (s *OptionsTestSuite) TestHawkOptions_UnknownKey() {
+	_, err := s.parseService(`
+		service Test {
+			option (hawk.service) = { HttpPrefix: "/api", Prefix: "/api" };
+			rpc Get(Request) returns (Request) {
+				option (hawk.method).Compress = true;
+			}
+		}
+	`)
+	var diagnostics Diagnostics
+	s.Require().ErrorAs(err, &diagnostics)
+	s.Require().Len(diagnostics.Errors(), 2)
+	s.Equal("unknown key `Prefix` of `(hawk.service)` (service `Test`)", diagnostics[0].Message)
+	s.Equal(8, diagnostics[0].Pos.Line)
+	s.Equal("unknown key `Compress` of `(hawk.method)` (method `Get`)", diagnostics[1].Message)
+}
+
+func (s *OptionsTestSuite) TestHawkOptions_Field() {
+	p := NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package test;
+		import "hawk/options.proto";
+		message Request {
+			string name = 1 [(hawk.field) = {}];
+			string email = 2 [(hawk.field).Hidden = true];
+		}
+	`)
+	var diagnostics Diagnostics
+	s.Require().ErrorAs(err, &diagnostics)
+	s.Require().Len(diagnostics.Errors(), 1)
+	s.Equal("unknown key `Hidden` of `(hawk.field)` (field `email`)", diagnostics[0].Message)
+
+	msg := p.Definition().Messages["Request"]
+	s.Require().NotNil(msg)
+	s.Require().Len(msg.Fields, 2)
+	s.Empty(msg.Fields[0].Options)
+	s.Empty(msg.Fields[1].Options)
+}
+
+func (s *OptionsTestSuite) TestHawkOptions_InvalidValue() {
+	_, err := s.parseService(`
+		service Test {
+			option (hawk.service) = { HttpPrefix: true };
+		}
+	`)
+	var diagnostics Diagnostics
+	s.Require().ErrorAs(err, &diagnostics)
+	s.Equal("invalid value provided for `HttpPrefix` of `(hawk.service)` (service `Test`)", diagnostics[0].Message)
+}
+
+func (s *OptionsTestSuite) TestHawkOptions_Qualified() {
+	def := &Definition{pack: "hawk.example"}
+	s.True(def.isOption(&io.Option{Name: "hawk.service"}, options.Service))
+	s.True(def.isOption(&io.Option{Name: ".hawk.service"}, options.Service))
+	s.True(def.isOption(&io.Option{Name: "service"}, options.Service))
+	s.False(def.isOption(&io.Option{Name: ".service"}, options.Service))
+	s.False(def.isOption(&io.Option{Name: "config"}, options.Service))
+	s.False((&Definition{pack: "test"}).isOption(&io.Option{Name: "service"}, options.Service))
+}
+
+func (s *OptionsTestSuite) TestLegacyConfig() {
+	srv, err := s.parseService(`
+		service Test {
+			option (config) = { HttpPrefix: "/api", Unknown: 1 };
+		}
+	`)
+	s.Require().NoError(err)
+	s.Equal("/api", srv.HttpPrefix)
+}
@@ -0,0 +1,242 @@
+package proto
+
+import (
+	"github.com/iancoleman/strcase"
+	"github.com/niiigoo/hawk/proto/io"
+	"path"
+	"strings"
+	"unicode"
+)
+
+// Symbol is a message or an enum declared in one of the parsed files or one of
+// the well-known types provided by protobuf.
+type Symbol struct {
+	// FullName is the fully-qualified name without the leading dot (`pkg.Outer.Inner`)
+	FullName string
+	// Name is the name relative to the package (`Outer.Inner`)
+	Name    string
+	Package string
+	Type    Type
+
+	Message *io.Message
+	Enum    *io.Enum
+	// WellKnown marks the types of `google/protobuf/*.proto`, their declaration is not parsed
+	WellKnown bool
+	// GoPackage is the import path of the Go package the type is generated to, it is empty if the
+	// file declaring the type has no option `go_package`
+	GoPackage string
+}
+
+// GoName returns the name of the generated go type, nested types are joined by an underscore.
+func (s *Symbol) GoName() string {
+	parts := strings.Split(s.Name, ".")
+	for i, p := range parts {
+		parts[i] = strcase.ToCamel(p)
+	}
+	return strings.Join(parts, "_")
+}
+
+// GoAlias returns the name the Go package of the symbol is imported as. The well-known types keep
+// the name of their package (`durationpb`), the other packages are named after the proto package
+// (`common.v1` becomes `commonv1pb`) to not conflict with the packages imported by the generated code.
+func (s *Symbol) GoAlias() string {
+	if s.WellKnown {
+		return path.Base(s.GoPackage)
+	}
+	name := s.Package
+	if name == "" {
+		name = path.Base(s.GoPackage)
+	}
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name) + "pb"
+}
+
+// RelativeName returns the name of the symbol as it can be referenced from within the package pkg.
+func (s *Symbol) RelativeName(pkg string) string {
+	if s.Package == pkg {
+		return s.Name
+	}
+	return s.FullName
+}
+
+// GoType returns the Go type of the symbol as it is referenced from within the
+// code of the Go package goPackage, which is imported as `pb`. Types of other
+// packages are qualified by GoAlias (`commonpb.Page`).
+func (s *Symbol) GoType(goPackage string) string {
+	if s.GoPackage == "" || s.GoPackage == goPackage {
+		return "pb." + s.GoName()
+	}
+	return s.GoAlias() + "." + s.GoName()
+}
+
+var wellKnownMessages = []string{
+	"Any", "Duration", "Empty", "FieldMask", "ListValue", "Struct", "Timestamp", "Value",
+	"DoubleValue", "FloatValue", "Int64Value", "UInt64Value", "Int32Value", "UInt32Value", "BoolValue",
+	"StringValue", "BytesValue",
+}
+
+var wellKnownEnums = []string{
+	"NullValue",
+}
+
+// wellKnownGoPackages contains the Go packages of the well-known types, the wrappers are located
+// in `wrapperspb`.
+var wellKnownGoPackages = map[string]string{
+	"Any":       "anypb",
+	"Duration":  "durationpb",
+	"Empty":     "emptypb",
+	"FieldMask": "fieldmaskpb",
+	"ListValue": "structpb",
+	"NullValue": "structpb",
+	"Struct":    "structpb",
+	"Timestamp": "timestamppb",
+	"Value":     "structpb",
+}
+
+// wellKnownGoPackage returns the import path of the Go package of the well-known type name.
+func wellKnownGoPackage(name string) string {
+	pkg, ok := wellKnownGoPackages[name]
+	if !ok {
+		pkg = "wrapperspb"
+	}
+	return "google.golang.org/protobuf/types/known/" + pkg
+}
+
+// symbols is the symbol table shared by all files of a definition, the key is the fully-qualified name.
+type symbols struct {
+	types    map[string]*Symbol
+	packages map[string]bool
+}
+
+func newSymbols() *symbols {
+	s := &symbols{
+		types:    make(map[string]*Symbol),
+		packages: make(map[string]bool),
+	}
+	s.addPackage("google.protobuf")
+	for _, name := range wellKnownMessages {
+		s.add(&Symbol{FullName: "google.protobuf." + name, Name: name, Package: "google.protobuf", Type: TypeMessage, WellKnown: true, GoPackage: wellKnownGoPackage(name)})
+	}
+	for _, name := range wellKnownEnums {
+		s.add(&Symbol{FullName: "google.protobuf." + name, Name: name, Package: "google.protobuf", Type: TypeEnum, WellKnown: true, GoPackage: wellKnownGoPackage(name)})
+	}
+	return s
+}
+
+func (s *symbols) add(sym *Symbol) {
+	s.types[sym.FullName] = sym
+}
+
+func (s *symbols) addPackage(pkg string) {
+	for pkg != "" {
+		s.packages[pkg] = true
+		pos := strings.LastIndex(pkg, ".")
+		if pos < 0 {
+			break
+		}
+		pkg = pkg[:pos]
+	}
+}
+
+// addFile registers all messages and enums of a file, including the nested ones.
+func (s *symbols) addFile(data *io.Proto) []*Symbol {
+	pkg := packageOf(data)
+	s.addPackage(pkg)
+
+	options := make([]*io.Option, 0)
+	added := make([]*Symbol, 0)
+	for _, entry := range data.Entries {
+		if entry.Message != nil {
+			added = s.addMessage(added, pkg, "", entry.Message)
+		} else if entry.Enum != nil {
+			added = append(added, s.addEnum(pkg, "", entry.Enum))
+		} else if entry.Option != nil {
+			options = append(options, entry.Option)
+		}
+	}
+
+	goPackage, _ := goPackageOf(options)
+	for _, sym := range added {
+		sym.GoPackage = goPackage
+	}
+	return added
+}
+
+func (s *symbols) addMessage(added []*Symbol, pkg, parent string, msg *io.Message) []*Symbol {
+	sym := &Symbol{
+		FullName: qualify(pkg, qualify(parent, msg.Name)),
+		Name:     qualify(parent, msg.Name),
+		Package:  pkg,
+		Type:     TypeMessage,
+		Message:  msg,
+	}
+	s.add(sym)
+	added = append(added, sym)
+
+	for _, entry := range msg.Entries {
+		if entry.Message != nil {
+			added = s.addMessage(added, pkg, sym.Name, entry.Message)
+		} else if entry.Enum != nil {
+			added = append(added, s.addEnum(pkg, sym.Name, entry.Enum))
+		}
+	}
+	return added
+}
+
+func (s *symbols) addEnum(pkg, parent string, enum *io.Enum) *Symbol {
+	sym := &Symbol{
+		FullName: qualify(pkg, qualify(parent, enum.Name)),
+		Name:     qualify(parent, enum.Name),
+		Package:  pkg,
+		Type:     TypeEnum,
+		Enum:     enum,
+	}
+	s.add(sym)
+	return sym
+}
+
+// resolve looks up the reference ref from within scope following the scoping rules of protobuf:
+// A leading dot marks a fully-qualified name. Otherwise, the first part of the reference is searched
+// from the innermost scope to the outermost one. As soon as it is found as a message or a package,
+// the remaining parts have to be declared within it.
+func (s *symbols) resolve(scope, ref string) *Symbol {
+	if strings.HasPrefix(ref, ".") {
+		return s.types[ref[1:]]
+	}
+
+	first := ref
+	if pos := strings.Index(ref, "."); pos >= 0 {
+		first = ref[:pos]
+	}
+
+	for {
+		candidate := qualify(scope, first)
+		if first == ref {
+			if sym, ok := s.types[candidate]; ok {
+				return sym
+			}
+		} else if sym, ok := s.types[candidate]; (ok && sym.Type == TypeMessage) || s.packages[candidate] {
+			// the first part is an aggregate, the remainder has to be declared within it
+			return s.types[qualify(scope, ref)]
+		}
+		if scope == "" {
+			return nil
+		}
+		if pos := strings.LastIndex(scope, "."); pos >= 0 {
+			scope = scope[:pos]
+		} else {
+			scope = ""
+		}
+	}
+}
+
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
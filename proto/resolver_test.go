@@ -0,0 +1,110 @@
+package proto
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type ResolverTestSuite struct {
+	suite.Suite
+	def *Definition
+}
+
+func TestResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(ResolverTestSuite))
+}
+
+func (s *ResolverTestSuite) SetupTest() {
+	p := NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package shop.v1;
+		import "google/protobuf/timestamp.proto";
+		enum Status {
+			UNKNOWN = 0;
+		}
+		message Order {
+			message Item {
+				enum Status {
+					OPEN = 0;
+				}
+				string name = 1;
+				Status status = 2;
+			}
+			Item item = 1;
+			Status status = 2;
+			.shop.v1.Order.Item fq = 3;
+			google.protobuf.Timestamp created = 4;
+			v1.Status package_relative = 5;
+		}
+	`)
+	s.Require().NoError(err)
+	s.def = p.Definition()
+}
+
+func (s *ResolverTestSuite) TestResolve_Nested() {
+	sym := s.def.Resolve("shop.v1.Order", "Item")
+
+	s.Require().NotNil(sym)
+	s.Equal("shop.v1.Order.Item", sym.FullName)
+	s.Equal("Order_Item", sym.GoName())
+	s.Equal(TypeMessage, sym.Type)
+}
+
+func (s *ResolverTestSuite) TestResolve_InnermostScope() {
+	inner := s.def.Resolve("shop.v1.Order.Item", "Status")
+	outer := s.def.Resolve("shop.v1.Order", "Status")
+
+	s.Require().NotNil(inner)
+	s.Equal("shop.v1.Order.Item.Status", inner.FullName)
+	s.Equal(TypeEnum, inner.Type)
+	s.Require().NotNil(outer)
+	s.Equal("shop.v1.Status", outer.FullName)
+}
+
+func (s *ResolverTestSuite) TestResolve_FullyQualified() {
+	sym := s.def.Resolve("shop.v1.Order", ".shop.v1.Order.Item")
+
+	s.Require().NotNil(sym)
+	s.Equal("Order.Item", sym.Name)
+}
+
+func (s *ResolverTestSuite) TestResolve_WellKnown() {
+	sym := s.def.Resolve("shop.v1.Order", "google.protobuf.Timestamp")
+
+	s.Require().NotNil(sym)
+	s.True(sym.WellKnown)
+	s.Equal(TypeMessage, sym.Type)
+}
+
+func (s *ResolverTestSuite) TestResolve_PartiallyQualified() {
+	sym := s.def.Resolve("shop.v1.Order", "v1.Status")
+
+	s.Require().NotNil(sym)
+	s.Equal("shop.v1.Status", sym.FullName)
+}
+
+func (s *ResolverTestSuite) TestResolve_Unknown() {
+	s.Nil(s.def.Resolve("shop.v1.Order", "Missing"))
+	s.Nil(s.def.Resolve("shop.v1.Order", "Item.Missing"))
+}
+
+func (s *ResolverTestSuite) TestParams_Types() {
+	msg := s.def.MessagesMap["Order"]
+	s.Require().NotNil(msg)
+
+	types := make(map[string]Type)
+	for _, entry := range msg.Entries {
+		if entry.Field == nil {
+			continue
+		}
+		param := s.def.newParam("shop.v1.Order", entry.Field)
+		types[param.Name] = param.Type
+	}
+
+	s.Equal(TypeMessage, types["item"])
+	s.Equal(TypeEnum, types["status"])
+	s.Equal(TypeMessage, types["fq"])
+	s.Equal(TypeMessage, types["created"])
+	s.Equal(TypeEnum, types["package_relative"])
+}
@@ -0,0 +1,94 @@
+package proto
+
+import (
+	"github.com/pkg/errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// revision reads files as they are committed in a git revision. Files outside
+// the repository (e.g. include paths of `protoc.yaml`) are read from the disk.
+type revision struct {
+	ref  string
+	root string
+}
+
+// newRevision returns the revision ref of the repository containing dir.
+func newRevision(dir, ref string) (*revision, error) {
+	root, err := gitRoot(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to detect the git repository of '%s'", dir)
+	}
+	return &revision{ref: ref, root: root}, nil
+}
+
+// gitRoot returns the top-level directory of the repository containing dir.
+func gitRoot(dir string) (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", gitError(err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// locate returns the closest existing directory of the file and the path of
+// the file relative to it. ok is false if the file is outside the repository.
+func (r *revision) locate(file string) (dir, rel string, ok bool) {
+	dir = filepath.Dir(file)
+	for {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", false
+		}
+		dir = parent
+	}
+	root, err := gitRoot(dir)
+	if err != nil || root != r.root {
+		return "", "", false
+	}
+	rel, err = filepath.Rel(dir, file)
+	return dir, filepath.ToSlash(rel), err == nil
+}
+
+// exists checks whether the file exists in the revision.
+func (r *revision) exists(file string) bool {
+	dir, rel, ok := r.locate(file)
+	if !ok {
+		info, err := os.Stat(file)
+		return err == nil && !info.IsDir()
+	}
+	cmd := exec.Command("git", "cat-file", "-e", r.ref+":./"+rel)
+	cmd.Dir = dir
+	return cmd.Run() == nil
+}
+
+// read returns the content of the file in the revision.
+func (r *revision) read(file string) ([]byte, error) {
+	dir, rel, ok := r.locate(file)
+	if !ok {
+		return os.ReadFile(file)
+	}
+	cmd := exec.Command("git", "show", r.ref+":./"+rel)
+	cmd.Dir = dir
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(gitError(err), "failed to load '%s' of revision '%s'", file, r.ref)
+	}
+	return data, nil
+}
+
+// gitError returns the message git printed on failure.
+func gitError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
@@ -1,28 +1,54 @@
 package proto
 
 import (
+	"bytes"
+	"github.com/niiigoo/hawk/proto/googleapis"
 	"github.com/niiigoo/hawk/proto/io"
+	"github.com/niiigoo/hawk/proto/options"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"gopkg.in/yaml.v3"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 )
 
+// wellKnownImports are the prefixes of imports which are not loaded while
+// parsing. They are provided by hawk, see bundledIncludes.
+var wellKnownImports = []string{
+	"google/protobuf/",
+	"google/api/",
+	"googleapis/",
+	"hawk/",
+}
+
 type Parser interface {
 	DetectFile(args ...string) (string, error)
+	DetectFiles(args ...string) ([]string, error)
 	Parse(file string, comments ...bool) error
 	ParseString(data string) error
+	ParseRevision(file, ref string) error
 	Definition() *Definition
-	CreateFile(file, pgk, srv string) error
-	CompileProto(file, out string, includes ...string) error
+	Imports() []string
+	Config() ProtocConfig
+	SetDescriptorSet(path string)
+	SetConfigDir(dir string)
+	CreateFile(file, pgk, srv, goPackage string) error
+	CompileProto(file, out, importPath string, includes ...string) error
 }
 
 type service struct {
-	file       string
-	data       *io.Proto
-	definition *Definition
+	file          string
+	descriptorSet string
+	data          *io.Proto
+	definition    *Definition
+	// configDir is the directory `protoc.yaml` is looked up from
+	configDir string
+	// imports are the files imported by the parsed file
+	imports []string
 }
 
 func NewService() Parser {
@@ -33,96 +59,355 @@ func (p *service) Definition() *Definition {
 	return p.definition
 }
 
+// Imports returns the files imported by the file parsed last, directly or
+// indirectly, as they have been found on the disk. The well-known files are
+// not contained, neither are the imports of a FileDescriptorSet.
+func (p *service) Imports() []string {
+	return p.imports
+}
+
+// Config returns the configuration of `protoc.yaml`, see SetConfigDir.
+func (p *service) Config() ProtocConfig {
+	return p.parseConfig()
+}
+
+// SetConfigDir sets the directory `protoc.yaml` is looked up from, the working
+// directory by default. If it does not contain the file, its parents up to the
+// root of the Go module are searched.
+func (p *service) SetConfigDir(dir string) {
+	p.configDir = dir
+}
+
+// DetectFile returns the first file given by args or, if args is empty, the
+// first proto file in the working directory.
 func (p *service) DetectFile(args ...string) (string, error) {
+	files, err := p.DetectFiles(args...)
+	if err != nil {
+		return "", err
+	}
+	return files[0], nil
+}
+
+// DetectFiles returns the files given by args or, if args is empty, all proto
+// files in the working directory sorted by name.
+func (p *service) DetectFiles(args ...string) ([]string, error) {
 	if len(args) > 0 {
-		return args[0], nil
+		return args, nil
 	}
 
 	dir, err := os.Getwd()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	entries, err := os.ReadDir(dir)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	files := make([]string, 0)
 	for _, entry := range entries {
 		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".proto") {
-			return dir + "/" + entry.Name(), nil
+			files = append(files, dir+"/"+entry.Name())
 		}
 	}
+	if len(files) == 0 {
+		return nil, errors.New("no .proto file found")
+	}
 
-	return "", errors.New("no .proto file found")
+	return files, nil
+}
+
+// SetDescriptorSet makes Parse build the definition from the FileDescriptorSet
+// stored at path instead of parsing the proto file. DescriptorSetProtoc lets
+// protoc produce the set from the proto file.
+func (p *service) SetDescriptorSet(path string) {
+	p.descriptorSet = path
 }
 
 func (p *service) Parse(file string, comments ...bool) error {
-	f, err := os.Open(file)
+	withComments := len(comments) > 0 && comments[0]
+
+	var err error
+	var imports []*io.Proto
+	p.file = file
+	p.imports = nil
+	if p.descriptorSet != "" {
+		p.data, imports, err = p.parseDescriptorSet(file)
+		if err != nil {
+			return err
+		}
+	} else {
+		p.data, err = p.parseFile(file, withComments)
+		if err != nil {
+			return err
+		}
+
+		imports, err = p.loadImports(p.data, filepath.Dir(file), withComments, map[string]bool{}, nil)
+		if err != nil {
+			return err
+		}
+		for _, imported := range imports {
+			p.imports = append(p.imports, imported.Pos.Filename)
+		}
+	}
+
+	p.definition, err = DefinitionFromProto(p.data, imports...)
+	if err != nil {
+		return err
+	}
+	p.printWarnings()
+
+	return nil
+}
+
+func (p *service) ParseString(data string) error {
+	var err error
+	p.imports = nil
+	p.data, err = io.ParseString("", data, false)
+	if err != nil {
+		return err
+	}
+
+	imports, err := p.loadImports(p.data, ".", false, map[string]bool{}, nil)
+	if err != nil {
+		return err
+	}
+
+	p.definition, err = DefinitionFromProto(p.data, imports...)
+	if err != nil {
+		return err
+	}
+	p.printWarnings()
+
+	return nil
+}
+
+// ParseRevision parses the file as it is committed in the git revision ref
+// (e.g. `main` or `HEAD~1`). The imports are loaded from the same revision.
+func (p *service) ParseRevision(file, ref string) error {
+	dir := filepath.Dir(file)
+	rev, err := newRevision(dir, ref)
+	if err != nil {
+		return err
+	}
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return err
+	}
+	data, err := rev.read(abs)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		_ = f.Close()
-	}()
 
 	p.file = file
-	p.data, err = io.Parse(file, f, len(comments) > 0 && comments[0])
+	p.imports = nil
+	p.data, err = io.ParseString(ref+":"+file, string(data), false)
+	if err != nil {
+		return err
+	}
+
+	imports, err := p.loadImports(p.data, dir, false, map[string]bool{}, rev)
+	if err != nil {
+		return err
+	}
+
+	p.definition, err = DefinitionFromProto(p.data, imports...)
 	if err != nil {
 		return err
 	}
+	p.printWarnings()
 
-	p.definition, err = DefinitionFromProto(p.data)
+	return nil
+}
 
-	return err
+// printWarnings logs the warnings found while building the definition.
+func (p *service) printWarnings() {
+	for _, warning := range p.definition.Diagnostics.Warnings() {
+		log.Warn(warning.Error())
+	}
 }
 
-func (p *service) ParseString(data string) error {
+// parseDescriptorSet converts the configured FileDescriptorSet, the comments
+// are taken from the source code info.
+func (p *service) parseDescriptorSet(file string) (*io.Proto, []*io.Proto, error) {
+	var data []byte
 	var err error
-	p.data, err = io.ParseString("", data, false)
+	if p.descriptorSet == DescriptorSetProtoc {
+		data, err = p.protocDescriptorSet(file)
+	} else {
+		data, err = os.ReadFile(p.descriptorSet)
+	}
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	p.definition, err = DefinitionFromProto(p.data)
+	set := &descriptorpb.FileDescriptorSet{}
+	if err = protobuf.Unmarshal(data, set); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read descriptor set '%s'", p.descriptorSet)
+	}
 
-	return err
+	return ProtoFromDescriptorSet(set, filepath.Base(file), file)
 }
 
-func (p *service) CreateFile(file, pkg, srv string) error {
-	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0666)
+// protocDescriptorSet compiles the file including its imports and the source
+// code info into a FileDescriptorSet using protoc.
+func (p *service) protocDescriptorSet(file string) ([]byte, error) {
+	out, err := os.CreateTemp("", "hawk-*.binpb")
 	if err != nil {
-		return errors.Wrapf(err, "failed to open file '%s'", file)
+		return nil, err
+	}
+	_ = out.Close()
+	defer func() {
+		_ = os.Remove(out.Name())
+	}()
+
+	args := []string{
+		"--descriptor_set_out=" + out.Name(),
+		"--include_imports",
+		"--include_source_info",
+		"-I=" + filepath.Dir(file),
+	}
+	config := p.parseConfig()
+	bundled, err := bundledIncludes(config)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range append(config.Imports, bundled...) {
+		args = append(args, "-I="+i)
+	}
+	args = append(args, file)
+
+	stderr := bytes.NewBuffer(nil)
+	cmd := exec.Command("protoc", args...)
+	cmd.Stderr = stderr
+	if err = cmd.Run(); err != nil {
+		return nil, errors.Errorf("protoc failed: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	return os.ReadFile(out.Name())
+}
+
+func (p *service) parseFile(file string, comments bool) (*io.Proto, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
 	}
 	defer func() {
 		_ = f.Close()
 	}()
 
-	_, err = f.WriteString(`syntax = "proto3";
+	return io.Parse(file, f, comments)
+}
 
-package ` + pkg + `;
-option go_package = ".;` + pkg + `";
+// loadImports parses all files imported by data, recursively. The imports are
+// looked up relative to dir and the include paths configured in `protoc.yaml`.
+// Well-known files (google/protobuf, google/api) are skipped, the types
+// declared in them are handled by hawk directly. The files are read from the
+// git revision rev, or from the disk if it is nil.
+func (p *service) loadImports(data *io.Proto, dir string, comments bool, visited map[string]bool, rev *revision) ([]*io.Proto, error) {
+	imports := make([]*io.Proto, 0)
+	for _, entry := range data.Entries {
+		if entry.Import == "" || isWellKnownImport(entry.Import) {
+			continue
+		}
 
-import "google/protobuf/descriptor.proto";
-import "googleapis/google/api/annotations.proto";
+		file, ok := p.findImport(entry.Import, dir, rev)
+		if !ok {
+			log.WithField("import", entry.Import).Warn("imported file not found, types declared in it are unknown")
+			continue
+		}
+		if visited[file] {
+			continue
+		}
+		visited[file] = true
+
+		var imported *io.Proto
+		var err error
+		if rev != nil {
+			var data []byte
+			if data, err = rev.read(file); err == nil {
+				imported, err = io.ParseString(rev.ref+":"+file, string(data), comments)
+			}
+		} else {
+			imported, err = p.parseFile(file, comments)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse imported file '%s'", entry.Import)
+		}
+		imports = append(imports, imported)
 
-extend google.protobuf.ServiceOptions {
-  optional Config config = 10000;
+		nested, err := p.loadImports(imported, dir, comments, visited, rev)
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, nested...)
+	}
+
+	return imports, nil
 }
-extend google.protobuf.MethodOptions {
-  optional bool httpCompress = 10001;
+
+// findImport returns the path of the imported file. The directory of the
+// parsed proto file is searched first, followed by the configured imports.
+// The file has to exist in the git revision rev unless it is nil.
+func (p *service) findImport(name, dir string, rev *revision) (string, bool) {
+	paths := []string{dir}
+	for _, i := range p.parseConfig().Imports {
+		paths = append(paths, i)
+	}
+
+	for _, path := range paths {
+		file := filepath.Join(path, name)
+		if abs, err := filepath.Abs(file); err == nil {
+			file = abs
+		}
+		if rev != nil {
+			if rev.exists(file) {
+				return file, true
+			}
+		} else if info, err := os.Stat(file); err == nil && !info.IsDir() {
+			return file, true
+		}
+	}
+
+	return "", false
 }
 
-message Config {
-  string HttpPrefix = 1;
-  bool HttpCompress = 2;
-  string WebSocketPath = 3;
-  uint32 WebSocketMaxMessageSize = 4;
+func isWellKnownImport(name string) bool {
+	for _, prefix := range wellKnownImports {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
+// CreateFile writes a proto file declaring the service srv in the package pkg,
+// goPackage is used as `go_package` and defaults to the directory of the file.
+func (p *service) CreateFile(file, pkg, srv, goPackage string) error {
+	if goPackage == "" {
+		goPackage = "."
+	}
+
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0666)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open file '%s'", file)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	_, err = f.WriteString(`syntax = "proto3";
+
+package ` + pkg + `;
+option go_package = "` + goPackage + `;` + pkg + `";
+
+import "googleapis/google/api/annotations.proto";
+import "hawk/options.proto";
+
 service ` + srv + ` {
-	option (config) = {
-		HttpPrefix: "/api/` + pkg + `"
-		HttpCompress: false
-	};
+  option (hawk.service) = {
+    HttpPrefix: "/api/` + pkg + `"
+    HttpCompress: false
+  };
 }
 `)
 	if err != nil {
@@ -132,39 +417,96 @@ service ` + srv + ` {
 	return nil
 }
 
+// parseConfig reads `protoc.yaml` (or `protoc.yml`), the relative paths in
+// it are resolved against its directory.
 func (p *service) parseConfig() ProtocConfig {
-	data, err := os.ReadFile("protoc.yaml")
-	if err != nil {
-		data, err = os.ReadFile("protoc.yml")
+	for _, dir := range configDirs(p.configDir) {
+		data, err := os.ReadFile(filepath.Join(dir, "protoc.yaml"))
 		if err != nil {
-			return ProtocConfig{}
+			data, err = os.ReadFile(filepath.Join(dir, "protoc.yml"))
+			if err != nil {
+				continue
+			}
 		}
+
+		var config ProtocConfig
+		_ = yaml.Unmarshal(data, &config)
+		config.resolve(dir)
+		return config
 	}
+	return ProtocConfig{}
+}
+
+// configDirs returns the directories `protoc.yaml` is looked up in: dir and
+// its parents up to the root of the Go module. Only dir is returned if it is
+// not located in a module.
+func configDirs(dir string) []string {
+	if dir == "" {
+		dir = "."
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return []string{dir}
+	}
+	dirs := make([]string, 0)
+	for current := abs; ; current = filepath.Dir(current) {
+		dirs = append(dirs, current)
+		if _, err = os.Stat(filepath.Join(current, "go.mod")); err == nil {
+			return dirs
+		}
+		if filepath.Dir(current) == current {
+			return []string{abs}
+		}
+	}
+}
 
-	var config ProtocConfig
-	_ = yaml.Unmarshal(data, &config)
-	return config
+// bundledIncludes returns the include paths of the files bundled with hawk:
+// googleapis and `hawk/options.proto`.
+func bundledIncludes(config ProtocConfig) ([]string, error) {
+	includes, err := googleapis.Includes(config.Googleapis)
+	if err != nil {
+		return nil, err
+	}
+	hawkIncludes, err := options.Includes()
+	if err != nil {
+		return nil, err
+	}
+	return append(includes, hawkIncludes...), nil
 }
 
-func (p *service) CompileProto(file, out string, imports ...string) error {
+// CompileProto generates the Go and gRPC code of the proto file into out,
+// the directory of the Go package importPath. The file is compiled in-process
+// unless the external protoc is configured in `protoc.yaml`.
+func (p *service) CompileProto(file, out, importPath string, imports ...string) error {
 	config := p.parseConfig()
-	if len(config.Imports) > 0 {
-		imports = append(imports, config.Imports...)
+	bundled, err := bundledIncludes(config)
+	if err != nil {
+		return err
+	}
+	imports = append(append(imports, config.Imports...), bundled...)
+	if config.Compiler != CompilerProtoc {
+		return CompilePackage(file, out, importPath, imports...)
 	}
 
+	imports, name := compileIncludes(file, imports)
 	args := []string{
 		"--go-grpc_out=" + out,
 		"--go_out=" + out,
 	}
+	for _, param := range packageParameters(name, importPath) {
+		args = append(args, "--go-grpc_opt="+param, "--go_opt="+param)
+	}
 	for _, i := range imports {
-		args = append(args, "-I="+os.ExpandEnv(i))
+		args = append(args, "-I="+i)
 	}
 	args = append(args, file)
+
+	stderr := bytes.NewBuffer(nil)
 	cmd := exec.Command("protoc", args...)
-	err := cmd.Run()
-	if err != nil {
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
 		log.Info("Run: ", cmd.String())
-		log.WithError(err).Error("failed to compile proto file")
+		return errors.Errorf("protoc failed: %s", strings.TrimSpace(stderr.String()))
 	}
-	return err
+	return nil
 }
@@ -0,0 +1,322 @@
+package proto
+
+import (
+	"github.com/stretchr/testify/suite"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *ServiceTestSuite) writeFile(name, content string) string {
+	file := filepath.Join(s.dir, name)
+	s.Require().NoError(os.MkdirAll(filepath.Dir(file), 0777))
+	s.Require().NoError(os.WriteFile(file, []byte(content), 0666))
+	return file
+}
+
+func (s *ServiceTestSuite) TestParse_Imports() {
+	s.writeFile("common/pagination.proto", `
+		syntax = "proto3";
+		package common;
+		import "common/order.proto";
+		message Pagination {
+			int32 page = 1;
+			Order order = 2;
+		}
+	`)
+	s.writeFile("common/order.proto", `
+		syntax = "proto3";
+		package common;
+		enum Order {
+			ASC = 0;
+			DESC = 1;
+		}
+	`)
+	file := s.writeFile("test.proto", `
+		syntax = "proto3";
+		package test;
+		import "google/api/annotations.proto";
+		import "common/pagination.proto";
+		message ListRequest {
+			common.Pagination pagination = 1;
+		}
+		message ListResponse {}
+		service Test {
+			rpc List(ListRequest) returns (ListResponse) {
+				option (google.api.http) = {
+					get: "/list"
+				};
+			}
+		}
+	`)
+
+	p := NewService()
+	err := p.Parse(file)
+
+	s.Require().NoError(err)
+	def := p.Definition()
+	s.Contains(def.MessagesMap, "common.Pagination")
+	s.Contains(def.MessagesMap, "ListRequest")
+	s.Contains(def.MessagesMap, "test.ListRequest")
+	s.Require().Len(def.Services, 1)
+	s.Require().Len(def.Services[0].Methods[0].HttpBindings, 1)
+	params := def.Services[0].Methods[0].HttpBindings[0].Params
+	s.Require().Len(params, 1)
+	s.Equal(TypeMessage, params[0].Type)
+	s.Equal(LocationQuery, params[0].Location)
+}
+
+func (s *ServiceTestSuite) TestParse_ImportMissing() {
+	file := s.writeFile("test.proto", `
+		syntax = "proto3";
+		package test;
+		import "missing.proto";
+		message Request {}
+	`)
+
+	p := NewService()
+	err := p.Parse(file)
+
+	s.Require().NoError(err)
+	s.Contains(p.Definition().MessagesMap, "Request")
+}
+
+func (s *ServiceTestSuite) git(args ...string) {
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = s.dir
+	out, err := cmd.CombinedOutput()
+	s.Require().NoError(err, string(out))
+}
+
+func (s *ServiceTestSuite) TestParseRevision_Imports() {
+	if _, err := exec.LookPath("git"); err != nil {
+		s.T().Skip("git is not installed")
+	}
+	s.writeFile("common/page.proto", `
+		syntax = "proto3";
+		package common;
+		message Page {
+			int32 number = 1;
+		}
+	`)
+	file := s.writeFile("test.proto", `
+		syntax = "proto3";
+		package test;
+		import "common/page.proto";
+		message ListRequest {
+			common.Page page = 1;
+		}
+	`)
+	s.git("init", "-q")
+	s.git("add", "-A")
+	s.git("commit", "-q", "-m", "init")
+
+	// the working tree differs from the revision
+	s.writeFile("common/page.proto", `
+		syntax = "proto3";
+		package common;
+		message Cursor {
+			string token = 1;
+		}
+	`)
+
+	p := NewService()
+	s.Require().NoError(p.ParseRevision(file, "HEAD"))
+
+	def := p.Definition()
+	s.Contains(def.MessagesMap, "common.Page")
+	s.NotContains(def.MessagesMap, "common.Cursor")
+
+	s.Require().NoError(os.RemoveAll(filepath.Join(s.dir, "common")))
+	s.Require().NoError(p.ParseRevision(file, "HEAD"))
+	s.Contains(p.Definition().MessagesMap, "common.Page")
+}
+
+func (s *ServiceTestSuite) TestConfig_Lookup() {
+	s.writeFile("go.mod", "module example.com/mono\n")
+	s.writeFile("protoc.yaml", `
+imports:
+  - api
+  - /usr/include
+templates: templates
+plugins:
+  - command: ./tools/sdk
+  - command: hawk-sdk
+`)
+	service := filepath.Join(s.dir, "services", "foo")
+	s.Require().NoError(os.MkdirAll(service, 0777))
+
+	p := NewService()
+	p.SetConfigDir(service)
+	config := p.Config()
+	s.Equal([]string{filepath.Join(s.dir, "api"), "/usr/include"}, config.Imports)
+	s.Equal(filepath.Join(s.dir, "templates"), config.Templates)
+	s.Require().Len(config.Plugins, 2)
+	s.Equal(filepath.Join(s.dir, "tools", "sdk"), config.Plugins[0].Command)
+	s.Equal("hawk-sdk", config.Plugins[1].Command)
+
+	// the file of the service takes precedence
+	s.writeFile("services/foo/protoc.yaml", "templates: ../templates\n")
+	s.Equal(filepath.Join(s.dir, "services", "templates"), p.Config().Templates)
+
+	// the lookup stops at the module root
+	s.writeFile("services/go.mod", "module example.com/services\n")
+	p.SetConfigDir(filepath.Join(s.dir, "services"))
+	s.Empty(p.Config().Imports)
+}
+
+func (s *ServiceTestSuite) TestParse_Diagnostics() {
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+message Request {
+	map<string, string> labels = 1;
+}
+message Response {}
+service Test {
+	rpc Get(Request) returns (Response) {
+		option (google.api.http) = {
+			get: "/get/{id}"
+		};
+	}
+	rpc Update(Request) returns (Response) {
+		option (google.api.http) = {
+			put: "/update"
+			body: "missing"
+		};
+	}
+}
+`)
+
+	p := NewService()
+	err := p.Parse(file)
+
+	var diagnostics Diagnostics
+	s.Require().ErrorAs(err, &diagnostics)
+	s.Require().Len(diagnostics.Errors(), 2)
+	s.Equal(file+":9:10: path parameter `id` not found (method `Get`)", diagnostics.Errors()[0].Error())
+	s.Equal(file+":14:10: body field `missing` not found (method `Update`)", diagnostics.Errors()[1].Error())
+	s.Require().NotEmpty(diagnostics.Warnings())
+	s.Equal(4, diagnostics.Warnings()[0].Pos.Line)
+	s.Equal(SeverityWarning, diagnostics.Warnings()[0].Severity)
+}
+
+func (s *ServiceTestSuite) TestParse_PathDiagnostics() {
+	file := s.writeFile("test.proto", `syntax = "proto3";
+package test;
+message Author { string name = 1; }
+message Book { string name = 1; Author author = 2; }
+message Request { Book book = 1; string id = 2; }
+service Test {
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/v1/{book.name=shelves/*}/{book.author.name}"
+		};
+	}
+	rpc Missing(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/v1/{book.title}"
+		};
+	}
+	rpc Invalid(Request) returns (Request) {
+		option (google.api.http) = { get: "/v1/{id" };
+	}
+	rpc Wildcard(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/v1/*/{id}"
+		};
+	}
+}
+`)
+
+	p := NewService()
+	err := p.Parse(file)
+
+	var diagnostics Diagnostics
+	s.Require().ErrorAs(err, &diagnostics)
+	s.Require().Len(diagnostics.Errors(), 3)
+	// the position points to the end of the template within the string literal
+	s.Equal(file+":18:45: failed to parse path `/v1/{id` (method `Invalid`): unexpected token \"<EOF>\" (expected \"}\")", diagnostics.Errors()[0].Error())
+	s.Equal(file+":13:10: path parameter `book.title` not found (method `Missing`)", diagnostics.Errors()[1].Error())
+	s.Equal(file+":21:10: wildcard `*` is not bound to a field, use a variable like `{name=*}` (method `Wildcard`)", diagnostics.Errors()[2].Error())
+
+	params := p.Definition().Services[0].Methods[0].HttpBindings[0].Params
+	s.Require().Len(params, 2)
+	s.Equal("book", params[0].Name)
+	s.Equal(LocationPath, params[0].Location)
+	s.Equal("id", params[1].Name)
+	s.Equal(LocationQuery, params[1].Location)
+}
+
+func (s *ServiceTestSuite) TestDetectFiles() {
+	s.writeFile("b.proto", `syntax = "proto3";`)
+	s.writeFile("a.proto", `syntax = "proto3";`)
+	s.writeFile("a.txt", "")
+	s.writeFile("nested/c.proto", `syntax = "proto3";`)
+	wd, err := os.Getwd()
+	s.Require().NoError(err)
+	s.Require().NoError(os.Chdir(s.dir))
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	p := NewService()
+	files, err := p.DetectFiles()
+	s.Require().NoError(err)
+	s.Equal([]string{s.dir + "/a.proto", s.dir + "/b.proto"}, files)
+
+	file, err := p.DetectFile()
+	s.Require().NoError(err)
+	s.Equal(s.dir+"/a.proto", file)
+
+	files, err = p.DetectFiles("x.proto")
+	s.Require().NoError(err)
+	s.Equal([]string{"x.proto"}, files)
+
+	s.Require().NoError(os.Remove("a.proto"))
+	s.Require().NoError(os.Remove("b.proto"))
+	_, err = p.DetectFiles()
+	s.Error(err)
+}
+
+func (s *ServiceTestSuite) TestParse_GoPackage() {
+	p := NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+package test.v1;
+option java_package = "com.example.test";
+option go_package = "example.com/test/api/v1;apiv1";
+
+service Test {}
+`))
+
+	importPath, name := p.Definition().GoPackage()
+	s.Equal("example.com/test/api/v1", importPath)
+	s.Equal("apiv1", name)
+	s.Len(p.Definition().Options(), 2)
+	s.Equal("test.v1", p.Definition().Services[0].Package)
+
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+option go_package = "example.com/test/api/v1";
+`))
+	importPath, name = p.Definition().GoPackage()
+	s.Equal("example.com/test/api/v1", importPath)
+	s.Equal("v1", name)
+
+	s.Require().NoError(p.ParseString(`syntax = "proto3";`))
+	importPath, name = p.Definition().GoPackage()
+	s.Empty(importPath)
+	s.Empty(name)
+}
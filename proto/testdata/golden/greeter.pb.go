@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: greeter.proto
+
+// Package greeter is compiled by the built-in compiler, the generated files
+// are compared with the checked in ones.
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kind of the greeting
+type Greeting_Kind int32
+
+const (
+	Greeting_KIND_UNSPECIFIED Greeting_Kind = 0
+	Greeting_KIND_FORMAL      Greeting_Kind = 1
+)
+
+// Enum value maps for Greeting_Kind.
+var (
+	Greeting_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_FORMAL",
+	}
+	Greeting_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"KIND_FORMAL":      1,
+	}
+)
+
+func (x Greeting_Kind) Enum() *Greeting_Kind {
+	p := new(Greeting_Kind)
+	*p = x
+	return p
+}
+
+func (x Greeting_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Greeting_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_greeter_proto_enumTypes[0].Descriptor()
+}
+
+func (Greeting_Kind) Type() protoreflect.EnumType {
+	return &file_greeter_proto_enumTypes[0]
+}
+
+func (x Greeting_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Greeting_Kind.Descriptor instead.
+func (Greeting_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_greeter_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// A greeting
+type Greeting struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Message    string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Kind       Greeting_Kind          `protobuf:"varint,2,opt,name=kind,proto3,enum=greeter.Greeting_Kind" json:"kind,omitempty"`
+	Recipients []*Greeting_Recipient  `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Labels     map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	// Types that are valid to be assigned to Channel:
+	//
+	//	*Greeting_Email
+	//	*Greeting_Phone
+	Channel isGreeting_Channel `protobuf_oneof:"channel"`
+	// Deprecated: Marked as deprecated in greeter.proto.
+	Signature     []byte `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Greeting) Reset() {
+	*x = Greeting{}
+	mi := &file_greeter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Greeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Greeting) ProtoMessage() {}
+
+func (x *Greeting) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Greeting.ProtoReflect.Descriptor instead.
+func (*Greeting) Descriptor() ([]byte, []int) {
+	return file_greeter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Greeting) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Greeting) GetKind() Greeting_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Greeting_KIND_UNSPECIFIED
+}
+
+func (x *Greeting) GetRecipients() []*Greeting_Recipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *Greeting) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Greeting) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Greeting) GetChannel() isGreeting_Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *Greeting) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Channel.(*Greeting_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *Greeting) GetPhone() int64 {
+	if x != nil {
+		if x, ok := x.Channel.(*Greeting_Phone); ok {
+			return x.Phone
+		}
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in greeter.proto.
+func (x *Greeting) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type isGreeting_Channel interface {
+	isGreeting_Channel()
+}
+
+type Greeting_Email struct {
+	Email string `protobuf:"bytes,6,opt,name=email,proto3,oneof"`
+}
+
+type Greeting_Phone struct {
+	Phone int64 `protobuf:"varint,7,opt,name=phone,proto3,oneof"`
+}
+
+func (*Greeting_Email) isGreeting_Channel() {}
+
+func (*Greeting_Phone) isGreeting_Channel() {}
+
+// A recipient of the greeting
+type Greeting_Recipient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Greeting_Recipient) Reset() {
+	*x = Greeting_Recipient{}
+	mi := &file_greeter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Greeting_Recipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Greeting_Recipient) ProtoMessage() {}
+
+func (x *Greeting_Recipient) ProtoReflect() protoreflect.Message {
+	mi := &file_greeter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Greeting_Recipient.ProtoReflect.Descriptor instead.
+func (*Greeting_Recipient) Descriptor() ([]byte, []int) {
+	return file_greeter_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Greeting_Recipient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Greeting_Recipient) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+var File_greeter_proto protoreflect.FileDescriptor
+
+const file_greeter_proto_rawDesc = "" +
+	"\n" +
+	"\rgreeter.proto\x12\agreeter\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x04\n" +
+	"\bGreeting\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12*\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.greeter.Greeting.KindR\x04kind\x12;\n" +
+	"\n" +
+	"recipients\x18\x03 \x03(\v2\x1b.greeter.Greeting.RecipientR\n" +
+	"recipients\x125\n" +
+	"\x06labels\x18\x04 \x03(\v2\x1d.greeter.Greeting.LabelsEntryR\x06labels\x124\n" +
+	"\acreated\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12\x16\n" +
+	"\x05email\x18\x06 \x01(\tH\x00R\x05email\x12\x16\n" +
+	"\x05phone\x18\a \x01(\x03H\x00R\x05phone\x12 \n" +
+	"\tsignature\x18\b \x01(\fB\x02\x18\x01R\tsignature\x1aD\n" +
+	"\tRecipient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01B\b\n" +
+	"\x06_title\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"-\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vKIND_FORMAL\x10\x01B\t\n" +
+	"\achannel2\xe6\x01\n" +
+	"\aGreeter\x127\n" +
+	"\x05Greet\x12\x1b.greeter.Greeting.Recipient\x1a\x11.greeter.Greeting\x128\n" +
+	"\x04List\x12\x1b.greeter.Greeting.Recipient\x1a\x11.greeter.Greeting0\x01\x121\n" +
+	"\aCollect\x12\x11.greeter.Greeting\x1a\x11.greeter.Greeting(\x01\x125\n" +
+	"\x04Chat\x12\x11.greeter.Greeting\x1a\x11.greeter.Greeting\"\x03\x88\x02\x01(\x010\x01B\x1bZ\x19example.com/greeter/pb;pbb\x06proto3"
+
+var (
+	file_greeter_proto_rawDescOnce sync.Once
+	file_greeter_proto_rawDescData []byte
+)
+
+func file_greeter_proto_rawDescGZIP() []byte {
+	file_greeter_proto_rawDescOnce.Do(func() {
+		file_greeter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_greeter_proto_rawDesc), len(file_greeter_proto_rawDesc)))
+	})
+	return file_greeter_proto_rawDescData
+}
+
+var file_greeter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_greeter_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_greeter_proto_goTypes = []any{
+	(Greeting_Kind)(0),            // 0: greeter.Greeting.Kind
+	(*Greeting)(nil),              // 1: greeter.Greeting
+	(*Greeting_Recipient)(nil),    // 2: greeter.Greeting.Recipient
+	nil,                           // 3: greeter.Greeting.LabelsEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_greeter_proto_depIdxs = []int32{
+	0, // 0: greeter.Greeting.kind:type_name -> greeter.Greeting.Kind
+	2, // 1: greeter.Greeting.recipients:type_name -> greeter.Greeting.Recipient
+	3, // 2: greeter.Greeting.labels:type_name -> greeter.Greeting.LabelsEntry
+	4, // 3: greeter.Greeting.created:type_name -> google.protobuf.Timestamp
+	2, // 4: greeter.Greeter.Greet:input_type -> greeter.Greeting.Recipient
+	2, // 5: greeter.Greeter.List:input_type -> greeter.Greeting.Recipient
+	1, // 6: greeter.Greeter.Collect:input_type -> greeter.Greeting
+	1, // 7: greeter.Greeter.Chat:input_type -> greeter.Greeting
+	1, // 8: greeter.Greeter.Greet:output_type -> greeter.Greeting
+	1, // 9: greeter.Greeter.List:output_type -> greeter.Greeting
+	1, // 10: greeter.Greeter.Collect:output_type -> greeter.Greeting
+	1, // 11: greeter.Greeter.Chat:output_type -> greeter.Greeting
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_greeter_proto_init() }
+func file_greeter_proto_init() {
+	if File_greeter_proto != nil {
+		return
+	}
+	file_greeter_proto_msgTypes[0].OneofWrappers = []any{
+		(*Greeting_Email)(nil),
+		(*Greeting_Phone)(nil),
+	}
+	file_greeter_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_greeter_proto_rawDesc), len(file_greeter_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_greeter_proto_goTypes,
+		DependencyIndexes: file_greeter_proto_depIdxs,
+		EnumInfos:         file_greeter_proto_enumTypes,
+		MessageInfos:      file_greeter_proto_msgTypes,
+	}.Build()
+	File_greeter_proto = out.File
+	file_greeter_proto_goTypes = nil
+	file_greeter_proto_depIdxs = nil
+}